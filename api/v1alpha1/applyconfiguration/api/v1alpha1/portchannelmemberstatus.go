@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// PortChannelMemberStatusApplyConfiguration represents a declarative configuration of the PortChannelMemberStatus type for use
+// with apply.
+//
+// PortChannelMemberStatus reports the observed state of a single port channel member.
+type PortChannelMemberStatusApplyConfiguration struct {
+	// Name is the name of the member SwitchInterface.
+	Name *string `json:"name,omitempty"`
+	// NativeName is the native name of the member interface on the switch (e.g., "Ethernet0").
+	NativeName *string `json:"nativeName,omitempty"`
+	// Selected reports whether LACP selected the member for aggregation.
+	Selected *bool `json:"selected,omitempty"`
+}
+
+// PortChannelMemberStatusApplyConfiguration constructs a declarative configuration of the PortChannelMemberStatus type for use with
+// apply.
+func PortChannelMemberStatus() *PortChannelMemberStatusApplyConfiguration {
+	return &PortChannelMemberStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PortChannelMemberStatusApplyConfiguration) WithName(value string) *PortChannelMemberStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNativeName sets the NativeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NativeName field is set to the value of the last call.
+func (b *PortChannelMemberStatusApplyConfiguration) WithNativeName(value string) *PortChannelMemberStatusApplyConfiguration {
+	b.NativeName = &value
+	return b
+}
+
+// WithSelected sets the Selected field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selected field is set to the value of the last call.
+func (b *PortChannelMemberStatusApplyConfiguration) WithSelected(value bool) *PortChannelMemberStatusApplyConfiguration {
+	b.Selected = &value
+	return b
+}
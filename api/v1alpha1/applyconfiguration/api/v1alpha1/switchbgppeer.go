@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	internal "github.com/ironcore-dev/sonic-operator/api/v1alpha1/applyconfiguration/internal"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	managedfields "k8s.io/apimachinery/pkg/util/managedfields"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// SwitchBGPPeerApplyConfiguration represents a declarative configuration of the SwitchBGPPeer type for use
+// with apply.
+//
+// SwitchBGPPeer is the Schema for the switchbgppeers API
+type SwitchBGPPeerApplyConfiguration struct {
+	v1.TypeMetaApplyConfiguration `json:",inline"`
+	// metadata is a standard object metadata
+	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
+	// spec defines the desired state of SwitchBGPPeer
+	Spec *SwitchBGPPeerSpecApplyConfiguration `json:"spec,omitempty"`
+	// status defines the observed state of SwitchBGPPeer
+	Status *SwitchBGPPeerStatusApplyConfiguration `json:"status,omitempty"`
+}
+
+// SwitchBGPPeer constructs a declarative configuration of the SwitchBGPPeer type for use with
+// apply.
+func SwitchBGPPeer(name string) *SwitchBGPPeerApplyConfiguration {
+	b := &SwitchBGPPeerApplyConfiguration{}
+	b.WithName(name)
+	b.WithKind("SwitchBGPPeer")
+	b.WithAPIVersion("sonic.networking.metal.ironcore.dev/v1alpha1")
+	return b
+}
+
+// ExtractSwitchBGPPeerFrom extracts the applied configuration owned by fieldManager from
+// switchBGPPeer for the specified subresource. Pass an empty string for subresource to extract
+// the main resource. Common subresources include "status", "scale", etc.
+// switchBGPPeer must be a unmodified SwitchBGPPeer API object that was retrieved from the Kubernetes API.
+// ExtractSwitchBGPPeerFrom provides a way to perform a extract/modify-in-place/apply workflow.
+// Note that an extracted apply configuration will contain fewer fields than what the fieldManager previously
+// applied if another fieldManager has updated or force applied any of the previously applied fields.
+func ExtractSwitchBGPPeerFrom(switchBGPPeer *apiv1alpha1.SwitchBGPPeer, fieldManager string, subresource string) (*SwitchBGPPeerApplyConfiguration, error) {
+	b := &SwitchBGPPeerApplyConfiguration{}
+	err := managedfields.ExtractInto(switchBGPPeer, internal.Parser().Type("com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchBGPPeer"), fieldManager, b, subresource)
+	if err != nil {
+		return nil, err
+	}
+	b.WithName(switchBGPPeer.Name)
+
+	b.WithKind("SwitchBGPPeer")
+	b.WithAPIVersion("sonic.networking.metal.ironcore.dev/v1alpha1")
+	return b, nil
+}
+
+// ExtractSwitchBGPPeer extracts the applied configuration owned by fieldManager from
+// switchBGPPeer. If no managedFields are found in switchBGPPeer for fieldManager, a
+// SwitchBGPPeerApplyConfiguration is returned with only the Name, Namespace (if applicable),
+// APIVersion and Kind populated. It is possible that no managed fields were found for because other
+// field managers have taken ownership of all the fields previously owned by fieldManager, or because
+// the fieldManager never owned fields any fields.
+// switchBGPPeer must be a unmodified SwitchBGPPeer API object that was retrieved from the Kubernetes API.
+// ExtractSwitchBGPPeer provides a way to perform a extract/modify-in-place/apply workflow.
+// Note that an extracted apply configuration will contain fewer fields than what the fieldManager previously
+// applied if another fieldManager has updated or force applied any of the previously applied fields.
+func ExtractSwitchBGPPeer(switchBGPPeer *apiv1alpha1.SwitchBGPPeer, fieldManager string) (*SwitchBGPPeerApplyConfiguration, error) {
+	return ExtractSwitchBGPPeerFrom(switchBGPPeer, fieldManager, "")
+}
+
+// ExtractSwitchBGPPeerStatus extracts the applied configuration owned by fieldManager from
+// switchBGPPeer for the status subresource.
+func ExtractSwitchBGPPeerStatus(switchBGPPeer *apiv1alpha1.SwitchBGPPeer, fieldManager string) (*SwitchBGPPeerApplyConfiguration, error) {
+	return ExtractSwitchBGPPeerFrom(switchBGPPeer, fieldManager, "status")
+}
+
+func (b SwitchBGPPeerApplyConfiguration) IsApplyConfiguration() {}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *SwitchBGPPeerApplyConfiguration) WithKind(value string) *SwitchBGPPeerApplyConfiguration {
+	b.TypeMetaApplyConfiguration.Kind = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *SwitchBGPPeerApplyConfiguration) WithAPIVersion(value string) *SwitchBGPPeerApplyConfiguration {
+	b.TypeMetaApplyConfiguration.APIVersion = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *SwitchBGPPeerApplyConfiguration) WithName(value string) *SwitchBGPPeerApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Name = &value
+	return b
+}
+
+// WithGenerateName sets the GenerateName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GenerateName field is set to the value of the last call.
+func (b *SwitchBGPPeerApplyConfiguration) WithGenerateName(value string) *SwitchBGPPeerApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.GenerateName = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *SwitchBGPPeerApplyConfiguration) WithNamespace(value string) *SwitchBGPPeerApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Namespace = &value
+	return b
+}
+
+// WithUID sets the UID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UID field is set to the value of the last call.
+func (b *SwitchBGPPeerApplyConfiguration) WithUID(value types.UID) *SwitchBGPPeerApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.UID = &value
+	return b
+}
+
+// WithResourceVersion sets the ResourceVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceVersion field is set to the value of the last call.
+func (b *SwitchBGPPeerApplyConfiguration) WithResourceVersion(value string) *SwitchBGPPeerApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.ResourceVersion = &value
+	return b
+}
+
+// WithGeneration sets the Generation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generation field is set to the value of the last call.
+func (b *SwitchBGPPeerApplyConfiguration) WithGeneration(value int64) *SwitchBGPPeerApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Generation = &value
+	return b
+}
+
+// WithCreationTimestamp sets the CreationTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CreationTimestamp field is set to the value of the last call.
+func (b *SwitchBGPPeerApplyConfiguration) WithCreationTimestamp(value metav1.Time) *SwitchBGPPeerApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.CreationTimestamp = &value
+	return b
+}
+
+// WithDeletionTimestamp sets the DeletionTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionTimestamp field is set to the value of the last call.
+func (b *SwitchBGPPeerApplyConfiguration) WithDeletionTimestamp(value metav1.Time) *SwitchBGPPeerApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.DeletionTimestamp = &value
+	return b
+}
+
+// WithDeletionGracePeriodSeconds sets the DeletionGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionGracePeriodSeconds field is set to the value of the last call.
+func (b *SwitchBGPPeerApplyConfiguration) WithDeletionGracePeriodSeconds(value int64) *SwitchBGPPeerApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.DeletionGracePeriodSeconds = &value
+	return b
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *SwitchBGPPeerApplyConfiguration) WithLabels(entries map[string]string) *SwitchBGPPeerApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.ObjectMetaApplyConfiguration.Labels == nil && len(entries) > 0 {
+		b.ObjectMetaApplyConfiguration.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ObjectMetaApplyConfiguration.Labels[k] = v
+	}
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *SwitchBGPPeerApplyConfiguration) WithAnnotations(entries map[string]string) *SwitchBGPPeerApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.ObjectMetaApplyConfiguration.Annotations == nil && len(entries) > 0 {
+		b.ObjectMetaApplyConfiguration.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ObjectMetaApplyConfiguration.Annotations[k] = v
+	}
+	return b
+}
+
+// WithOwnerReferences adds the given value to the OwnerReferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OwnerReferences field.
+func (b *SwitchBGPPeerApplyConfiguration) WithOwnerReferences(values ...*v1.OwnerReferenceApplyConfiguration) *SwitchBGPPeerApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithOwnerReferences")
+		}
+		b.ObjectMetaApplyConfiguration.OwnerReferences = append(b.ObjectMetaApplyConfiguration.OwnerReferences, *values[i])
+	}
+	return b
+}
+
+// WithFinalizers adds the given value to the Finalizers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Finalizers field.
+func (b *SwitchBGPPeerApplyConfiguration) WithFinalizers(values ...string) *SwitchBGPPeerApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		b.ObjectMetaApplyConfiguration.Finalizers = append(b.ObjectMetaApplyConfiguration.Finalizers, values[i])
+	}
+	return b
+}
+
+func (b *SwitchBGPPeerApplyConfiguration) ensureObjectMetaApplyConfigurationExists() {
+	if b.ObjectMetaApplyConfiguration == nil {
+		b.ObjectMetaApplyConfiguration = &v1.ObjectMetaApplyConfiguration{}
+	}
+}
+
+// WithSpec sets the Spec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Spec field is set to the value of the last call.
+func (b *SwitchBGPPeerApplyConfiguration) WithSpec(value *SwitchBGPPeerSpecApplyConfiguration) *SwitchBGPPeerApplyConfiguration {
+	b.Spec = value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *SwitchBGPPeerApplyConfiguration) WithStatus(value *SwitchBGPPeerStatusApplyConfiguration) *SwitchBGPPeerApplyConfiguration {
+	b.Status = value
+	return b
+}
+
+// GetKind retrieves the value of the Kind field in the declarative configuration.
+func (b *SwitchBGPPeerApplyConfiguration) GetKind() *string {
+	return b.TypeMetaApplyConfiguration.Kind
+}
+
+// GetAPIVersion retrieves the value of the APIVersion field in the declarative configuration.
+func (b *SwitchBGPPeerApplyConfiguration) GetAPIVersion() *string {
+	return b.TypeMetaApplyConfiguration.APIVersion
+}
+
+// GetName retrieves the value of the Name field in the declarative configuration.
+func (b *SwitchBGPPeerApplyConfiguration) GetName() *string {
+	b.ensureObjectMetaApplyConfigurationExists()
+	return b.ObjectMetaApplyConfiguration.Name
+}
+
+// GetNamespace retrieves the value of the Namespace field in the declarative configuration.
+func (b *SwitchBGPPeerApplyConfiguration) GetNamespace() *string {
+	b.ensureObjectMetaApplyConfigurationExists()
+	return b.ObjectMetaApplyConfiguration.Namespace
+}
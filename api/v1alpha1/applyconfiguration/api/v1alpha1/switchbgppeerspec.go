@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// SwitchBGPPeerSpecApplyConfiguration represents a declarative configuration of the SwitchBGPPeerSpec type for use
+// with apply.
+//
+// SwitchBGPPeerSpec defines the desired state of SwitchBGPPeer
+type SwitchBGPPeerSpecApplyConfiguration struct {
+	// SwitchRef is a reference to the Switch this BGP peer is configured on.
+	SwitchRef *v1.LocalObjectReference `json:"switchRef,omitempty"`
+	// InterfaceRef is a reference to the SwitchInterface the unnumbered BGP session is established over.
+	// The referenced SwitchInterface has to belong to the same Switch.
+	InterfaceRef *v1.LocalObjectReference `json:"interfaceRef,omitempty"`
+	// RemoteAS is the AS number of the peer, or "external"/"internal" to accept any eBGP/iBGP peer.
+	RemoteAS *string `json:"remoteAS,omitempty"`
+	// Description is a human readable name of the peer.
+	Description *string `json:"description,omitempty"`
+}
+
+// SwitchBGPPeerSpecApplyConfiguration constructs a declarative configuration of the SwitchBGPPeerSpec type for use with
+// apply.
+func SwitchBGPPeerSpec() *SwitchBGPPeerSpecApplyConfiguration {
+	return &SwitchBGPPeerSpecApplyConfiguration{}
+}
+
+// WithSwitchRef sets the SwitchRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SwitchRef field is set to the value of the last call.
+func (b *SwitchBGPPeerSpecApplyConfiguration) WithSwitchRef(value v1.LocalObjectReference) *SwitchBGPPeerSpecApplyConfiguration {
+	b.SwitchRef = &value
+	return b
+}
+
+// WithInterfaceRef sets the InterfaceRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InterfaceRef field is set to the value of the last call.
+func (b *SwitchBGPPeerSpecApplyConfiguration) WithInterfaceRef(value v1.LocalObjectReference) *SwitchBGPPeerSpecApplyConfiguration {
+	b.InterfaceRef = &value
+	return b
+}
+
+// WithRemoteAS sets the RemoteAS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemoteAS field is set to the value of the last call.
+func (b *SwitchBGPPeerSpecApplyConfiguration) WithRemoteAS(value string) *SwitchBGPPeerSpecApplyConfiguration {
+	b.RemoteAS = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *SwitchBGPPeerSpecApplyConfiguration) WithDescription(value string) *SwitchBGPPeerSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
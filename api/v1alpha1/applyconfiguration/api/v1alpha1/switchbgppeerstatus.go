@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// SwitchBGPPeerStatusApplyConfiguration represents a declarative configuration of the SwitchBGPPeerStatus type for use
+// with apply.
+//
+// SwitchBGPPeerStatus defines the observed state of SwitchBGPPeer.
+type SwitchBGPPeerStatusApplyConfiguration struct {
+	// State represents the high-level state of the SwitchBGPPeer.
+	State *apiv1alpha1.SwitchBGPPeerState `json:"state,omitempty"`
+	// NativeName is the native name of the interface the session is established over (e.g., "Ethernet0").
+	NativeName *string `json:"nativeName,omitempty"`
+	// SessionState is the observed BGP session state (e.g., "Established", "Active").
+	SessionState *string `json:"sessionState,omitempty"`
+	// PrefixesReceived is the number of prefixes received from the peer.
+	PrefixesReceived *int64 `json:"prefixesReceived,omitempty"`
+	// Uptime is the time since the session was established, as reported by FRR.
+	Uptime *string `json:"uptime,omitempty"`
+	// The status of each condition is one of True, False, or Unknown.
+	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// SwitchBGPPeerStatusApplyConfiguration constructs a declarative configuration of the SwitchBGPPeerStatus type for use with
+// apply.
+func SwitchBGPPeerStatus() *SwitchBGPPeerStatusApplyConfiguration {
+	return &SwitchBGPPeerStatusApplyConfiguration{}
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *SwitchBGPPeerStatusApplyConfiguration) WithState(value apiv1alpha1.SwitchBGPPeerState) *SwitchBGPPeerStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithNativeName sets the NativeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NativeName field is set to the value of the last call.
+func (b *SwitchBGPPeerStatusApplyConfiguration) WithNativeName(value string) *SwitchBGPPeerStatusApplyConfiguration {
+	b.NativeName = &value
+	return b
+}
+
+// WithSessionState sets the SessionState field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SessionState field is set to the value of the last call.
+func (b *SwitchBGPPeerStatusApplyConfiguration) WithSessionState(value string) *SwitchBGPPeerStatusApplyConfiguration {
+	b.SessionState = &value
+	return b
+}
+
+// WithPrefixesReceived sets the PrefixesReceived field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PrefixesReceived field is set to the value of the last call.
+func (b *SwitchBGPPeerStatusApplyConfiguration) WithPrefixesReceived(value int64) *SwitchBGPPeerStatusApplyConfiguration {
+	b.PrefixesReceived = &value
+	return b
+}
+
+// WithUptime sets the Uptime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Uptime field is set to the value of the last call.
+func (b *SwitchBGPPeerStatusApplyConfiguration) WithUptime(value string) *SwitchBGPPeerStatusApplyConfiguration {
+	b.Uptime = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *SwitchBGPPeerStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *SwitchBGPPeerStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -42,6 +42,8 @@ type SwitchCredentialsApplyConfiguration struct {
 	// Used to facilitate programmatic handling of secret data.
 	// More info: https://kubernetes.io/docs/concepts/configuration/secret/#secret-types
 	Type *corev1.SecretType `json:"type,omitempty"`
+	// Status defines the observed state of SwitchCredentials.
+	Status *SwitchCredentialsStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // SwitchCredentials constructs a declarative configuration of the SwitchCredentials type for use with
@@ -88,6 +90,12 @@ func ExtractSwitchCredentials(switchCredentials *apiv1alpha1.SwitchCredentials,
 	return ExtractSwitchCredentialsFrom(switchCredentials, fieldManager, "")
 }
 
+// ExtractSwitchCredentialsStatus extracts the applied configuration owned by fieldManager from
+// switchCredentials for the status subresource.
+func ExtractSwitchCredentialsStatus(switchCredentials *apiv1alpha1.SwitchCredentials, fieldManager string) (*SwitchCredentialsApplyConfiguration, error) {
+	return ExtractSwitchCredentialsFrom(switchCredentials, fieldManager, "status")
+}
+
 func (b SwitchCredentialsApplyConfiguration) IsApplyConfiguration() {}
 
 // WithKind sets the Kind field in the declarative configuration to the given value
@@ -292,6 +300,14 @@ func (b *SwitchCredentialsApplyConfiguration) WithType(value corev1.SecretType)
 	return b
 }
 
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *SwitchCredentialsApplyConfiguration) WithStatus(value *SwitchCredentialsStatusApplyConfiguration) *SwitchCredentialsApplyConfiguration {
+	b.Status = value
+	return b
+}
+
 // GetKind retrieves the value of the Kind field in the declarative configuration.
 func (b *SwitchCredentialsApplyConfiguration) GetKind() *string {
 	return b.TypeMetaApplyConfiguration.Kind
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// SwitchCredentialsStatusApplyConfiguration represents a declarative configuration of the SwitchCredentialsStatus type for use
+// with apply.
+//
+// SwitchCredentialsStatus defines the observed state of SwitchCredentials.
+type SwitchCredentialsStatusApplyConfiguration struct {
+	// The status of each condition is one of True, False, or Unknown.
+	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// SwitchCredentialsStatusApplyConfiguration constructs a declarative configuration of the SwitchCredentialsStatus type for use with
+// apply.
+func SwitchCredentialsStatus() *SwitchCredentialsStatusApplyConfiguration {
+	return &SwitchCredentialsStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *SwitchCredentialsStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *SwitchCredentialsStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -23,6 +23,19 @@ type SwitchInterfaceSpecApplyConfiguration struct {
 	SwitchRef *v1.LocalObjectReference `json:"switchRef,omitempty"`
 	// AdminState represents the desired administrative state of the interface.
 	AdminState *apiv1alpha1.AdminState `json:"adminState,omitempty"`
+	// Addresses are the IPv4/IPv6 addresses in CIDR notation assigned to the interface (e.g., "10.0.0.1/31").
+	Addresses []string `json:"addresses,omitempty"`
+	// MTU is the desired maximum transmission unit of the port.
+	MTU *int32 `json:"mtu,omitempty"`
+	// Speed is the desired speed of the port in Mbps (e.g., 100000).
+	Speed *int32 `json:"speed,omitempty"`
+	// FEC is the desired forward error correction mode of the port.
+	FEC *apiv1alpha1.FECMode `json:"fec,omitempty"`
+	// Autoneg enables or disables auto-negotiation on the port.
+	Autoneg *bool `json:"autoneg,omitempty"`
+	// DeletionPolicy defines what happens to the interface on the switch when the SwitchInterface is deleted.
+	// Defaults to the deletion policy of the Switch.
+	DeletionPolicy *apiv1alpha1.DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // SwitchInterfaceSpecApplyConfiguration constructs a declarative configuration of the SwitchInterfaceSpec type for use with
@@ -62,3 +75,53 @@ func (b *SwitchInterfaceSpecApplyConfiguration) WithAdminState(value apiv1alpha1
 	b.AdminState = &value
 	return b
 }
+
+// WithAddresses adds the given value to the Addresses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Addresses field.
+func (b *SwitchInterfaceSpecApplyConfiguration) WithAddresses(values ...string) *SwitchInterfaceSpecApplyConfiguration {
+	for i := range values {
+		b.Addresses = append(b.Addresses, values[i])
+	}
+	return b
+}
+
+// WithMTU sets the MTU field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MTU field is set to the value of the last call.
+func (b *SwitchInterfaceSpecApplyConfiguration) WithMTU(value int32) *SwitchInterfaceSpecApplyConfiguration {
+	b.MTU = &value
+	return b
+}
+
+// WithSpeed sets the Speed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Speed field is set to the value of the last call.
+func (b *SwitchInterfaceSpecApplyConfiguration) WithSpeed(value int32) *SwitchInterfaceSpecApplyConfiguration {
+	b.Speed = &value
+	return b
+}
+
+// WithFEC sets the FEC field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FEC field is set to the value of the last call.
+func (b *SwitchInterfaceSpecApplyConfiguration) WithFEC(value apiv1alpha1.FECMode) *SwitchInterfaceSpecApplyConfiguration {
+	b.FEC = &value
+	return b
+}
+
+// WithAutoneg sets the Autoneg field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoneg field is set to the value of the last call.
+func (b *SwitchInterfaceSpecApplyConfiguration) WithAutoneg(value bool) *SwitchInterfaceSpecApplyConfiguration {
+	b.Autoneg = &value
+	return b
+}
+
+// WithDeletionPolicy sets the DeletionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionPolicy field is set to the value of the last call.
+func (b *SwitchInterfaceSpecApplyConfiguration) WithDeletionPolicy(value apiv1alpha1.DeletionPolicy) *SwitchInterfaceSpecApplyConfiguration {
+	b.DeletionPolicy = &value
+	return b
+}
@@ -27,6 +27,21 @@ type SwitchInterfaceStatusApplyConfiguration struct {
 	MacAddress *string `json:"macAddress,omitempty"`
 	// AliasName is the alias name of the interface.
 	AliasName *string `json:"aliasName,omitempty"`
+	// DefaultAliasName is the alias name the interface had before it was set to the handle.
+	// It is restored by the ResetToDefault deletion policy.
+	DefaultAliasName *string `json:"defaultAliasName,omitempty"`
+	// Addresses are the IPv4/IPv6 addresses observed on the interface.
+	Addresses []string `json:"addresses,omitempty"`
+	// MTU is the operational maximum transmission unit of the port.
+	MTU *int32 `json:"mtu,omitempty"`
+	// Speed is the operational speed of the port in Mbps.
+	Speed *int32 `json:"speed,omitempty"`
+	// FEC is the operational forward error correction mode of the port.
+	FEC *apiv1alpha1.FECMode `json:"fec,omitempty"`
+	// Autoneg reports whether auto-negotiation is enabled on the port.
+	Autoneg *bool `json:"autoneg,omitempty"`
+	// Transceiver reports the transceiver inserted into the port, if any.
+	Transceiver *TransceiverStatusApplyConfiguration `json:"transceiver,omitempty"`
 	// The status of each condition is one of True, False, or Unknown.
 	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
@@ -85,6 +100,64 @@ func (b *SwitchInterfaceStatusApplyConfiguration) WithAliasName(value string) *S
 	return b
 }
 
+// WithDefaultAliasName sets the DefaultAliasName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultAliasName field is set to the value of the last call.
+func (b *SwitchInterfaceStatusApplyConfiguration) WithDefaultAliasName(value string) *SwitchInterfaceStatusApplyConfiguration {
+	b.DefaultAliasName = &value
+	return b
+}
+
+// WithAddresses adds the given value to the Addresses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Addresses field.
+func (b *SwitchInterfaceStatusApplyConfiguration) WithAddresses(values ...string) *SwitchInterfaceStatusApplyConfiguration {
+	for i := range values {
+		b.Addresses = append(b.Addresses, values[i])
+	}
+	return b
+}
+
+// WithMTU sets the MTU field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MTU field is set to the value of the last call.
+func (b *SwitchInterfaceStatusApplyConfiguration) WithMTU(value int32) *SwitchInterfaceStatusApplyConfiguration {
+	b.MTU = &value
+	return b
+}
+
+// WithSpeed sets the Speed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Speed field is set to the value of the last call.
+func (b *SwitchInterfaceStatusApplyConfiguration) WithSpeed(value int32) *SwitchInterfaceStatusApplyConfiguration {
+	b.Speed = &value
+	return b
+}
+
+// WithFEC sets the FEC field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FEC field is set to the value of the last call.
+func (b *SwitchInterfaceStatusApplyConfiguration) WithFEC(value apiv1alpha1.FECMode) *SwitchInterfaceStatusApplyConfiguration {
+	b.FEC = &value
+	return b
+}
+
+// WithAutoneg sets the Autoneg field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoneg field is set to the value of the last call.
+func (b *SwitchInterfaceStatusApplyConfiguration) WithAutoneg(value bool) *SwitchInterfaceStatusApplyConfiguration {
+	b.Autoneg = &value
+	return b
+}
+
+// WithTransceiver sets the Transceiver field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Transceiver field is set to the value of the last call.
+func (b *SwitchInterfaceStatusApplyConfiguration) WithTransceiver(value *TransceiverStatusApplyConfiguration) *SwitchInterfaceStatusApplyConfiguration {
+	b.Transceiver = value
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
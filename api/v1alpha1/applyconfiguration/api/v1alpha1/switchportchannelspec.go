@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// SwitchPortChannelSpecApplyConfiguration represents a declarative configuration of the SwitchPortChannelSpec type for use
+// with apply.
+//
+// SwitchPortChannelSpec defines the desired state of SwitchPortChannel
+type SwitchPortChannelSpecApplyConfiguration struct {
+	// NativeName is the native name of the port channel on the switch (e.g., "PortChannel1").
+	NativeName *string `json:"nativeName,omitempty"`
+	// SwitchRef is a reference to the Switch this port channel is configured on.
+	SwitchRef *v1.LocalObjectReference `json:"switchRef,omitempty"`
+	// MemberRefs are references to the SwitchInterfaces bundled into this port channel.
+	// The referenced SwitchInterfaces have to belong to the same Switch.
+	MemberRefs []v1.LocalObjectReference `json:"memberRefs,omitempty"`
+}
+
+// SwitchPortChannelSpecApplyConfiguration constructs a declarative configuration of the SwitchPortChannelSpec type for use with
+// apply.
+func SwitchPortChannelSpec() *SwitchPortChannelSpecApplyConfiguration {
+	return &SwitchPortChannelSpecApplyConfiguration{}
+}
+
+// WithNativeName sets the NativeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NativeName field is set to the value of the last call.
+func (b *SwitchPortChannelSpecApplyConfiguration) WithNativeName(value string) *SwitchPortChannelSpecApplyConfiguration {
+	b.NativeName = &value
+	return b
+}
+
+// WithSwitchRef sets the SwitchRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SwitchRef field is set to the value of the last call.
+func (b *SwitchPortChannelSpecApplyConfiguration) WithSwitchRef(value v1.LocalObjectReference) *SwitchPortChannelSpecApplyConfiguration {
+	b.SwitchRef = &value
+	return b
+}
+
+// WithMemberRefs adds the given value to the MemberRefs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MemberRefs field.
+func (b *SwitchPortChannelSpecApplyConfiguration) WithMemberRefs(values ...v1.LocalObjectReference) *SwitchPortChannelSpecApplyConfiguration {
+	for i := range values {
+		b.MemberRefs = append(b.MemberRefs, values[i])
+	}
+	return b
+}
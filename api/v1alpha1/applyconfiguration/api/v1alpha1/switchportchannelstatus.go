@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// SwitchPortChannelStatusApplyConfiguration represents a declarative configuration of the SwitchPortChannelStatus type for use
+// with apply.
+//
+// SwitchPortChannelStatus defines the observed state of SwitchPortChannel.
+type SwitchPortChannelStatusApplyConfiguration struct {
+	// AdminState represents the actual administrative state of the port channel.
+	AdminState *apiv1alpha1.AdminState `json:"adminState,omitempty"`
+	// OperationalState represents the actual operational state of the port channel.
+	OperationalState *apiv1alpha1.OperationState `json:"operationalState,omitempty"`
+	// State represents the high-level state of the SwitchPortChannel.
+	State *apiv1alpha1.SwitchPortChannelState `json:"state,omitempty"`
+	// Members reports the observed state of each port channel member.
+	Members []PortChannelMemberStatusApplyConfiguration `json:"members,omitempty"`
+	// The status of each condition is one of True, False, or Unknown.
+	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// SwitchPortChannelStatusApplyConfiguration constructs a declarative configuration of the SwitchPortChannelStatus type for use with
+// apply.
+func SwitchPortChannelStatus() *SwitchPortChannelStatusApplyConfiguration {
+	return &SwitchPortChannelStatusApplyConfiguration{}
+}
+
+// WithAdminState sets the AdminState field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AdminState field is set to the value of the last call.
+func (b *SwitchPortChannelStatusApplyConfiguration) WithAdminState(value apiv1alpha1.AdminState) *SwitchPortChannelStatusApplyConfiguration {
+	b.AdminState = &value
+	return b
+}
+
+// WithOperationalState sets the OperationalState field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OperationalState field is set to the value of the last call.
+func (b *SwitchPortChannelStatusApplyConfiguration) WithOperationalState(value apiv1alpha1.OperationState) *SwitchPortChannelStatusApplyConfiguration {
+	b.OperationalState = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *SwitchPortChannelStatusApplyConfiguration) WithState(value apiv1alpha1.SwitchPortChannelState) *SwitchPortChannelStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithMembers adds the given value to the Members field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Members field.
+func (b *SwitchPortChannelStatusApplyConfiguration) WithMembers(values ...*PortChannelMemberStatusApplyConfiguration) *SwitchPortChannelStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMembers")
+		}
+		b.Members = append(b.Members, *values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *SwitchPortChannelStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *SwitchPortChannelStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
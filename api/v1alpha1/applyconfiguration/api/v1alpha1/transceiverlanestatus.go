@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// TransceiverLaneStatusApplyConfiguration represents a declarative configuration of the TransceiverLaneStatus type for use
+// with apply.
+//
+// TransceiverLaneStatus reports the DOM readings of a single transceiver lane.
+type TransceiverLaneStatusApplyConfiguration struct {
+	// Lane is the number of the lane, starting at 1.
+	Lane *int32 `json:"lane,omitempty"`
+	// RxPower is the received optical power in dBm.
+	RxPower *string `json:"rxPower,omitempty"`
+	// TxPower is the transmitted optical power in dBm.
+	TxPower *string `json:"txPower,omitempty"`
+}
+
+// TransceiverLaneStatusApplyConfiguration constructs a declarative configuration of the TransceiverLaneStatus type for use with
+// apply.
+func TransceiverLaneStatus() *TransceiverLaneStatusApplyConfiguration {
+	return &TransceiverLaneStatusApplyConfiguration{}
+}
+
+// WithLane sets the Lane field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Lane field is set to the value of the last call.
+func (b *TransceiverLaneStatusApplyConfiguration) WithLane(value int32) *TransceiverLaneStatusApplyConfiguration {
+	b.Lane = &value
+	return b
+}
+
+// WithRxPower sets the RxPower field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RxPower field is set to the value of the last call.
+func (b *TransceiverLaneStatusApplyConfiguration) WithRxPower(value string) *TransceiverLaneStatusApplyConfiguration {
+	b.RxPower = &value
+	return b
+}
+
+// WithTxPower sets the TxPower field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TxPower field is set to the value of the last call.
+func (b *TransceiverLaneStatusApplyConfiguration) WithTxPower(value string) *TransceiverLaneStatusApplyConfiguration {
+	b.TxPower = &value
+	return b
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// TransceiverStatusApplyConfiguration represents a declarative configuration of the TransceiverStatus type for use
+// with apply.
+//
+// TransceiverStatus reports the inventory and DOM readings of a transceiver.
+type TransceiverStatusApplyConfiguration struct {
+	// Vendor is the manufacturer of the transceiver.
+	Vendor *string `json:"vendor,omitempty"`
+	// PartNumber is the part number of the transceiver.
+	PartNumber *string `json:"partNumber,omitempty"`
+	// SerialNumber is the serial number of the transceiver.
+	SerialNumber *string `json:"serialNumber,omitempty"`
+	// Type is the form factor of the transceiver (e.g., "QSFP28 or later").
+	Type *string `json:"type,omitempty"`
+	// Temperature is the module temperature in degrees Celsius.
+	Temperature *string `json:"temperature,omitempty"`
+	// Voltage is the module supply voltage in volts.
+	Voltage *string `json:"voltage,omitempty"`
+	// Lanes reports the DOM readings of each lane.
+	Lanes []TransceiverLaneStatusApplyConfiguration `json:"lanes,omitempty"`
+}
+
+// TransceiverStatusApplyConfiguration constructs a declarative configuration of the TransceiverStatus type for use with
+// apply.
+func TransceiverStatus() *TransceiverStatusApplyConfiguration {
+	return &TransceiverStatusApplyConfiguration{}
+}
+
+// WithVendor sets the Vendor field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Vendor field is set to the value of the last call.
+func (b *TransceiverStatusApplyConfiguration) WithVendor(value string) *TransceiverStatusApplyConfiguration {
+	b.Vendor = &value
+	return b
+}
+
+// WithPartNumber sets the PartNumber field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PartNumber field is set to the value of the last call.
+func (b *TransceiverStatusApplyConfiguration) WithPartNumber(value string) *TransceiverStatusApplyConfiguration {
+	b.PartNumber = &value
+	return b
+}
+
+// WithSerialNumber sets the SerialNumber field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SerialNumber field is set to the value of the last call.
+func (b *TransceiverStatusApplyConfiguration) WithSerialNumber(value string) *TransceiverStatusApplyConfiguration {
+	b.SerialNumber = &value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *TransceiverStatusApplyConfiguration) WithType(value string) *TransceiverStatusApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithTemperature sets the Temperature field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Temperature field is set to the value of the last call.
+func (b *TransceiverStatusApplyConfiguration) WithTemperature(value string) *TransceiverStatusApplyConfiguration {
+	b.Temperature = &value
+	return b
+}
+
+// WithVoltage sets the Voltage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Voltage field is set to the value of the last call.
+func (b *TransceiverStatusApplyConfiguration) WithVoltage(value string) *TransceiverStatusApplyConfiguration {
+	b.Voltage = &value
+	return b
+}
+
+// WithLanes adds the given value to the Lanes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Lanes field.
+func (b *TransceiverStatusApplyConfiguration) WithLanes(values ...*TransceiverLaneStatusApplyConfiguration) *TransceiverStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithLanes")
+		}
+		b.Lanes = append(b.Lanes, *values[i])
+	}
+	return b
+}
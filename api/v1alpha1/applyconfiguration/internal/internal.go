@@ -28,6 +28,10 @@ var parser *typed.Parser
 var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.AdminState
   scalar: string
+- name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.DeletionPolicy
+  scalar: string
+- name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.FECMode
+  scalar: string
 - name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.Neighbor
   map:
     fields:
@@ -42,6 +46,79 @@ var schemaYAML = typed.YAMLObject(`types:
         scalar: string
 - name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.OperationState
   scalar: string
+- name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.PortChannelMemberStatus
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+    - name: nativeName
+      type:
+        scalar: string
+    - name: selected
+      type:
+        scalar: boolean
+- name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchBGPPeer
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+    - name: spec
+      type:
+        namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchBGPPeerSpec
+    - name: status
+      type:
+        namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchBGPPeerStatus
+- name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchBGPPeerSpec
+  map:
+    fields:
+    - name: description
+      type:
+        scalar: string
+    - name: interfaceRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+    - name: remoteAS
+      type:
+        scalar: string
+    - name: switchRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+- name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchBGPPeerState
+  scalar: string
+- name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchBGPPeerStatus
+  map:
+    fields:
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: associative
+          keys:
+          - type
+    - name: nativeName
+      type:
+        scalar: string
+    - name: prefixesReceived
+      type:
+        scalar: numeric
+    - name: sessionState
+      type:
+        scalar: string
+    - name: state
+      type:
+        namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchBGPPeerState
+    - name: uptime
+      type:
+        scalar: string
 - name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchCredentials
   map:
     fields:
@@ -62,6 +139,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: metadata
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+    - name: status
+      type:
+        namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchCredentialsStatus
     - name: stringData
       type:
         map:
@@ -70,6 +150,17 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: type
       type:
         namedType: io.k8s.api.core.v1.SecretType
+- name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchCredentialsStatus
+  map:
+    fields:
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: associative
+          keys:
+          - type
 - name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchInterface
   map:
     fields:
@@ -91,15 +182,36 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchInterfaceSpec
   map:
     fields:
+    - name: addresses
+      type:
+        list:
+          elementType:
+            scalar: untyped
+          elementRelationship: associative
     - name: adminState
       type:
         namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.AdminState
+    - name: autoneg
+      type:
+        scalar: boolean
+    - name: deletionPolicy
+      type:
+        namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.DeletionPolicy
+    - name: fec
+      type:
+        namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.FECMode
     - name: handle
       type:
         scalar: string
+    - name: mtu
+      type:
+        scalar: numeric
     - name: nativeName
       type:
         scalar: string
+    - name: speed
+      type:
+        scalar: numeric
     - name: switchRef
       type:
         namedType: io.k8s.api.core.v1.LocalObjectReference
@@ -108,12 +220,21 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchInterfaceStatus
   map:
     fields:
+    - name: addresses
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
     - name: adminState
       type:
         namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.AdminState
     - name: aliasName
       type:
         scalar: string
+    - name: autoneg
+      type:
+        scalar: boolean
     - name: conditions
       type:
         list:
@@ -122,18 +243,133 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: defaultAliasName
+      type:
+        scalar: string
+    - name: fec
+      type:
+        namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.FECMode
     - name: macAddress
       type:
         scalar: string
+    - name: mtu
+      type:
+        scalar: numeric
     - name: neighbor
       type:
         namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.Neighbor
     - name: operationalState
       type:
         namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.OperationState
+    - name: speed
+      type:
+        scalar: numeric
     - name: state
       type:
         namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchInterfaceState
+    - name: transceiver
+      type:
+        namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.TransceiverStatus
+- name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchPortChannel
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+    - name: spec
+      type:
+        namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchPortChannelSpec
+    - name: status
+      type:
+        namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchPortChannelStatus
+- name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchPortChannelSpec
+  map:
+    fields:
+    - name: memberRefs
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.LocalObjectReference
+          elementRelationship: atomic
+    - name: nativeName
+      type:
+        scalar: string
+    - name: switchRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+- name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchPortChannelState
+  scalar: string
+- name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchPortChannelStatus
+  map:
+    fields:
+    - name: adminState
+      type:
+        namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.AdminState
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: associative
+          keys:
+          - type
+    - name: members
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.PortChannelMemberStatus
+          elementRelationship: atomic
+    - name: operationalState
+      type:
+        namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.OperationState
+    - name: state
+      type:
+        namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.SwitchPortChannelState
+- name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.TransceiverLaneStatus
+  map:
+    fields:
+    - name: lane
+      type:
+        scalar: numeric
+    - name: rxPower
+      type:
+        scalar: string
+    - name: txPower
+      type:
+        scalar: string
+- name: com.github.ironcore-dev.sonic-operator.api.v1alpha1.TransceiverStatus
+  map:
+    fields:
+    - name: lanes
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.sonic-operator.api.v1alpha1.TransceiverLaneStatus
+          elementRelationship: atomic
+    - name: partNumber
+      type:
+        scalar: string
+    - name: serialNumber
+      type:
+        scalar: string
+    - name: temperature
+      type:
+        scalar: string
+    - name: type
+      type:
+        scalar: string
+    - name: vendor
+      type:
+        scalar: string
+    - name: voltage
+      type:
+        scalar: string
 - name: io.k8s.api.core.v1.LocalObjectReference
   map:
     fields:
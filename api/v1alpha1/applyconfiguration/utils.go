@@ -21,14 +21,34 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 	// Group=sonic.networking.metal.ironcore.dev, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithKind("Neighbor"):
 		return &apiv1alpha1.NeighborApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PortChannelMemberStatus"):
+		return &apiv1alpha1.PortChannelMemberStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SwitchBGPPeer"):
+		return &apiv1alpha1.SwitchBGPPeerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SwitchBGPPeerSpec"):
+		return &apiv1alpha1.SwitchBGPPeerSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SwitchBGPPeerStatus"):
+		return &apiv1alpha1.SwitchBGPPeerStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SwitchCredentials"):
 		return &apiv1alpha1.SwitchCredentialsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SwitchCredentialsStatus"):
+		return &apiv1alpha1.SwitchCredentialsStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SwitchInterface"):
 		return &apiv1alpha1.SwitchInterfaceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SwitchInterfaceSpec"):
 		return &apiv1alpha1.SwitchInterfaceSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SwitchInterfaceStatus"):
 		return &apiv1alpha1.SwitchInterfaceStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SwitchPortChannel"):
+		return &apiv1alpha1.SwitchPortChannelApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SwitchPortChannelSpec"):
+		return &apiv1alpha1.SwitchPortChannelSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SwitchPortChannelStatus"):
+		return &apiv1alpha1.SwitchPortChannelStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TransceiverLaneStatus"):
+		return &apiv1alpha1.TransceiverLaneStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TransceiverStatus"):
+		return &apiv1alpha1.TransceiverStatusApplyConfiguration{}
 
 	}
 	return nil
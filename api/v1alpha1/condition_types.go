@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+// Conditions shared by all resources managed through a switch agent.
+const (
+	// ConditionReady reports whether the last reconciliation of the resource succeeded.
+	ConditionReady = "Ready"
+	// ConditionAgentReachable reports whether the switch agent could be reached during the last reconciliation.
+	ConditionAgentReachable = "AgentReachable"
+
+	// ReasonReconciled is used when the resource was reconciled successfully.
+	ReasonReconciled = "Reconciled"
+	// ReasonReconcileFailed is used when the reconciliation failed for a reason other than an agent error.
+	ReasonReconcileFailed = "ReconcileFailed"
+	// ReasonAgentReachable is used when the switch agent responded.
+	ReasonAgentReachable = "AgentReachable"
+	// ReasonAgentUnreachable is used when the switch agent is unavailable or did not respond in time.
+	ReasonAgentUnreachable = "AgentUnreachable"
+)
@@ -13,8 +13,72 @@ var (
 	SwitchFinalizer = "sonic.networking.metal.ironcore.dev/sonic-operator"
 )
 
+const (
+	// SwitchOrphanGracePeriodAnnotation sets the duration (e.g., "10m") a SwitchInterface may be missing on the
+	// switch before it is deleted. It protects against interfaces missing temporarily, e.g., during a restart.
+	SwitchOrphanGracePeriodAnnotation = "sonic.networking.metal.ironcore.dev/orphan-grace-period"
+	// SwitchInterfaceOrphanedSinceAnnotation marks a SwitchInterface whose interface no longer exists on the
+	// switch with the time (RFC 3339) it was first found missing.
+	SwitchInterfaceOrphanedSinceAnnotation = "sonic.networking.metal.ironcore.dev/orphaned-since"
+)
+
 type PortSpec struct {
+	// Name is the native name of the physical port on the switch (e.g., "Ethernet0").
 	Name string `json:"name"`
+
+	// BreakoutMode is the breakout mode of the port as defined by the platform (e.g., "4x25G[10G]").
+	// If empty, the current breakout mode of the port is left untouched.
+	// +optional
+	BreakoutMode string `json:"breakoutMode,omitempty"`
+}
+
+// DeletionPolicy defines what happens to the configuration of an interface on the switch when its
+// SwitchInterface is deleted.
+// +kubebuilder:validation:Enum=Retain;AdminDown;ResetToDefault
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyRetain leaves the interface configuration untouched.
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+	// DeletionPolicyAdminDown shuts the interface down.
+	DeletionPolicyAdminDown DeletionPolicy = "AdminDown"
+	// DeletionPolicyResetToDefault shuts the interface down and restores its default alias and MTU.
+	DeletionPolicyResetToDefault DeletionPolicy = "ResetToDefault"
+)
+
+// ProvisioningSpec defines the parameters used to render the ZTP script of a Switch.
+type ProvisioningSpec struct {
+	// Type is the role of the switch and selects the ZTP template (e.g., "leaf", "spine" or a type
+	// with a user-supplied template like "border-leaf").
+	// +kubebuilder:validation:MinLength=1
+	Type string `json:"type"`
+
+	// ID is the number of the switch within its type, e.g., 2 for spine-2.
+	// +kubebuilder:validation:Minimum=0
+	ID int32 `json:"id"`
+
+	// Prefix is the /64 prefix of the switch (e.g., "2001:db8::/64").
+	// +kubebuilder:validation:Format=cidr
+	Prefix string `json:"prefix"`
+
+	// LoopbackIP is the loopback address of the switch in CIDR notation, usually the first /128 of
+	// the prefix (e.g., "2001:db8::/128").
+	// +kubebuilder:validation:Format=cidr
+	LoopbackIP string `json:"loopbackIP"`
+
+	// ASNumber is the autonomous system number of the switch.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	ASNumber int64 `json:"asNumber"`
+
+	// SerialNumber is the serial number of the switch as reported by ONIE. If set, it identifies
+	// the switch when it requests the ZTP script, regardless of its address.
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// HWSKU selects a HWSKU specific ZTP template, which takes precedence over the template of the
+	// type. Defaults to the HWSKU observed in status.sku.
+	// +optional
+	HWSKU string `json:"hwsku,omitempty"`
 }
 
 type Management struct {
@@ -32,6 +96,24 @@ type SwitchSpec struct {
 
 	// Ports the physical ports available on the Switch.
 	Ports []PortSpec `json:"ports,omitempty"`
+
+	// DeletionPolicy is the default deletion policy of the SwitchInterfaces of the Switch. Deleting the
+	// Switch deletes its SwitchInterfaces first, so the policy is also applied when the Switch is deleted.
+	// Defaults to Retain.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// PollInterval is the interval in which the state of the Switch, its interfaces, port channels and
+	// BGP peers is read from the switch agent. If unset, the resync interval of the respective controller
+	// is used.
+	// +optional
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+
+	// Provisioning defines the parameters of the ZTP script served to the Switch. The Switch is
+	// identified by provisioning.serialNumber, macAddress or its management host, in this order,
+	// when it requests the script.
+	// +optional
+	Provisioning *ProvisioningSpec `json:"provisioning,omitempty"`
 }
 
 // SwitchState represents the high-level state of the Switch.
@@ -43,14 +125,116 @@ const (
 	SwitchStateFailed  SwitchState = "Failed"
 )
 
+const (
+	// SwitchConditionPowerRedundant reports whether at least two PSUs are present and healthy.
+	SwitchConditionPowerRedundant = "PowerRedundant"
+	// SwitchConditionFansHealthy reports whether all fans are present and running within their speed range.
+	SwitchConditionFansHealthy = "FansHealthy"
+	// SwitchConditionThermalOK reports whether no temperature sensor exceeds its high threshold.
+	SwitchConditionThermalOK = "ThermalOK"
+
+	// SwitchConditionConnected reports whether the gRPC connection to the switch agent is ready.
+	// The reason reflects the connection state: Idle, Connecting, Ready, TransientFailure or Shutdown.
+	SwitchConditionConnected = "Connected"
+
+	// SwitchConditionReachable reports whether the switch agent could be reached during the last reconciliation.
+	// It is set together with ConditionAgentReachable and kept for existing consumers.
+	SwitchConditionReachable = "Reachable"
+
+	// SwitchReasonAgentReachable is used when the switch agent responded.
+	SwitchReasonAgentReachable = ReasonAgentReachable
+	// SwitchReasonAgentUnreachable is used when the switch agent is unavailable or did not respond in time.
+	SwitchReasonAgentUnreachable = ReasonAgentUnreachable
+
+	// SwitchConditionPortsMatched reports whether the ports of the switch match spec.ports.
+	SwitchConditionPortsMatched = "PortsMatched"
+
+	// SwitchReasonPortsMatched is used when all declared ports exist and no undeclared port exists.
+	SwitchReasonPortsMatched = "PortsMatched"
+	// SwitchReasonPortsDrifted is used when declared ports are missing or undeclared ports exist.
+	SwitchReasonPortsDrifted = "PortsDrifted"
+	// SwitchReasonPortsNotDeclared is used when spec.ports is empty.
+	SwitchReasonPortsNotDeclared = "PortsNotDeclared"
+
+	// SwitchConditionDiscovered reports whether the device information of the Switch was read from the agent.
+	SwitchConditionDiscovered = "Discovered"
+
+	// SwitchReasonNotReported is used when the platform does not report the respective components.
+	SwitchReasonNotReported = "NotReported"
+	// SwitchReasonHealthy is used when all components of a kind are healthy.
+	SwitchReasonHealthy = "Healthy"
+	// SwitchReasonUnhealthy is used when at least one component of a kind is unhealthy.
+	SwitchReasonUnhealthy = "Unhealthy"
+)
+
 // PortStatus defines the observed state of a port on the Switch.
 type PortStatus struct {
 	// Name is the name of the port.
 	Name string `json:"name"`
-	// InterfaceRefs lists the references to Interfaces connected to this port.
+	// BreakoutMode is the breakout mode currently applied to the port.
+	BreakoutMode string `json:"breakoutMode,omitempty"`
+	// InterfaceRefs lists the references to Interfaces connected to this port, ordered by the
+	// port of the interface and then by name.
 	InterfaceRefs []v1.LocalObjectReference `json:"interfaceRefs,omitempty"`
 }
 
+// ProvisioningPhase is the last reported step of the provisioning of a Switch.
+type ProvisioningPhase string
+
+const (
+	// ProvisioningPhaseImageServed is set when ONIE downloaded an updater or installer image.
+	ProvisioningPhaseImageServed ProvisioningPhase = "ImageServed"
+	// ProvisioningPhaseScriptServed is set when the ZTP script was served to the switch.
+	ProvisioningPhaseScriptServed ProvisioningPhase = "ScriptServed"
+	// ProvisioningPhaseStage1Started is set when stage 1 of the ZTP script started.
+	ProvisioningPhaseStage1Started ProvisioningPhase = "Stage1Started"
+	// ProvisioningPhaseStage1Done is set when stage 1 of the ZTP script finished and the switch reboots.
+	ProvisioningPhaseStage1Done ProvisioningPhase = "Stage1Done"
+	// ProvisioningPhaseStage2Done is set when stage 2 of the ZTP script finished configuring the switch.
+	ProvisioningPhaseStage2Done ProvisioningPhase = "Stage2Done"
+	// ProvisioningPhaseAgentStarted is set when the ZTP script started the switch agent.
+	ProvisioningPhaseAgentStarted ProvisioningPhase = "AgentStarted"
+)
+
+// ProvisioningStatus defines the observed progress of the ONIE and ZTP provisioning of a Switch.
+type ProvisioningStatus struct {
+	// Phase is the last reported provisioning step.
+	// +optional
+	Phase ProvisioningPhase `json:"phase,omitempty"`
+
+	// LastUpdateTime is the time Phase was last reported.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// Image is the name of the last image served by ONIE.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// ImageServedTime is the time the last image was served by ONIE.
+	// +optional
+	ImageServedTime *metav1.Time `json:"imageServedTime,omitempty"`
+
+	// ScriptServedTime is the time the ZTP script was last served.
+	// +optional
+	ScriptServedTime *metav1.Time `json:"scriptServedTime,omitempty"`
+
+	// Stage1StartedTime is the time stage 1 of the ZTP script last started.
+	// +optional
+	Stage1StartedTime *metav1.Time `json:"stage1StartedTime,omitempty"`
+
+	// Stage1DoneTime is the time stage 1 of the ZTP script last finished.
+	// +optional
+	Stage1DoneTime *metav1.Time `json:"stage1DoneTime,omitempty"`
+
+	// Stage2DoneTime is the time stage 2 of the ZTP script last finished.
+	// +optional
+	Stage2DoneTime *metav1.Time `json:"stage2DoneTime,omitempty"`
+
+	// AgentStartedTime is the time the ZTP script last started the switch agent.
+	// +optional
+	AgentStartedTime *metav1.Time `json:"agentStartedTime,omitempty"`
+}
+
 // SwitchStatus defines the observed state of Switch.
 type SwitchStatus struct {
 	// State represents the high-level state of the Switch.
@@ -70,6 +254,13 @@ type SwitchStatus struct {
 	// SKU is the stock keeping unit of this switch.
 	SKU string `json:"sku,omitempty"`
 
+	// Provisioning is the progress of the ONIE and ZTP provisioning, as reported by the provisioning
+	// server. Serving an image starts a new run and clears the timestamps of the ZTP script and its
+	// stages. Serving the script clears the timestamps of the stages only if the previous run finished
+	// or never started, so the download after the reboot between stage 1 and 2 keeps them.
+	// +optional
+	Provisioning *ProvisioningStatus `json:"provisioning,omitempty"`
+
 	// The status of each condition is one of True, False, or Unknown.
 	// +listType=map
 	// +listMapKey=type
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SwitchBGPPeerSpec defines the desired state of SwitchBGPPeer
+type SwitchBGPPeerSpec struct {
+	// SwitchRef is a reference to the Switch this BGP peer is configured on.
+	// +required
+	SwitchRef *v1.LocalObjectReference `json:"switchRef"`
+
+	// InterfaceRef is a reference to the SwitchInterface the unnumbered BGP session is established over.
+	// The referenced SwitchInterface has to belong to the same Switch.
+	// +required
+	InterfaceRef *v1.LocalObjectReference `json:"interfaceRef"`
+
+	// RemoteAS is the AS number of the peer, or "external"/"internal" to accept any eBGP/iBGP peer.
+	// +required
+	// +kubebuilder:validation:Pattern=`^([0-9]{1,10}|external|internal)$`
+	RemoteAS string `json:"remoteAS"`
+
+	// Description is a human readable name of the peer.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+type SwitchBGPPeerState string
+
+const (
+	SwitchBGPPeerStatePending SwitchBGPPeerState = "Pending"
+	SwitchBGPPeerStateReady   SwitchBGPPeerState = "Ready"
+	SwitchBGPPeerStateFailed  SwitchBGPPeerState = "Failed"
+)
+
+// SwitchBGPPeerStatus defines the observed state of SwitchBGPPeer.
+type SwitchBGPPeerStatus struct {
+	// State represents the high-level state of the SwitchBGPPeer.
+	// +optional
+	State SwitchBGPPeerState `json:"state,omitempty"`
+
+	// NativeName is the native name of the interface the session is established over (e.g., "Ethernet0").
+	// +optional
+	NativeName string `json:"nativeName,omitempty"`
+
+	// SessionState is the observed BGP session state (e.g., "Established", "Active").
+	// +optional
+	SessionState string `json:"sessionState,omitempty"`
+
+	// PrefixesReceived is the number of prefixes received from the peer.
+	// +optional
+	PrefixesReceived int64 `json:"prefixesReceived,omitempty"`
+
+	// Uptime is the time since the session was established, as reported by FRR.
+	// +optional
+	Uptime string `json:"uptime,omitempty"`
+
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Switch",type=string,JSONPath=`.spec.switchRef.name`
+// +kubebuilder:printcolumn:name="Interface",type=string,JSONPath=`.status.nativeName`
+// +kubebuilder:printcolumn:name="RemoteAS",type=string,JSONPath=`.spec.remoteAS`
+// +kubebuilder:printcolumn:name="SessionState",type=string,JSONPath=`.status.sessionState`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// SwitchBGPPeer is the Schema for the switchbgppeers API
+type SwitchBGPPeer struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the desired state of SwitchBGPPeer
+	// +required
+	Spec SwitchBGPPeerSpec `json:"spec"`
+
+	// status defines the observed state of SwitchBGPPeer
+	// +optional
+	Status SwitchBGPPeerStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// SwitchBGPPeerList contains a list of SwitchBGPPeer
+type SwitchBGPPeerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SwitchBGPPeer `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(func(s *runtime.Scheme) error {
+		s.AddKnownTypes(GroupVersion, &SwitchBGPPeer{}, &SwitchBGPPeerList{})
+		return nil
+	})
+}
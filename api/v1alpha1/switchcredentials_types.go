@@ -9,9 +9,31 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+const (
+	// SwitchCredentialsConditionValid reports whether the credentials contain all required keys with valid data.
+	SwitchCredentialsConditionValid = "Valid"
+
+	// SwitchCredentialsReasonValid is used when all required keys are present and valid.
+	SwitchCredentialsReasonValid = "Valid"
+	// SwitchCredentialsReasonMissingKeys is used when at least one required key is missing.
+	SwitchCredentialsReasonMissingKeys = "MissingKeys"
+	// SwitchCredentialsReasonInvalidData is used when the certificates, the key or the token cannot be used.
+	SwitchCredentialsReasonInvalidData = "InvalidData"
+)
+
+// SwitchCredentialsStatus defines the observed state of SwitchCredentials.
+type SwitchCredentialsStatus struct {
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Valid",type=string,JSONPath=`.status.conditions[?(@.type=="Valid")].status`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // SwitchCredentials is the Schema for the switchcredentials API
@@ -48,6 +70,10 @@ type SwitchCredentials struct {
 	// More info: https://kubernetes.io/docs/concepts/configuration/secret/#secret-types
 	// +optional
 	Type v1.SecretType `json:"type,omitempty" protobuf:"bytes,3,opt,name=type,casttype=SecretType"`
+
+	// Status defines the observed state of SwitchCredentials.
+	// +optional
+	Status SwitchCredentialsStatus `json:"status,omitempty,omitzero"`
 }
 
 // +kubebuilder:object:root=true
@@ -17,6 +17,17 @@ const (
 	AdminStateDown    AdminState = "Down"
 )
 
+// FECMode is the forward error correction mode of a port.
+// +kubebuilder:validation:Enum=none;rs;fc;auto
+type FECMode string
+
+const (
+	FECModeNone FECMode = "none"
+	FECModeRS   FECMode = "rs"
+	FECModeFC   FECMode = "fc"
+	FECModeAuto FECMode = "auto"
+)
+
 // SwitchInterfaceSpec defines the desired state of SwitchInterface
 type SwitchInterfaceSpec struct {
 	// Handle uniquely identifies this interface on the switch.
@@ -33,6 +44,36 @@ type SwitchInterfaceSpec struct {
 	// AdminState represents the desired administrative state of the interface.
 	// +optional
 	AdminState AdminState `json:"adminState,omitempty"`
+
+	// Addresses are the IPv4/IPv6 addresses in CIDR notation assigned to the interface (e.g., "10.0.0.1/31").
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:items:Format=cidr
+	Addresses []string `json:"addresses,omitempty"`
+
+	// MTU is the desired maximum transmission unit of the port.
+	// +optional
+	// +kubebuilder:validation:Minimum=68
+	// +kubebuilder:validation:Maximum=9216
+	MTU int32 `json:"mtu,omitempty"`
+
+	// Speed is the desired speed of the port in Mbps (e.g., 100000).
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Speed int32 `json:"speed,omitempty"`
+
+	// FEC is the desired forward error correction mode of the port.
+	// +optional
+	FEC FECMode `json:"fec,omitempty"`
+
+	// Autoneg enables or disables auto-negotiation on the port.
+	// +optional
+	Autoneg *bool `json:"autoneg,omitempty"`
+
+	// DeletionPolicy defines what happens to the interface on the switch when the SwitchInterface is deleted.
+	// Defaults to the deletion policy of the Switch.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 type OperationState string
@@ -50,6 +91,19 @@ const (
 	SwitchInterfaceStateInitializing SwitchInterfaceState = "Initializing"
 	SwitchInterfaceStateReady        SwitchInterfaceState = "Ready"
 	SwitchInterfaceStateFailed       SwitchInterfaceState = "Failed"
+	SwitchInterfaceStateOrphaned     SwitchInterfaceState = "Orphaned"
+)
+
+const (
+	// SwitchInterfaceConditionAdminStateSynced reports whether the admin state on the switch matches spec.adminState.
+	SwitchInterfaceConditionAdminStateSynced = "AdminStateSynced"
+	// SwitchInterfaceConditionNeighborDiscovered reports whether a neighbor was discovered on the interface.
+	SwitchInterfaceConditionNeighborDiscovered = "NeighborDiscovered"
+
+	// SwitchInterfaceReasonSynced is used when the admin state on the switch matches the spec.
+	SwitchInterfaceReasonSynced = "Synced"
+	// SwitchInterfaceReasonNeighborFound is used when a neighbor was discovered on the interface.
+	SwitchInterfaceReasonNeighborFound = "NeighborFound"
 )
 
 // Neighbor represents a connected neighbor device.
@@ -88,6 +142,34 @@ type SwitchInterfaceStatus struct {
 	// AliasName is the alias name of the interface.
 	// +optional
 	AliasName string `json:"aliasName,omitempty"`
+	// DefaultAliasName is the alias name the interface had before it was set to the handle.
+	// It is restored by the ResetToDefault deletion policy.
+	// +optional
+	DefaultAliasName string `json:"defaultAliasName,omitempty"`
+
+	// Addresses are the IPv4/IPv6 addresses observed on the interface.
+	// +optional
+	Addresses []string `json:"addresses,omitempty"`
+
+	// MTU is the operational maximum transmission unit of the port.
+	// +optional
+	MTU int32 `json:"mtu,omitempty"`
+
+	// Speed is the operational speed of the port in Mbps.
+	// +optional
+	Speed int32 `json:"speed,omitempty"`
+
+	// FEC is the operational forward error correction mode of the port.
+	// +optional
+	FEC FECMode `json:"fec,omitempty"`
+
+	// Autoneg reports whether auto-negotiation is enabled on the port.
+	// +optional
+	Autoneg *bool `json:"autoneg,omitempty"`
+
+	// Transceiver reports the transceiver inserted into the port, if any.
+	// +optional
+	Transceiver *TransceiverStatus `json:"transceiver,omitempty"`
 
 	// The status of each condition is one of True, False, or Unknown.
 	// +listType=map
@@ -96,6 +178,51 @@ type SwitchInterfaceStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// TransceiverLaneStatus reports the DOM readings of a single transceiver lane.
+type TransceiverLaneStatus struct {
+	// Lane is the number of the lane, starting at 1.
+	Lane int32 `json:"lane"`
+
+	// RxPower is the received optical power in dBm.
+	// +optional
+	RxPower string `json:"rxPower,omitempty"`
+
+	// TxPower is the transmitted optical power in dBm.
+	// +optional
+	TxPower string `json:"txPower,omitempty"`
+}
+
+// TransceiverStatus reports the inventory and DOM readings of a transceiver.
+type TransceiverStatus struct {
+	// Vendor is the manufacturer of the transceiver.
+	// +optional
+	Vendor string `json:"vendor,omitempty"`
+
+	// PartNumber is the part number of the transceiver.
+	// +optional
+	PartNumber string `json:"partNumber,omitempty"`
+
+	// SerialNumber is the serial number of the transceiver.
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+
+	// Type is the form factor of the transceiver (e.g., "QSFP28 or later").
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Temperature is the module temperature in degrees Celsius.
+	// +optional
+	Temperature string `json:"temperature,omitempty"`
+
+	// Voltage is the module supply voltage in volts.
+	// +optional
+	Voltage string `json:"voltage,omitempty"`
+
+	// Lanes reports the DOM readings of each lane.
+	// +optional
+	Lanes []TransceiverLaneStatus `json:"lanes,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SwitchPortChannelSpec defines the desired state of SwitchPortChannel
+type SwitchPortChannelSpec struct {
+	// NativeName is the native name of the port channel on the switch (e.g., "PortChannel1").
+	// +required
+	// +kubebuilder:validation:Pattern=`^PortChannel[0-9]{1,4}$`
+	NativeName string `json:"nativeName"`
+
+	// SwitchRef is a reference to the Switch this port channel is configured on.
+	// +required
+	SwitchRef *v1.LocalObjectReference `json:"switchRef"`
+
+	// MemberRefs are references to the SwitchInterfaces bundled into this port channel.
+	// The referenced SwitchInterfaces have to belong to the same Switch.
+	// +optional
+	MemberRefs []v1.LocalObjectReference `json:"memberRefs,omitempty"`
+}
+
+type SwitchPortChannelState string
+
+const (
+	SwitchPortChannelStatePending SwitchPortChannelState = "Pending"
+	SwitchPortChannelStateReady   SwitchPortChannelState = "Ready"
+	SwitchPortChannelStateFailed  SwitchPortChannelState = "Failed"
+)
+
+// PortChannelMemberStatus reports the observed state of a single port channel member.
+type PortChannelMemberStatus struct {
+	// Name is the name of the member SwitchInterface.
+	Name string `json:"name"`
+
+	// NativeName is the native name of the member interface on the switch (e.g., "Ethernet0").
+	// +optional
+	NativeName string `json:"nativeName,omitempty"`
+
+	// Selected reports whether LACP selected the member for aggregation.
+	Selected bool `json:"selected"`
+}
+
+// SwitchPortChannelStatus defines the observed state of SwitchPortChannel.
+type SwitchPortChannelStatus struct {
+	// AdminState represents the actual administrative state of the port channel.
+	// +optional
+	AdminState AdminState `json:"adminState,omitempty"`
+
+	// OperationalState represents the actual operational state of the port channel.
+	// +optional
+	OperationalState OperationState `json:"operationalState,omitempty"`
+
+	// State represents the high-level state of the SwitchPortChannel.
+	// +optional
+	State SwitchPortChannelState `json:"state,omitempty"`
+
+	// Members reports the observed state of each port channel member.
+	// +optional
+	Members []PortChannelMemberStatus `json:"members,omitempty"`
+
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="NativeName",type=string,JSONPath=`.spec.nativeName`
+// +kubebuilder:printcolumn:name="Switch",type=string,JSONPath=`.spec.switchRef.name`
+// +kubebuilder:printcolumn:name="AdminState",type=string,JSONPath=`.status.adminState`
+// +kubebuilder:printcolumn:name="OperationalState",type=string,JSONPath=`.status.operationalState`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// SwitchPortChannel is the Schema for the switchportchannels API
+type SwitchPortChannel struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the desired state of SwitchPortChannel
+	// +required
+	Spec SwitchPortChannelSpec `json:"spec"`
+
+	// status defines the observed state of SwitchPortChannel
+	// +optional
+	Status SwitchPortChannelStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// SwitchPortChannelList contains a list of SwitchPortChannel
+type SwitchPortChannelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SwitchPortChannel `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(func(s *runtime.Scheme) error {
+		s.AddKnownTypes(GroupVersion, &SwitchPortChannel{}, &SwitchPortChannelList{})
+		return nil
+	})
+}
@@ -8,8 +8,8 @@
 package v1alpha1
 
 import (
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -44,6 +44,21 @@ func (in *Neighbor) DeepCopy() *Neighbor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortChannelMemberStatus) DeepCopyInto(out *PortChannelMemberStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortChannelMemberStatus.
+func (in *PortChannelMemberStatus) DeepCopy() *PortChannelMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PortChannelMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortSpec) DeepCopyInto(out *PortSpec) {
 	*out = *in
@@ -64,7 +79,7 @@ func (in *PortStatus) DeepCopyInto(out *PortStatus) {
 	*out = *in
 	if in.InterfaceRefs != nil {
 		in, out := &in.InterfaceRefs, &out.InterfaceRefs
-		*out = make([]v1.LocalObjectReference, len(*in))
+		*out = make([]corev1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
 }
@@ -79,6 +94,64 @@ func (in *PortStatus) DeepCopy() *PortStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningSpec) DeepCopyInto(out *ProvisioningSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningSpec.
+func (in *ProvisioningSpec) DeepCopy() *ProvisioningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningStatus) DeepCopyInto(out *ProvisioningStatus) {
+	*out = *in
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ImageServedTime != nil {
+		in, out := &in.ImageServedTime, &out.ImageServedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ScriptServedTime != nil {
+		in, out := &in.ScriptServedTime, &out.ScriptServedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Stage1StartedTime != nil {
+		in, out := &in.Stage1StartedTime, &out.Stage1StartedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Stage1DoneTime != nil {
+		in, out := &in.Stage1DoneTime, &out.Stage1DoneTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Stage2DoneTime != nil {
+		in, out := &in.Stage2DoneTime, &out.Stage2DoneTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AgentStartedTime != nil {
+		in, out := &in.AgentStartedTime, &out.AgentStartedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningStatus.
+func (in *ProvisioningStatus) DeepCopy() *ProvisioningStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Switch) DeepCopyInto(out *Switch) {
 	*out = *in
@@ -106,6 +179,112 @@ func (in *Switch) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchBGPPeer) DeepCopyInto(out *SwitchBGPPeer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchBGPPeer.
+func (in *SwitchBGPPeer) DeepCopy() *SwitchBGPPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchBGPPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SwitchBGPPeer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchBGPPeerList) DeepCopyInto(out *SwitchBGPPeerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SwitchBGPPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchBGPPeerList.
+func (in *SwitchBGPPeerList) DeepCopy() *SwitchBGPPeerList {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchBGPPeerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SwitchBGPPeerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchBGPPeerSpec) DeepCopyInto(out *SwitchBGPPeerSpec) {
+	*out = *in
+	if in.SwitchRef != nil {
+		in, out := &in.SwitchRef, &out.SwitchRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.InterfaceRef != nil {
+		in, out := &in.InterfaceRef, &out.InterfaceRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchBGPPeerSpec.
+func (in *SwitchBGPPeerSpec) DeepCopy() *SwitchBGPPeerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchBGPPeerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchBGPPeerStatus) DeepCopyInto(out *SwitchBGPPeerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchBGPPeerStatus.
+func (in *SwitchBGPPeerStatus) DeepCopy() *SwitchBGPPeerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchBGPPeerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SwitchCredentials) DeepCopyInto(out *SwitchCredentials) {
 	*out = *in
@@ -139,6 +318,7 @@ func (in *SwitchCredentials) DeepCopyInto(out *SwitchCredentials) {
 			(*out)[key] = val
 		}
 	}
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchCredentials.
@@ -191,6 +371,28 @@ func (in *SwitchCredentialsList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchCredentialsStatus) DeepCopyInto(out *SwitchCredentialsStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchCredentialsStatus.
+func (in *SwitchCredentialsStatus) DeepCopy() *SwitchCredentialsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchCredentialsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SwitchInterface) DeepCopyInto(out *SwitchInterface) {
 	*out = *in
@@ -255,7 +457,17 @@ func (in *SwitchInterfaceSpec) DeepCopyInto(out *SwitchInterfaceSpec) {
 	*out = *in
 	if in.SwitchRef != nil {
 		in, out := &in.SwitchRef, &out.SwitchRef
-		*out = new(v1.LocalObjectReference)
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Autoneg != nil {
+		in, out := &in.Autoneg, &out.Autoneg
+		*out = new(bool)
 		**out = **in
 	}
 }
@@ -274,9 +486,24 @@ func (in *SwitchInterfaceSpec) DeepCopy() *SwitchInterfaceSpec {
 func (in *SwitchInterfaceStatus) DeepCopyInto(out *SwitchInterfaceStatus) {
 	*out = *in
 	out.Neighbor = in.Neighbor
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Autoneg != nil {
+		in, out := &in.Autoneg, &out.Autoneg
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Transceiver != nil {
+		in, out := &in.Transceiver, &out.Transceiver
+		*out = new(TransceiverStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -325,6 +552,117 @@ func (in *SwitchList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchPortChannel) DeepCopyInto(out *SwitchPortChannel) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchPortChannel.
+func (in *SwitchPortChannel) DeepCopy() *SwitchPortChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchPortChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SwitchPortChannel) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchPortChannelList) DeepCopyInto(out *SwitchPortChannelList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SwitchPortChannel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchPortChannelList.
+func (in *SwitchPortChannelList) DeepCopy() *SwitchPortChannelList {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchPortChannelList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SwitchPortChannelList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchPortChannelSpec) DeepCopyInto(out *SwitchPortChannelSpec) {
+	*out = *in
+	if in.SwitchRef != nil {
+		in, out := &in.SwitchRef, &out.SwitchRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.MemberRefs != nil {
+		in, out := &in.MemberRefs, &out.MemberRefs
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchPortChannelSpec.
+func (in *SwitchPortChannelSpec) DeepCopy() *SwitchPortChannelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchPortChannelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchPortChannelStatus) DeepCopyInto(out *SwitchPortChannelStatus) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]PortChannelMemberStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchPortChannelStatus.
+func (in *SwitchPortChannelStatus) DeepCopy() *SwitchPortChannelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchPortChannelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SwitchSpec) DeepCopyInto(out *SwitchSpec) {
 	*out = *in
@@ -334,6 +672,16 @@ func (in *SwitchSpec) DeepCopyInto(out *SwitchSpec) {
 		*out = make([]PortSpec, len(*in))
 		copy(*out, *in)
 	}
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Provisioning != nil {
+		in, out := &in.Provisioning, &out.Provisioning
+		*out = new(ProvisioningSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchSpec.
@@ -356,9 +704,14 @@ func (in *SwitchStatus) DeepCopyInto(out *SwitchStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Provisioning != nil {
+		in, out := &in.Provisioning, &out.Provisioning
+		*out = new(ProvisioningStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -374,3 +727,38 @@ func (in *SwitchStatus) DeepCopy() *SwitchStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransceiverLaneStatus) DeepCopyInto(out *TransceiverLaneStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransceiverLaneStatus.
+func (in *TransceiverLaneStatus) DeepCopy() *TransceiverLaneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransceiverLaneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransceiverStatus) DeepCopyInto(out *TransceiverStatus) {
+	*out = *in
+	if in.Lanes != nil {
+		in, out := &in.Lanes, &out.Lanes
+		*out = make([]TransceiverLaneStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransceiverStatus.
+func (in *TransceiverStatus) DeepCopy() *TransceiverStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransceiverStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -4,12 +4,14 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
-	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"net/http"
 	"os"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -19,6 +21,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -26,8 +29,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
 	"github.com/ironcore-dev/sonic-operator/internal/controller"
+	"github.com/ironcore-dev/sonic-operator/internal/filewatch"
+	"github.com/ironcore-dev/sonic-operator/internal/identity"
 	"github.com/ironcore-dev/sonic-operator/internal/onie"
+	webhookv1alpha1 "github.com/ironcore-dev/sonic-operator/internal/webhook/v1alpha1"
 	"github.com/ironcore-dev/sonic-operator/internal/ztp"
 	// +kubebuilder:scaffold:imports
 )
@@ -54,7 +61,12 @@ func main() {
 	var secureMetrics bool
 	var enableHTTP2 bool
 	var disableProvisionsingServer bool
-	var httpServerAddr, onieImagesDir, onieConfigFile, ztpConfigFile string
+	var httpServerAddr, onieImagesDir, onieConfigFile, ztpConfigFile, ztpTemplatesDir, trustedProxies string
+	var switchResyncInterval, switchInterfaceResyncInterval, switchBGPPeerResyncInterval, switchPortChannelResyncInterval time.Duration
+	var requirePortsMatched bool
+	var deletionTimeout time.Duration
+	var interfaceNameTableFile string
+	var deriveInterfaceNames bool
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -75,12 +87,32 @@ func main() {
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
 	flag.StringVar(&httpServerAddr, "http-server-address", "0", "The address the HTTP server for ZTP and ONIE binds to.")
 	flag.StringVar(&ztpConfigFile, "ztp-config-file", "/etc/ztp.json", "Config file containing the parameters to render ZTP scripts.")
+	flag.StringVar(&ztpTemplatesDir, "ztp-templates-dir", "",
+		"Directory with additional ZTP templates (*.gotmpl), e.g., a mounted ConfigMap. Templates replace built-in templates of the same name.")
 	flag.StringVar(&onieImagesDir, "onie-images-dir", "/var/lib/sonic-operator/onie", "The directory which contains the ONIE and SONiC installer image files.")
 	flag.StringVar(&onieConfigFile, "onie-config-file", "/etc/onie.json", "Config file containing machine-to-image mappings for ONIE provisioning.")
+	flag.StringVar(&trustedProxies, "trusted-proxies", "",
+		"Comma-separated addresses or prefixes of proxies whose X-Forwarded-For header identifies the switch requesting ZTP and ONIE resources.")
 	flag.BoolVar(&disableProvisionsingServer, "disable-static-config", false, "If set, the HTTP server for ZTP and ONIE will not be started.")
 	opts := zap.Options{
 		Development: true,
 	}
+	flag.DurationVar(&switchResyncInterval, "switch-resync-interval", controller.DefaultSwitchResyncInterval,
+		"The interval in which Switches are reconciled again. Overridden by spec.pollInterval of a Switch.")
+	flag.DurationVar(&switchInterfaceResyncInterval, "switchinterface-resync-interval", controller.DefaultSwitchInterfaceResyncInterval,
+		"The interval in which SwitchInterfaces are reconciled again. Overridden by spec.pollInterval of their Switch.")
+	flag.DurationVar(&switchBGPPeerResyncInterval, "switchbgppeer-resync-interval", controller.DefaultSwitchBGPPeerResyncInterval,
+		"The interval in which SwitchBGPPeers are reconciled again. Overridden by spec.pollInterval of their Switch.")
+	flag.DurationVar(&switchPortChannelResyncInterval, "switchportchannel-resync-interval", controller.DefaultSwitchPortChannelResyncInterval,
+		"The interval in which SwitchPortChannels are reconciled again. Overridden by spec.pollInterval of their Switch.")
+	flag.BoolVar(&requirePortsMatched, "require-ports-matched", false,
+		"If set, Switches whose ports do not match spec.ports are not marked Ready.")
+	flag.DurationVar(&deletionTimeout, "deletion-timeout", controller.DefaultDeletionTimeout,
+		"The time after which deleted Switches, SwitchInterfaces, SwitchPortChannels and SwitchBGPPeers are released even if their cleanup on the switch failed. 0 waits forever.")
+	flag.StringVar(&interfaceNameTableFile, "interface-name-table-file", "",
+		"A YAML file with explicit tables of native to abstract interface names by HWSKU, as given to the switch agents with --name-table-file.")
+	flag.BoolVar(&deriveInterfaceNames, "derive-interface-names", false,
+		"Set if the switch agents run with --derive-names-from-platform. SwitchInterface handles of HWSKUs without a name table are then only checked for their format.")
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
@@ -177,27 +209,81 @@ func main() {
 		os.Exit(1)
 	}
 
+	interfaceWatcher := controller.NewInterfaceWatcher(mgr.GetClient())
+	if err := mgr.Add(interfaceWatcher); err != nil {
+		setupLog.Error(err, "unable to add interface watcher")
+		os.Exit(1)
+	}
+
 	if err := (&controller.SwitchReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:              mgr.GetClient(),
+		Scheme:              mgr.GetScheme(),
+		InterfaceWatcher:    interfaceWatcher,
+		ResyncInterval:      switchResyncInterval,
+		RequirePortsMatched: requirePortsMatched,
+		DeletionTimeout:     deletionTimeout,
+		Recorder:            mgr.GetEventRecorder("switch-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Switch")
 		os.Exit(1)
 	}
 	if err := (&controller.SwitchInterfaceReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		InterfaceWatcher: interfaceWatcher,
+		ResyncInterval:   switchInterfaceResyncInterval,
+		DeletionTimeout:  deletionTimeout,
+		Recorder:         mgr.GetEventRecorder("switchinterface-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "SwitchInterface")
 		os.Exit(1)
 	}
 	if err := (&controller.SwitchCredentialsReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorder("switchcredentials-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "SwitchCredentials")
 		os.Exit(1)
 	}
+	if err := (&controller.SwitchPortChannelReconciler{
+		Client:          mgr.GetClient(),
+		Scheme:          mgr.GetScheme(),
+		ResyncInterval:  switchPortChannelResyncInterval,
+		DeletionTimeout: deletionTimeout,
+		Recorder:        mgr.GetEventRecorder("switchportchannel-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SwitchPortChannel")
+		os.Exit(1)
+	}
+	if err := (&controller.SwitchBGPPeerReconciler{
+		Client:          mgr.GetClient(),
+		Scheme:          mgr.GetScheme(),
+		ResyncInterval:  switchBGPPeerResyncInterval,
+		DeletionTimeout: deletionTimeout,
+		Recorder:        mgr.GetEventRecorder("switchbgppeer-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SwitchBGPPeer")
+		os.Exit(1)
+	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := webhookv1alpha1.SetupSwitchWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Switch")
+			os.Exit(1)
+		}
+		var nameTables agent.NameTables
+		if interfaceNameTableFile != "" {
+			if nameTables, err = agent.LoadNameTables(interfaceNameTableFile); err != nil {
+				setupLog.Error(err, "unable to load interface name tables")
+				os.Exit(1)
+			}
+		}
+		if err := webhookv1alpha1.SetupSwitchInterfaceWebhookWithManager(mgr, nameTables, deriveInterfaceNames); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "SwitchInterface")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -210,18 +296,22 @@ func main() {
 	}
 	if !disableProvisionsingServer {
 		setupLog.Info("starting HTTP server")
-		provServer, err := setupProvisioningServer(httpServerAddr, onieImagesDir, onieConfigFile, ztpConfigFile)
+		proxies, err := identity.ParseTrustedProxies(trustedProxies)
+		if err != nil {
+			setupLog.Error(err, "unable to parse trusted proxies")
+			os.Exit(1)
+		}
+		provServer, err := setupProvisioningServer(httpServerAddr, onieImagesDir, onieConfigFile, ztpConfigFile, ztpTemplatesDir, proxies, mgr.GetClient())
 		if err != nil {
 			setupLog.Error(err, "unable to setup HTTP server")
 			os.Exit(1)
 		}
-
-		go func() {
-			if err := provServer.ListenAndServe(); err != nil {
-				setupLog.Error(err, "http server failed")
-				os.Exit(1)
-			}
-		}()
+		// The server runs as part of the manager so that the Switch cache is started before it
+		// serves requests. It runs on every replica, not only on the leader.
+		if err := mgr.Add(provServer); err != nil {
+			setupLog.Error(err, "unable to add HTTP server to the manager")
+			os.Exit(1)
+		}
 	}
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -230,35 +320,89 @@ func main() {
 	}
 }
 
-func setupProvisioningServer(addr string, onieImagesDir string, onieConfigPath string, ztpConfigPath string) (*http.Server, error) {
-	f, err := os.Open(ztpConfigPath)
-	if err != nil {
-		return nil, fmt.Errorf("unable to open ztp config file: %w", err)
-	}
+// provisioningServer runs the HTTP server for ZTP and ONIE as a manager.Runnable.
+type provisioningServer struct {
+	*http.Server
+	// watchers reload the ZTP and ONIE config files while the server is running.
+	watchers []*filewatch.Watcher
+}
 
-	var ztpConf ztp.Config
-	err = json.NewDecoder(f).Decode(&ztpConf)
-	if err != nil {
-		return nil, err
+func (s provisioningServer) Start(ctx context.Context) error {
+	for _, w := range s.watchers {
+		go w.Run(ctx)
 	}
-
-	of, err := os.Open(onieConfigPath)
-	if err != nil {
-		return nil, fmt.Errorf("unable to open onie config file: %w", err)
+	go func() {
+		<-ctx.Done()
+		_ = s.Shutdown(context.Background())
+	}()
+	if err := s.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("http server failed: %w", err)
 	}
+	return nil
+}
 
-	var onieConf onie.Config
-	if err := json.NewDecoder(of).Decode(&onieConf); err != nil {
-		return nil, err
-	}
+func (s provisioningServer) NeedLeaderElection() bool {
+	return false
+}
 
+// setupProvisioningServer resolves the ZTP parameters of a requesting switch from the Switch objects
+// read through the given client, falling back to the switchParams of the ZTP config file. The progress
+// of the provisioning is recorded in the status of the Switch. Both config files are reloaded when they
+// change, an invalid version is logged and the last good one is kept.
+func setupProvisioningServer(addr string, onieImagesDir string, onieConfigPath string, ztpConfigPath string, ztpTemplatesDir string, trustedProxies identity.TrustedProxies, c client.Client) (provisioningServer, error) {
 	mux := http.NewServeMux()
+	recorder := &ztp.SwitchStatusRecorder{Client: c}
 
-	ztp.Register(mux, ztpConf)
-	onie.Register(mux, onieImagesDir, onieConf)
+	ztpHandler, err := ztp.Register(mux, ztp.Config{})
+	if err != nil {
+		return provisioningServer{}, err
+	}
+	ztpWatcher := &filewatch.Watcher{
+		Path: ztpConfigPath,
+		Apply: func(content []byte) error {
+			ztpConf, err := ztp.ParseConfig(content)
+			if err != nil {
+				return err
+			}
+			ztpConf.Resolver = ztp.Resolvers{
+				&ztp.SwitchResolver{Reader: c},
+				ztp.NewStaticResolver(ztpConf),
+			}
+			ztpConf.Recorder = recorder
+			ztpConf.TrustedProxies = trustedProxies
+			ztpConf.TemplateDir = ztpTemplatesDir
+			return ztpHandler.Update(ztpConf)
+		},
+	}
+	if ztpTemplatesDir != "" {
+		ztpWatcher.Dirs = []string{ztpTemplatesDir}
+	}
+	if err := ztpWatcher.Load(); err != nil {
+		return provisioningServer{}, err
+	}
+
+	onieHandler := onie.Register(mux, onieImagesDir, onie.Config{})
+	onieWatcher := &filewatch.Watcher{
+		Path: onieConfigPath,
+		Apply: func(content []byte) error {
+			onieConf, err := onie.ParseConfig(content)
+			if err != nil {
+				return err
+			}
+			onieConf.Recorder = recorder
+			onieConf.TrustedProxies = trustedProxies
+			return onieHandler.Update(onieConf)
+		},
+	}
+	if err := onieWatcher.Load(); err != nil {
+		return provisioningServer{}, err
+	}
 
-	return &http.Server{
-		Addr:    addr,
-		Handler: mux,
+	return provisioningServer{
+		Server: &http.Server{
+			Addr:    addr,
+			Handler: mux,
+		},
+		watchers: []*filewatch.Watcher{ztpWatcher, onieWatcher},
 	}, nil
 }
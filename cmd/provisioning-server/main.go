@@ -4,11 +4,14 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"flag"
 	"fmt"
 	"net/http"
 	"os"
 
+	"github.com/ironcore-dev/sonic-operator/internal/filewatch"
+	"github.com/ironcore-dev/sonic-operator/internal/identity"
 	"github.com/ironcore-dev/sonic-operator/internal/onie"
 	"github.com/ironcore-dev/sonic-operator/internal/ztp"
 )
@@ -22,40 +25,71 @@ func main() {
 }
 
 func Main() error {
-	if len(os.Args) != 5 {
-		return fmt.Errorf("usage: provisioning-server <listen-addr> <onie-images-dir> <onie-config> <ztp-conf>")
+	trustedProxiesFlag := flag.String("trusted-proxies", "",
+		"Comma-separated addresses or prefixes of proxies whose X-Forwarded-For header identifies the switch.")
+	templatesDir := flag.String("ztp-templates-dir", "",
+		"Directory with additional ZTP templates (*.gotmpl). Templates replace built-in templates of the same name.")
+	flag.Parse()
+	if flag.NArg() != 4 {
+		return fmt.Errorf("usage: provisioning-server [--trusted-proxies=<cidr>,...] [--ztp-templates-dir=<dir>] <listen-addr> <onie-images-dir> <onie-config> <ztp-conf>")
 	}
 
-	listenAddr := os.Args[1]
-	onieImagesDir := os.Args[2]
-	onieConfigPath := os.Args[3]
-	ztpConfigPath := os.Args[4]
+	listenAddr := flag.Arg(0)
+	onieImagesDir := flag.Arg(1)
+	onieConfigPath := flag.Arg(2)
+	ztpConfigPath := flag.Arg(3)
 
-	f, err := os.Open(ztpConfigPath)
+	trustedProxies, err := identity.ParseTrustedProxies(*trustedProxiesFlag)
 	if err != nil {
-		return fmt.Errorf("unable to open ztp config file: %w", err)
+		return err
 	}
 
-	var ztpConf ztp.Config
-	err = json.NewDecoder(f).Decode(&ztpConf)
+	mux := http.NewServeMux()
+
+	// Both config files are reloaded when they change. An invalid version is logged and the
+	// last good one stays active.
+	ztpHandler, err := ztp.Register(mux, ztp.Config{})
 	if err != nil {
 		return err
 	}
-
-	of, err := os.Open(onieConfigPath)
-	if err != nil {
-		return fmt.Errorf("unable to open onie config file: %w", err)
+	ztpWatcher := &filewatch.Watcher{
+		Path: ztpConfigPath,
+		Apply: func(content []byte) error {
+			c, err := ztp.ParseConfig(content)
+			if err != nil {
+				return err
+			}
+			c.TrustedProxies = trustedProxies
+			c.TemplateDir = *templatesDir
+			return ztpHandler.Update(c)
+		},
 	}
-
-	var onieConf onie.Config
-	if err := json.NewDecoder(of).Decode(&onieConf); err != nil {
+	if *templatesDir != "" {
+		ztpWatcher.Dirs = []string{*templatesDir}
+	}
+	if err := ztpWatcher.Load(); err != nil {
 		return err
 	}
 
-	mux := http.NewServeMux()
+	onieHandler := onie.Register(mux, onieImagesDir, onie.Config{})
+	onieWatcher := &filewatch.Watcher{
+		Path: onieConfigPath,
+		Apply: func(content []byte) error {
+			c, err := onie.ParseConfig(content)
+			if err != nil {
+				return err
+			}
+			c.TrustedProxies = trustedProxies
+			return onieHandler.Update(c)
+		},
+	}
+	if err := onieWatcher.Load(); err != nil {
+		return err
+	}
 
-	ztp.Register(mux, ztpConf)
-	onie.Register(mux, onieImagesDir, onieConf)
+	ctx := context.Background()
+	go ztpWatcher.Run(ctx)
+	go onieWatcher.Run(ctx)
 
 	return http.ListenAndServe(listenAddr, mux)
 }
@@ -11,21 +11,51 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	agenterrors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
 	pb "github.com/ironcore-dev/sonic-operator/internal/agent/proto"
 	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
 )
 
 type SwitchAgentClient interface {
 	GetDeviceInfo(ctx context.Context) (*agent.SwitchDevice, error)
+	GetPlatformHealth(ctx context.Context) (*agent.PlatformHealth, error)
 	ListInterfaces(ctx context.Context) (*agent.InterfaceList, error)
 	GetInterfaceByAbstractName(ctx context.Context, iface *agent.Interface) (*agent.Interface, error)
 
 	GetInterfaceNeighbor(ctx context.Context, iface *agent.Interface) (*agent.InterfaceNeighbor, error)
+	GetInterfaceCounters(ctx context.Context, iface *agent.Interface) (*agent.InterfaceCounters, error)
+	ListInterfaceCounters(ctx context.Context) (*agent.InterfaceCountersList, error)
+	GetTransceiver(ctx context.Context, iface *agent.Interface) (*agent.Transceiver, error)
+	WatchInterfaces(ctx context.Context, events chan<- agent.InterfaceEvent) error
 
 	SetInterfaceAdminStatus(ctx context.Context, iface *agent.Interface) (*agent.Interface, error)
 	SetInterfaceAliasName(ctx context.Context, iface *agent.Interface) (*agent.Interface, error)
+	SetInterfacePortAttributes(ctx context.Context, iface *agent.Interface) (*agent.Interface, error)
 
 	ListPorts(ctx context.Context) (*agent.PortList, error)
+	ListPortBreakouts(ctx context.Context) (*agent.PortBreakoutList, error)
+	SetPortBreakout(ctx context.Context, breakout *agent.PortBreakout) (*agent.PortBreakout, error)
+
+	CreateVlan(ctx context.Context, vlan *agent.Vlan) (*agent.Vlan, error)
+	DeleteVlan(ctx context.Context, vlan *agent.Vlan) error
+	ListVlans(ctx context.Context) (*agent.VlanList, error)
+	AddVlanMember(ctx context.Context, member *agent.VlanMember) (*agent.VlanMember, error)
+	RemoveVlanMember(ctx context.Context, member *agent.VlanMember) error
+
+	CreatePortChannel(ctx context.Context, portChannel *agent.PortChannel) (*agent.PortChannel, error)
+	DeletePortChannel(ctx context.Context, portChannel *agent.PortChannel) error
+	GetPortChannel(ctx context.Context, portChannel *agent.PortChannel) (*agent.PortChannel, error)
+	ListPortChannels(ctx context.Context) (*agent.PortChannelList, error)
+	AddPortChannelMember(ctx context.Context, member *agent.PortChannelMember) (*agent.PortChannelMember, error)
+	RemovePortChannelMember(ctx context.Context, member *agent.PortChannelMember) error
+
+	ListInterfaceAddresses(ctx context.Context, iface *agent.Interface) (*agent.InterfaceAddressList, error)
+	AddInterfaceAddress(ctx context.Context, address *agent.InterfaceAddress) (*agent.InterfaceAddress, error)
+	RemoveInterfaceAddress(ctx context.Context, address *agent.InterfaceAddress) error
+
+	ListBGPNeighbors(ctx context.Context) (*agent.BGPNeighborList, error)
+	AddBGPNeighbor(ctx context.Context, neighbor *agent.BGPNeighbor) (*agent.BGPNeighbor, error)
+	RemoveBGPNeighbor(ctx context.Context, neighbor *agent.BGPNeighbor) error
 
 	SaveConfig(ctx context.Context) error
 }
@@ -35,10 +65,13 @@ type defaultSwitchAgentClient struct {
 	ConnectTimeout time.Duration
 
 	opts   []grpc.DialOption // Options for the gRPC connection
+	conn   grpc.ClientConnInterface
 	client pb.SwitchAgentServiceClient
 }
 
-func NewDefaultSwitchAgentClient(address string, connectTimeout time.Duration) (SwitchAgentClient, error) {
+// NewDefaultSwitchAgentClient returns a client for the agent at the given address.
+// If no dial options are given, the connection is not secured.
+func NewDefaultSwitchAgentClient(address string, connectTimeout time.Duration, opts ...grpc.DialOption) (SwitchAgentClient, error) {
 	if address == "" {
 		address = "localhost:50051"
 	}
@@ -53,17 +86,31 @@ func NewDefaultSwitchAgentClient(address string, connectTimeout time.Duration) (
 	}
 
 	// Remove the println from here - flags haven't been parsed yet!
-	c.opts = []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	c.opts = opts
+	if len(c.opts) == 0 {
+		c.opts = []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		}
 	}
 
 	return &c, nil
 }
 
+// NewSwitchAgentClientForConn returns a client that uses the given connection for all calls.
+// The connection is owned by the caller and is not closed by the client.
+func NewSwitchAgentClientForConn(conn grpc.ClientConnInterface) SwitchAgentClient {
+	return &defaultSwitchAgentClient{
+		conn:   conn,
+		client: pb.NewSwitchAgentServiceClient(conn),
+	}
+}
+
 func (c *defaultSwitchAgentClient) dial() (func() error, error) {
-	println("connect to ", c.Address)
+	if c.conn != nil {
+		return func() error { return nil }, nil
+	}
 
-	conn, err := grpc.NewClient(c.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(c.Address, c.opts...)
 
 	// conn, err := grpc.DialContext(dialCtx, c.Address,
 	// 	grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -136,6 +183,10 @@ func (c *defaultSwitchAgentClient) ListInterfaces(ctx context.Context) (*agent.I
 			MacAddress:      iface.GetMacAddress(),
 			OperationStatus: agent.DeviceStatus(iface.GetOperationalStatus()),
 			AdminStatus:     agent.DeviceStatus(iface.GetAdminStatus()),
+			MTU:             iface.GetMtu(),
+			Speed:           iface.GetSpeed(),
+			FEC:             iface.GetFec(),
+			Autoneg:         iface.GetAutoneg(),
 		}
 	}
 
@@ -172,7 +223,7 @@ func (c *defaultSwitchAgentClient) SetInterfaceAdminStatus(ctx context.Context,
 		fmt.Println("Error occurred while setting interface admin status:", resp.GetStatus().GetMessage())
 		return &agent.Interface{
 			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
-		}, fmt.Errorf("failed to set interface admin status: %s", resp.GetStatus().GetMessage())
+		}, agenterrors.NewStatusError("failed to set interface admin status", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
 	}
 	iface.Name = resp.GetInterface().GetName()
 	iface.AliasName = resp.GetInterface().GetAliasName()
@@ -185,7 +236,7 @@ func (c *defaultSwitchAgentClient) SetInterfaceAdminStatus(ctx context.Context,
 	return iface, nil
 }
 
-func (c *defaultSwitchAgentClient) GetInterfaceByAbstractName(ctx context.Context, iface *agent.Interface) (*agent.Interface, error) {
+func (c *defaultSwitchAgentClient) SetInterfacePortAttributes(ctx context.Context, iface *agent.Interface) (*agent.Interface, error) {
 	cleanup, err := c.dial()
 	if err != nil {
 		return nil, err
@@ -194,13 +245,53 @@ func (c *defaultSwitchAgentClient) GetInterfaceByAbstractName(ctx context.Contex
 		_ = cleanup()
 	}()
 
-	nativeName, err := agent.AbstractNameToNativeName(iface.GetName())
+	resp, err := c.client.SetInterfacePortAttributes(ctx, &pb.SetInterfacePortAttributesRequest{
+		InterfaceName: iface.GetName(),
+		Mtu:           iface.MTU,
+		Speed:         iface.Speed,
+		Fec:           iface.FEC,
+		Autoneg:       iface.Autoneg,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.Interface{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to set interface port attributes", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	return &agent.Interface{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceKind,
+		},
+		Name:            resp.GetInterface().GetName(),
+		AliasName:       resp.GetInterface().GetAliasName(),
+		NativeName:      resp.GetInterface().GetNativeName(),
+		MacAddress:      resp.GetInterface().GetMacAddress(),
+		OperationStatus: agent.DeviceStatus(resp.GetInterface().GetOperationalStatus()),
+		AdminStatus:     agent.DeviceStatus(resp.GetInterface().GetAdminStatus()),
+		MTU:             resp.GetInterface().GetMtu(),
+		Speed:           resp.GetInterface().GetSpeed(),
+		FEC:             resp.GetInterface().GetFec(),
+		Autoneg:         resp.GetInterface().GetAutoneg(),
+		Status:          agent.ProtoStatusToStatus(resp.GetStatus()),
+	}, nil
+}
+
+func (c *defaultSwitchAgentClient) GetInterfaceByAbstractName(ctx context.Context, iface *agent.Interface) (*agent.Interface, error) {
+	cleanup, err := c.dial()
 	if err != nil {
 		return nil, err
 	}
+	defer func() {
+		_ = cleanup()
+	}()
 
+	// The agent resolves the name with the naming scheme of its platform
 	resp, err := c.client.GetInterface(ctx, &pb.GetInterfaceRequest{
-		InterfaceName: nativeName,
+		InterfaceName: iface.GetName(),
 	})
 	if err != nil {
 		return nil, err
@@ -209,7 +300,7 @@ func (c *defaultSwitchAgentClient) GetInterfaceByAbstractName(ctx context.Contex
 	if resp.GetStatus().Code != 0 {
 		return &agent.Interface{
 			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
-		}, fmt.Errorf("failed to get interface: %s", resp.GetStatus().GetMessage())
+		}, agenterrors.NewStatusError("failed to get interface", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
 	}
 
 	return &agent.Interface{
@@ -222,6 +313,10 @@ func (c *defaultSwitchAgentClient) GetInterfaceByAbstractName(ctx context.Contex
 		MacAddress:      resp.GetInterface().GetMacAddress(),
 		OperationStatus: agent.DeviceStatus(resp.GetInterface().GetOperationalStatus()),
 		AdminStatus:     agent.DeviceStatus(resp.GetInterface().GetAdminStatus()),
+		MTU:             resp.GetInterface().GetMtu(),
+		Speed:           resp.GetInterface().GetSpeed(),
+		FEC:             resp.GetInterface().GetFec(),
+		Autoneg:         resp.GetInterface().GetAutoneg(),
 		Status:          agent.ProtoStatusToStatus(resp.GetStatus()),
 	}, nil
 }
@@ -245,7 +340,7 @@ func (c *defaultSwitchAgentClient) GetInterfaceNeighbor(ctx context.Context, ifa
 	if resp.GetStatus().Code != 0 {
 		return &agent.InterfaceNeighbor{
 			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
-		}, fmt.Errorf("failed to get interface neighbor: %s", resp.GetStatus().GetMessage())
+		}, agenterrors.NewStatusError("failed to get interface neighbor", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
 	}
 
 	return &agent.InterfaceNeighbor{
@@ -296,6 +391,313 @@ func (c *defaultSwitchAgentClient) ListPorts(ctx context.Context) (*agent.PortLi
 	return portList, nil
 }
 
+func (c *defaultSwitchAgentClient) GetPlatformHealth(ctx context.Context) (*agent.PlatformHealth, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.GetPlatformHealth(ctx, &pb.GetPlatformHealthRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.PlatformHealth{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to get platform health", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	psus := make([]agent.PSU, len(resp.GetPsus()))
+	for i, psu := range resp.GetPsus() {
+		psus[i] = agent.PSU{
+			Name:     psu.GetName(),
+			Presence: psu.GetPresence(),
+			OK:       psu.GetOk(),
+			Model:    psu.GetModel(),
+			Serial:   psu.GetSerial(),
+			Power:    psu.GetPower(),
+		}
+	}
+
+	fans := make([]agent.Fan, len(resp.GetFans()))
+	for i, fan := range resp.GetFans() {
+		fans[i] = agent.Fan{
+			Name:      fan.GetName(),
+			Presence:  fan.GetPresence(),
+			OK:        fan.GetOk(),
+			Speed:     fan.GetSpeed(),
+			Direction: fan.GetDirection(),
+		}
+	}
+
+	temperatures := make([]agent.TemperatureSensor, len(resp.GetTemperatures()))
+	for i, sensor := range resp.GetTemperatures() {
+		temperatures[i] = agent.TemperatureSensor{
+			Name:                  sensor.GetName(),
+			Temperature:           sensor.GetTemperature(),
+			HighThreshold:         sensor.GetHighThreshold(),
+			CriticalHighThreshold: sensor.GetCriticalHighThreshold(),
+			Warning:               sensor.GetWarning(),
+		}
+	}
+
+	return &agent.PlatformHealth{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PlatformHealthKind,
+		},
+		PSUs:         psus,
+		Fans:         fans,
+		Temperatures: temperatures,
+		Status:       agent.ProtoStatusToStatus(resp.GetStatus()),
+	}, nil
+}
+
+func protoToInterfaceCounters(counters *pb.InterfaceCounters) agent.InterfaceCounters {
+	return agent.InterfaceCounters{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceCountersKind,
+		},
+		Interface:  counters.GetInterface(),
+		RxOctets:   counters.GetRxOctets(),
+		RxPackets:  counters.GetRxPackets(),
+		RxErrors:   counters.GetRxErrors(),
+		RxDiscards: counters.GetRxDiscards(),
+		TxOctets:   counters.GetTxOctets(),
+		TxPackets:  counters.GetTxPackets(),
+		TxErrors:   counters.GetTxErrors(),
+		TxDiscards: counters.GetTxDiscards(),
+	}
+}
+
+func (c *defaultSwitchAgentClient) GetInterfaceCounters(ctx context.Context, iface *agent.Interface) (*agent.InterfaceCounters, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.GetInterfaceCounters(ctx, &pb.GetInterfaceCountersRequest{
+		InterfaceName: iface.GetName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.InterfaceCounters{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to get interface counters", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	counters := protoToInterfaceCounters(resp.GetCounters())
+	counters.Status = agent.ProtoStatusToStatus(resp.GetStatus())
+	return &counters, nil
+}
+
+func (c *defaultSwitchAgentClient) ListInterfaceCounters(ctx context.Context) (*agent.InterfaceCountersList, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.ListInterfaceCounters(ctx, &pb.ListInterfaceCountersRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.InterfaceCountersList{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to list interface counters", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	counters := make([]agent.InterfaceCounters, len(resp.GetCounters()))
+	for i, c := range resp.GetCounters() {
+		counters[i] = protoToInterfaceCounters(c)
+	}
+
+	return &agent.InterfaceCountersList{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceCountersListKind,
+		},
+		Items:  counters,
+		Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+	}, nil
+}
+
+func (c *defaultSwitchAgentClient) GetTransceiver(ctx context.Context, iface *agent.Interface) (*agent.Transceiver, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.GetTransceiver(ctx, &pb.GetTransceiverRequest{
+		InterfaceName: iface.GetName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.Transceiver{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to get transceiver", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	transceiver := resp.GetTransceiver()
+	lanes := make([]agent.TransceiverLane, len(transceiver.GetLanes()))
+	for i, lane := range transceiver.GetLanes() {
+		lanes[i] = agent.TransceiverLane{
+			Lane:    lane.GetLane(),
+			RxPower: lane.GetRxPower(),
+			TxPower: lane.GetTxPower(),
+		}
+	}
+
+	return &agent.Transceiver{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.TransceiverKind,
+		},
+		Interface:    transceiver.GetInterface(),
+		Vendor:       transceiver.GetVendor(),
+		PartNumber:   transceiver.GetPartNumber(),
+		SerialNumber: transceiver.GetSerialNumber(),
+		Type:         transceiver.GetType(),
+		Temperature:  transceiver.GetTemperature(),
+		Voltage:      transceiver.GetVoltage(),
+		Lanes:        lanes,
+		Status:       agent.ProtoStatusToStatus(resp.GetStatus()),
+	}, nil
+}
+
+// WatchInterfaces streams the interface state changes into events until the
+// context is done or the stream fails.
+func (c *defaultSwitchAgentClient) WatchInterfaces(ctx context.Context, events chan<- agent.InterfaceEvent) error {
+	cleanup, err := c.dial()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	stream, err := c.client.WatchInterfaces(ctx, &pb.WatchInterfacesRequest{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to watch interfaces: %w", err)
+		}
+
+		select {
+		case events <- agent.InterfaceEvent{
+			TypeMeta: agent.TypeMeta{
+				Kind: agent.InterfaceEventKind,
+			},
+			Name:            event.GetName(),
+			NativeName:      event.GetNativeName(),
+			AdminStatus:     agent.DeviceStatus(event.GetAdminStatus()),
+			OperationStatus: agent.DeviceStatus(event.GetOperationalStatus()),
+		}:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func protoToPortBreakout(breakout *pb.PortBreakout) agent.PortBreakout {
+	return agent.PortBreakout{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortBreakoutKind,
+		},
+		Port:           breakout.GetPort(),
+		Mode:           breakout.GetMode(),
+		DefaultMode:    breakout.GetDefaultMode(),
+		SupportedModes: breakout.GetSupportedModes(),
+		Ports:          breakout.GetPorts(),
+	}
+}
+
+func (c *defaultSwitchAgentClient) ListPortBreakouts(ctx context.Context) (*agent.PortBreakoutList, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.ListPortBreakouts(ctx, &pb.ListPortBreakoutsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.PortBreakoutList{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to list port breakouts", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	breakouts := make([]agent.PortBreakout, len(resp.GetBreakouts()))
+	for i, breakout := range resp.GetBreakouts() {
+		breakouts[i] = protoToPortBreakout(breakout)
+	}
+
+	return &agent.PortBreakoutList{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortBreakoutListKind,
+		},
+		Items:  breakouts,
+		Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+	}, nil
+}
+
+func (c *defaultSwitchAgentClient) SetPortBreakout(ctx context.Context, breakout *agent.PortBreakout) (*agent.PortBreakout, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.SetPortBreakout(ctx, &pb.SetPortBreakoutRequest{
+		Port: breakout.Port,
+		Mode: breakout.Mode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.PortBreakout{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to set port breakout", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	result := protoToPortBreakout(resp.GetBreakout())
+	result.Status = agent.ProtoStatusToStatus(resp.GetStatus())
+	return &result, nil
+}
+
 func (c *defaultSwitchAgentClient) SetInterfaceAliasName(ctx context.Context, iface *agent.Interface) (*agent.Interface, error) {
 	cleanup, err := c.dial()
 	if err != nil {
@@ -318,7 +720,7 @@ func (c *defaultSwitchAgentClient) SetInterfaceAliasName(ctx context.Context, if
 		fmt.Println("Error occurred while setting interface alias name:", resp.GetStatus().GetMessage())
 		return &agent.Interface{
 			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
-		}, fmt.Errorf("failed to set interface alias name: %s", resp.GetStatus().GetMessage())
+		}, agenterrors.NewStatusError("failed to set interface alias name", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
 	}
 
 	iface.AdminStatus = agent.DeviceStatus(resp.GetInterface().GetAdminStatus())
@@ -328,6 +730,566 @@ func (c *defaultSwitchAgentClient) SetInterfaceAliasName(ctx context.Context, if
 	return iface, nil
 }
 
+func protoToVlan(vlan *pb.Vlan) agent.Vlan {
+	members := make([]agent.VlanMember, len(vlan.GetMembers()))
+	for i, member := range vlan.GetMembers() {
+		members[i] = agent.VlanMember{
+			TypeMeta: agent.TypeMeta{
+				Kind: agent.VlanMemberKind,
+			},
+			VlanName:    member.GetVlanName(),
+			Interface:   member.GetInterfaceName(),
+			TaggingMode: member.GetTaggingMode(),
+		}
+	}
+
+	return agent.Vlan{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.VlanKind,
+		},
+		Name:    vlan.GetName(),
+		VlanID:  vlan.GetVlanId(),
+		Members: members,
+		State:   vlan.GetState(),
+	}
+}
+
+func (c *defaultSwitchAgentClient) CreateVlan(ctx context.Context, vlan *agent.Vlan) (*agent.Vlan, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.CreateVlan(ctx, &pb.CreateVlanRequest{
+		VlanId: vlan.VlanID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.Vlan{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to create vlan", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	created := protoToVlan(resp.GetVlan())
+	created.Status = agent.ProtoStatusToStatus(resp.GetStatus())
+	return &created, nil
+}
+
+func (c *defaultSwitchAgentClient) DeleteVlan(ctx context.Context, vlan *agent.Vlan) error {
+	cleanup, err := c.dial()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.DeleteVlan(ctx, &pb.DeleteVlanRequest{
+		VlanId: vlan.VlanID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return agenterrors.NewStatusError("failed to delete vlan", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	return nil
+}
+
+func (c *defaultSwitchAgentClient) ListVlans(ctx context.Context) (*agent.VlanList, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.ListVlans(ctx, &pb.ListVlansRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	vlans := make([]agent.Vlan, len(resp.GetVlans()))
+	for i, vlan := range resp.GetVlans() {
+		vlans[i] = protoToVlan(vlan)
+	}
+
+	return &agent.VlanList{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.VlanListKind,
+		},
+		Items:  vlans,
+		Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+	}, nil
+}
+
+func (c *defaultSwitchAgentClient) AddVlanMember(ctx context.Context, member *agent.VlanMember) (*agent.VlanMember, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	var vlanID uint32
+	if _, err := fmt.Sscanf(member.VlanName, "Vlan%d", &vlanID); err != nil {
+		return nil, fmt.Errorf("failed to parse vlan name %q: %w", member.VlanName, err)
+	}
+
+	resp, err := c.client.AddVlanMember(ctx, &pb.AddVlanMemberRequest{
+		VlanId:        vlanID,
+		InterfaceName: member.Interface,
+		TaggingMode:   member.TaggingMode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.VlanMember{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to add vlan member", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	return &agent.VlanMember{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.VlanMemberKind,
+		},
+		VlanName:    resp.GetMember().GetVlanName(),
+		Interface:   resp.GetMember().GetInterfaceName(),
+		TaggingMode: resp.GetMember().GetTaggingMode(),
+		Status:      agent.ProtoStatusToStatus(resp.GetStatus()),
+	}, nil
+}
+
+func (c *defaultSwitchAgentClient) RemoveVlanMember(ctx context.Context, member *agent.VlanMember) error {
+	cleanup, err := c.dial()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	var vlanID uint32
+	if _, err := fmt.Sscanf(member.VlanName, "Vlan%d", &vlanID); err != nil {
+		return fmt.Errorf("failed to parse vlan name %q: %w", member.VlanName, err)
+	}
+
+	resp, err := c.client.RemoveVlanMember(ctx, &pb.RemoveVlanMemberRequest{
+		VlanId:        vlanID,
+		InterfaceName: member.Interface,
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return agenterrors.NewStatusError("failed to remove vlan member", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	return nil
+}
+
+func protoToPortChannel(portChannel *pb.PortChannel) agent.PortChannel {
+	members := make([]agent.PortChannelMember, len(portChannel.GetMembers()))
+	for i, member := range portChannel.GetMembers() {
+		members[i] = agent.PortChannelMember{
+			TypeMeta: agent.TypeMeta{
+				Kind: agent.PortChannelMemberKind,
+			},
+			PortChannelName: member.GetPortChannelName(),
+			Interface:       member.GetInterfaceName(),
+			Selected:        member.GetSelected(),
+		}
+	}
+
+	return agent.PortChannel{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortChannelKind,
+		},
+		Name:            portChannel.GetName(),
+		Members:         members,
+		AdminStatus:     agent.DeviceStatus(portChannel.GetAdminStatus()),
+		OperationStatus: agent.DeviceStatus(portChannel.GetOperationalStatus()),
+	}
+}
+
+func (c *defaultSwitchAgentClient) CreatePortChannel(ctx context.Context, portChannel *agent.PortChannel) (*agent.PortChannel, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.CreatePortChannel(ctx, &pb.CreatePortChannelRequest{
+		Name:        portChannel.Name,
+		AdminStatus: string(portChannel.AdminStatus),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.PortChannel{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to create port channel", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	created := protoToPortChannel(resp.GetPortChannel())
+	created.Status = agent.ProtoStatusToStatus(resp.GetStatus())
+	return &created, nil
+}
+
+func (c *defaultSwitchAgentClient) DeletePortChannel(ctx context.Context, portChannel *agent.PortChannel) error {
+	cleanup, err := c.dial()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.DeletePortChannel(ctx, &pb.DeletePortChannelRequest{
+		Name: portChannel.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return agenterrors.NewStatusError("failed to delete port channel", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	return nil
+}
+
+func (c *defaultSwitchAgentClient) GetPortChannel(ctx context.Context, portChannel *agent.PortChannel) (*agent.PortChannel, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.GetPortChannel(ctx, &pb.GetPortChannelRequest{
+		Name: portChannel.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.PortChannel{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to get port channel", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	result := protoToPortChannel(resp.GetPortChannel())
+	result.Status = agent.ProtoStatusToStatus(resp.GetStatus())
+	return &result, nil
+}
+
+func (c *defaultSwitchAgentClient) ListPortChannels(ctx context.Context) (*agent.PortChannelList, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.ListPortChannels(ctx, &pb.ListPortChannelsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	portChannels := make([]agent.PortChannel, len(resp.GetPortChannels()))
+	for i, portChannel := range resp.GetPortChannels() {
+		portChannels[i] = protoToPortChannel(portChannel)
+	}
+
+	return &agent.PortChannelList{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortChannelListKind,
+		},
+		Items:  portChannels,
+		Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+	}, nil
+}
+
+func (c *defaultSwitchAgentClient) AddPortChannelMember(ctx context.Context, member *agent.PortChannelMember) (*agent.PortChannelMember, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.AddPortChannelMember(ctx, &pb.AddPortChannelMemberRequest{
+		Name:          member.PortChannelName,
+		InterfaceName: member.Interface,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.PortChannelMember{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to add port channel member", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	return &agent.PortChannelMember{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortChannelMemberKind,
+		},
+		PortChannelName: resp.GetMember().GetPortChannelName(),
+		Interface:       resp.GetMember().GetInterfaceName(),
+		Selected:        resp.GetMember().GetSelected(),
+		Status:          agent.ProtoStatusToStatus(resp.GetStatus()),
+	}, nil
+}
+
+func (c *defaultSwitchAgentClient) RemovePortChannelMember(ctx context.Context, member *agent.PortChannelMember) error {
+	cleanup, err := c.dial()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.RemovePortChannelMember(ctx, &pb.RemovePortChannelMemberRequest{
+		Name:          member.PortChannelName,
+		InterfaceName: member.Interface,
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return agenterrors.NewStatusError("failed to remove port channel member", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	return nil
+}
+
+func protoToInterfaceAddress(address *pb.InterfaceAddress) agent.InterfaceAddress {
+	return agent.InterfaceAddress{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceAddressKind,
+		},
+		Interface:  address.GetInterfaceName(),
+		Prefix:     address.GetPrefix(),
+		Configured: address.GetConfigured(),
+		Active:     address.GetActive(),
+	}
+}
+
+func (c *defaultSwitchAgentClient) ListInterfaceAddresses(ctx context.Context, iface *agent.Interface) (*agent.InterfaceAddressList, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.ListInterfaceAddresses(ctx, &pb.ListInterfaceAddressesRequest{
+		InterfaceName: iface.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.InterfaceAddressList{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to list interface addresses", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	addresses := make([]agent.InterfaceAddress, len(resp.GetAddresses()))
+	for i, address := range resp.GetAddresses() {
+		addresses[i] = protoToInterfaceAddress(address)
+	}
+
+	return &agent.InterfaceAddressList{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceAddressListKind,
+		},
+		Items:  addresses,
+		Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+	}, nil
+}
+
+func (c *defaultSwitchAgentClient) AddInterfaceAddress(ctx context.Context, address *agent.InterfaceAddress) (*agent.InterfaceAddress, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.AddInterfaceAddress(ctx, &pb.AddInterfaceAddressRequest{
+		InterfaceName: address.Interface,
+		Prefix:        address.Prefix,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.InterfaceAddress{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to add interface address", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	added := protoToInterfaceAddress(resp.GetAddress())
+	added.Status = agent.ProtoStatusToStatus(resp.GetStatus())
+	return &added, nil
+}
+
+func (c *defaultSwitchAgentClient) RemoveInterfaceAddress(ctx context.Context, address *agent.InterfaceAddress) error {
+	cleanup, err := c.dial()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.RemoveInterfaceAddress(ctx, &pb.RemoveInterfaceAddressRequest{
+		InterfaceName: address.Interface,
+		Prefix:        address.Prefix,
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return agenterrors.NewStatusError("failed to remove interface address", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	return nil
+}
+
+func protoToBGPNeighbor(neighbor *pb.BGPNeighbor) agent.BGPNeighbor {
+	return agent.BGPNeighbor{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.BGPNeighborKind,
+		},
+		Neighbor:         neighbor.GetNeighbor(),
+		Name:             neighbor.GetName(),
+		RemoteAS:         neighbor.GetRemoteAs(),
+		State:            neighbor.GetState(),
+		PrefixesReceived: neighbor.GetPrefixesReceived(),
+		Uptime:           neighbor.GetUptime(),
+		Configured:       neighbor.GetConfigured(),
+	}
+}
+
+func (c *defaultSwitchAgentClient) ListBGPNeighbors(ctx context.Context) (*agent.BGPNeighborList, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.ListBGPNeighbors(ctx, &pb.ListBGPNeighborsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.BGPNeighborList{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to list BGP neighbors", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	neighbors := make([]agent.BGPNeighbor, len(resp.GetNeighbors()))
+	for i, neighbor := range resp.GetNeighbors() {
+		neighbors[i] = protoToBGPNeighbor(neighbor)
+	}
+
+	return &agent.BGPNeighborList{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.BGPNeighborListKind,
+		},
+		Items:  neighbors,
+		Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+	}, nil
+}
+
+func (c *defaultSwitchAgentClient) AddBGPNeighbor(ctx context.Context, neighbor *agent.BGPNeighbor) (*agent.BGPNeighbor, error) {
+	cleanup, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.AddBGPNeighbor(ctx, &pb.AddBGPNeighborRequest{
+		InterfaceName: neighbor.Neighbor,
+		RemoteAs:      neighbor.RemoteAS,
+		Name:          neighbor.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return &agent.BGPNeighbor{
+			Status: agent.ProtoStatusToStatus(resp.GetStatus()),
+		}, agenterrors.NewStatusError("failed to add BGP neighbor", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	result := protoToBGPNeighbor(resp.GetNeighbor())
+	result.Status = agent.ProtoStatusToStatus(resp.GetStatus())
+	return &result, nil
+}
+
+func (c *defaultSwitchAgentClient) RemoveBGPNeighbor(ctx context.Context, neighbor *agent.BGPNeighbor) error {
+	cleanup, err := c.dial()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cleanup()
+	}()
+
+	resp, err := c.client.RemoveBGPNeighbor(ctx, &pb.RemoveBGPNeighborRequest{
+		InterfaceName: neighbor.Neighbor,
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.GetStatus().Code != 0 {
+		return agenterrors.NewStatusError("failed to remove BGP neighbor", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	return nil
+}
+
 func (c *defaultSwitchAgentClient) SaveConfig(ctx context.Context) error {
 	cleanup, err := c.dial()
 	if err != nil {
@@ -343,7 +1305,7 @@ func (c *defaultSwitchAgentClient) SaveConfig(ctx context.Context) error {
 	}
 
 	if resp.GetStatus().Code != 0 {
-		return fmt.Errorf("failed to save config: %s", resp.GetStatus().GetMessage())
+		return agenterrors.NewStatusError("failed to save config", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
 	}
 
 	return nil
@@ -130,6 +130,28 @@ func (t defaultTableConverter) ConvertToTable(v any) (*TableData, error) {
 		return t.portToTable([]agent.Port{*obj})
 	case *agent.InterfaceNeighbor:
 		return t.interfaceNeighborToTable([]agent.InterfaceNeighbor{*obj})
+	case *agent.InterfaceCounters:
+		return t.interfaceCountersToTable([]agent.InterfaceCounters{*obj})
+	case *agent.InterfaceCountersList:
+		return t.interfaceCountersToTable(obj.Items)
+	case *agent.Transceiver:
+		return t.transceiverToTable(*obj)
+	case *agent.PlatformHealth:
+		return t.platformHealthToTable(*obj)
+	case *agent.Vlan:
+		return t.vlanToTable([]agent.Vlan{*obj})
+	case *agent.VlanList:
+		return t.vlanToTable(obj.Items)
+	case *agent.PortChannel:
+		return t.portChannelToTable([]agent.PortChannel{*obj})
+	case *agent.PortChannelList:
+		return t.portChannelToTable(obj.Items)
+	case *agent.PortBreakoutList:
+		return t.portBreakoutToTable(obj.Items)
+	case *agent.BGPNeighborList:
+		return t.bgpNeighborToTable(obj.Items)
+	case *agent.InterfaceAddressList:
+		return t.interfaceAddressToTable(obj.Items)
 	}
 	return nil, fmt.Errorf("unsupported type %T for table conversion", v)
 }
@@ -150,7 +172,7 @@ func (t defaultTableConverter) deviceToTable(device agent.SwitchDevice) (*TableD
 }
 
 func (t defaultTableConverter) interfaceToTable(ifaces []agent.Interface) (*TableData, error) {
-	headers := []any{"Name", "Native Name", "Alias Name", "MAC Address", "Operation Status", "Admin Status"}
+	headers := []any{"Name", "Native Name", "Alias Name", "MAC Address", "Operation Status", "Admin Status", "MTU", "Speed", "FEC", "Autoneg"}
 	rows := make([][]any, 0, len(ifaces))
 
 	sort.Slice(ifaces, func(i, j int) bool {
@@ -168,6 +190,10 @@ func (t defaultTableConverter) interfaceToTable(ifaces []agent.Interface) (*Tabl
 			iface.MacAddress,
 			iface.OperationStatus,
 			iface.AdminStatus,
+			iface.MTU,
+			iface.Speed,
+			iface.FEC,
+			iface.Autoneg,
 		})
 	}
 
@@ -202,6 +228,170 @@ func (t defaultTableConverter) interfaceNeighborToTable(neighbors []agent.Interf
 	return &TableData{Headers: headers, Rows: rows}, nil
 }
 
+func (t defaultTableConverter) interfaceCountersToTable(counters []agent.InterfaceCounters) (*TableData, error) {
+	headers := []any{"Interface", "RX Octets", "RX Packets", "RX Errors", "RX Discards", "TX Octets", "TX Packets", "TX Errors", "TX Discards"}
+	rows := make([][]any, 0, len(counters))
+
+	for _, c := range counters {
+		rows = append(rows, []any{
+			c.Interface,
+			c.RxOctets,
+			c.RxPackets,
+			c.RxErrors,
+			c.RxDiscards,
+			c.TxOctets,
+			c.TxPackets,
+			c.TxErrors,
+			c.TxDiscards,
+		})
+	}
+
+	return &TableData{Headers: headers, Rows: rows}, nil
+}
+
+func (t defaultTableConverter) transceiverToTable(transceiver agent.Transceiver) (*TableData, error) {
+	headers := []any{"Interface", "Vendor", "Part Number", "Serial Number", "Type", "Temperature", "Voltage", "Lane", "RX Power", "TX Power"}
+	rows := make([][]any, 0, len(transceiver.Lanes))
+
+	info := []any{
+		transceiver.Interface,
+		transceiver.Vendor,
+		transceiver.PartNumber,
+		transceiver.SerialNumber,
+		transceiver.Type,
+		transceiver.Temperature,
+		transceiver.Voltage,
+	}
+	if len(transceiver.Lanes) == 0 {
+		rows = append(rows, append(info, "", "", ""))
+	}
+	for i, lane := range transceiver.Lanes {
+		// Only the first row carries the module information
+		if i > 0 {
+			info = make([]any, len(info))
+			for j := range info {
+				info[j] = ""
+			}
+		}
+		rows = append(rows, append(info, lane.Lane, lane.RxPower, lane.TxPower))
+	}
+
+	return &TableData{Headers: headers, Rows: rows}, nil
+}
+
+func (t defaultTableConverter) platformHealthToTable(health agent.PlatformHealth) (*TableData, error) {
+	headers := []any{"Component", "Name", "Present", "OK", "Reading"}
+	rows := make([][]any, 0, len(health.PSUs)+len(health.Fans)+len(health.Temperatures))
+
+	for _, psu := range health.PSUs {
+		rows = append(rows, []any{"PSU", psu.Name, psu.Presence, psu.OK, fmt.Sprintf("%s W", psu.Power)})
+	}
+	for _, fan := range health.Fans {
+		rows = append(rows, []any{"Fan", fan.Name, fan.Presence, fan.OK, fmt.Sprintf("%s %%", fan.Speed)})
+	}
+	for _, sensor := range health.Temperatures {
+		rows = append(rows, []any{"Temperature", sensor.Name, true, !sensor.Warning, fmt.Sprintf("%s C", sensor.Temperature)})
+	}
+
+	return &TableData{Headers: headers, Rows: rows}, nil
+}
+
+func (t defaultTableConverter) vlanToTable(vlans []agent.Vlan) (*TableData, error) {
+	headers := []any{"Name", "VLAN ID", "Members", "State"}
+	rows := make([][]any, 0, len(vlans))
+
+	for _, vlan := range vlans {
+		members := make([]string, 0, len(vlan.Members))
+		for _, member := range vlan.Members {
+			members = append(members, fmt.Sprintf("%s (%s)", member.Interface, member.TaggingMode))
+		}
+		rows = append(rows, []any{
+			vlan.Name,
+			vlan.VlanID,
+			strings.Join(members, ", "),
+			vlan.State,
+		})
+	}
+
+	return &TableData{Headers: headers, Rows: rows}, nil
+}
+
+func (t defaultTableConverter) portChannelToTable(portChannels []agent.PortChannel) (*TableData, error) {
+	headers := []any{"Name", "Members", "Operation Status", "Admin Status"}
+	rows := make([][]any, 0, len(portChannels))
+
+	for _, portChannel := range portChannels {
+		members := make([]string, 0, len(portChannel.Members))
+		for _, member := range portChannel.Members {
+			selected := "deselected"
+			if member.Selected {
+				selected = "selected"
+			}
+			members = append(members, fmt.Sprintf("%s (%s)", member.Interface, selected))
+		}
+		rows = append(rows, []any{
+			portChannel.Name,
+			strings.Join(members, ", "),
+			portChannel.OperationStatus,
+			portChannel.AdminStatus,
+		})
+	}
+
+	return &TableData{Headers: headers, Rows: rows}, nil
+}
+
+func (t defaultTableConverter) portBreakoutToTable(breakouts []agent.PortBreakout) (*TableData, error) {
+	headers := []any{"Port", "Mode", "Default Mode", "Supported Modes", "Ports"}
+	rows := make([][]any, 0, len(breakouts))
+
+	for _, breakout := range breakouts {
+		rows = append(rows, []any{
+			breakout.Port,
+			breakout.Mode,
+			breakout.DefaultMode,
+			strings.Join(breakout.SupportedModes, ", "),
+			strings.Join(breakout.Ports, ", "),
+		})
+	}
+
+	return &TableData{Headers: headers, Rows: rows}, nil
+}
+
+func (t defaultTableConverter) bgpNeighborToTable(neighbors []agent.BGPNeighbor) (*TableData, error) {
+	headers := []any{"Neighbor", "Name", "Remote AS", "State", "Prefixes Received", "Uptime", "Configured"}
+	rows := make([][]any, 0, len(neighbors))
+
+	for _, neighbor := range neighbors {
+		rows = append(rows, []any{
+			neighbor.Neighbor,
+			neighbor.Name,
+			neighbor.RemoteAS,
+			neighbor.State,
+			neighbor.PrefixesReceived,
+			neighbor.Uptime,
+			neighbor.Configured,
+		})
+	}
+
+	return &TableData{Headers: headers, Rows: rows}, nil
+}
+
+func (t defaultTableConverter) interfaceAddressToTable(addresses []agent.InterfaceAddress) (*TableData, error) {
+	headers := []any{"Interface", "Address", "Configured", "Active"}
+	rows := make([][]any, 0, len(addresses))
+
+	for _, address := range addresses {
+		rows = append(rows, []any{
+			address.Interface,
+			address.Prefix,
+			address.Configured,
+			address.Active,
+		})
+	}
+
+	return &TableData{Headers: headers, Rows: rows}, nil
+}
+
 var (
 	lightBoxStyle = table.BoxStyle{
 		BottomLeft:       "",
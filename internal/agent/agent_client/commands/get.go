@@ -21,8 +21,11 @@ func Get() *cobra.Command {
 
 	subcommands := []*cobra.Command{
 		GetDeviceInfo(printRenderer),
+		GetPlatformHealth(printRenderer),
 		GetInterface(printRenderer),
 		GetInterfaceNeighbor(printRenderer),
+		GetCounters(printRenderer),
+		GetTransceiver(printRenderer),
 	}
 
 	cmd.AddCommand(subcommands...)
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	client "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+
+	"github.com/spf13/cobra"
+)
+
+func GetCounters(printer client.PrintRenderer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "counters",
+		Short:   "Get interface counters, of all interfaces if none is given",
+		Example: "agent_cli get counters [interface-name]",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return RunListCounters(cmd.Context(), GetSharedSwitchAgentClient(), printer)
+			}
+			return RunGetCounters(cmd.Context(), GetSharedSwitchAgentClient(), printer, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func RunGetCounters(
+	ctx context.Context,
+	c client.SwitchAgentClient,
+	printer client.PrintRenderer,
+	interfaceName string,
+) error {
+	counters, err := c.GetInterfaceCounters(ctx, &agent.Interface{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceKind,
+		},
+		Name: interfaceName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get interface counters: %v", err)
+	}
+
+	return printer.Print("Interface Counters", os.Stdout, counters)
+}
+
+func RunListCounters(
+	ctx context.Context,
+	c client.SwitchAgentClient,
+	printer client.PrintRenderer,
+) error {
+	counters, err := c.ListInterfaceCounters(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list interface counters: %v", err)
+	}
+
+	return printer.Print("Interface Counters", os.Stdout, counters)
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strings"
 
 	client "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
 	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
@@ -35,30 +34,14 @@ func RunGetInterface(
 	printer client.PrintRenderer,
 	interfaceName string,
 ) error {
-	var iface *agent.Interface
-	var err error
-	// Determine if the provided name is a native name (e.g., "Ethernet0") or an abstract name (eth0-0")
-	if strings.HasPrefix(interfaceName, "Ethernet") {
-		var abstractName string
-		abstractName, err = agent.NativeNameToAbstractName(interfaceName)
-		if err != nil {
-			return fmt.Errorf("failed to convert native name to abstract name: %v", err)
-		}
-		iface, err = c.GetInterfaceByAbstractName(ctx, &agent.Interface{
-			TypeMeta: agent.TypeMeta{
-				Kind: agent.InterfaceKind,
-			},
-			Name: abstractName,
-		})
-	} else if strings.HasPrefix(interfaceName, "eth") {
-		iface, err = c.GetInterfaceByAbstractName(ctx, &agent.Interface{
-			TypeMeta: agent.TypeMeta{
-				Kind: agent.InterfaceKind,
-			},
-			Name: interfaceName,
-		})
-	}
-
+	// The agent accepts native (e.g., "Ethernet0") and abstract (e.g., "eth0-0") names
+	// and resolves them with the naming scheme of its platform.
+	iface, err := c.GetInterfaceByAbstractName(ctx, &agent.Interface{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceKind,
+		},
+		Name: interfaceName,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to get interface info: %v", err)
 	}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strings"
 
 	client "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
 	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
@@ -35,26 +34,12 @@ func RunGetInterfaceNeighbors(
 	printer client.PrintRenderer,
 	interfaceName string,
 ) error {
-	var abstractName string
-
-	if strings.HasPrefix(interfaceName, "Ethernet") {
-		var err error
-		abstractName, err = agent.NativeNameToAbstractName(interfaceName)
-		if err != nil {
-			return fmt.Errorf("failed to convert native name to abstract name: %v", err)
-		}
-
-	} else if strings.HasPrefix(interfaceName, "eth") {
-		abstractName = interfaceName
-	} else {
-		return fmt.Errorf("invalid interface name: %s. Must start with 'Ethernet' or 'eth'", interfaceName)
-	}
-
+	// The agent accepts native and abstract names and resolves them with the naming scheme of its platform
 	ifaceNeigh, err := c.GetInterfaceNeighbor(ctx, &agent.Interface{
 		TypeMeta: agent.TypeMeta{
 			Kind: agent.InterfaceKind,
 		},
-		Name: abstractName,
+		Name: interfaceName,
 	})
 
 	if err != nil {
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	client "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
+
+	"github.com/spf13/cobra"
+)
+
+func GetPlatformHealth(printer client.PrintRenderer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "platform-health",
+		Short:   "Get PSU, fan and temperature sensor health",
+		Example: "agent_cli get platform-health",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunGetPlatformHealth(cmd.Context(), GetSharedSwitchAgentClient(), printer)
+		},
+	}
+
+	return cmd
+}
+
+func RunGetPlatformHealth(
+	ctx context.Context,
+	c client.SwitchAgentClient,
+	printer client.PrintRenderer,
+) error {
+	health, err := c.GetPlatformHealth(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get platform health: %v", err)
+	}
+
+	return printer.Print("Platform Health", os.Stdout, health)
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	client "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+
+	"github.com/spf13/cobra"
+)
+
+func GetTransceiver(printer client.PrintRenderer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transceiver",
+		Short:   "Get transceiver inventory and DOM readings of an interface",
+		Example: "agent_cli get transceiver <interface-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunGetTransceiver(cmd.Context(), GetSharedSwitchAgentClient(), printer, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func RunGetTransceiver(
+	ctx context.Context,
+	c client.SwitchAgentClient,
+	printer client.PrintRenderer,
+	interfaceName string,
+) error {
+	transceiver, err := c.GetTransceiver(ctx, &agent.Interface{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceKind,
+		},
+		Name: interfaceName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get transceiver: %v", err)
+	}
+
+	return printer.Print("Transceiver", os.Stdout, transceiver)
+}
@@ -22,6 +22,11 @@ func List() *cobra.Command {
 	subcommands := []*cobra.Command{
 		ListInterfaces(printRenderer),
 		ListPorts(printRenderer),
+		ListVlans(printRenderer),
+		ListBreakouts(printRenderer),
+		ListBGPNeighbors(printRenderer),
+		ListPortChannels(printRenderer),
+		ListAddresses(printRenderer),
 	}
 
 	cmd.AddCommand(subcommands...)
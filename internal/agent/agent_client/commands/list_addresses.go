@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	client "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+
+	"github.com/spf13/cobra"
+)
+
+func ListAddresses(printer client.PrintRenderer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "addresses",
+		Short:   "List the IP addresses of an interface",
+		Example: "agent_cli list addresses <interface-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunListAddresses(cmd.Context(), GetSharedSwitchAgentClient(), printer, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func RunListAddresses(
+	ctx context.Context,
+	c client.SwitchAgentClient,
+	printer client.PrintRenderer,
+	interfaceName string,
+) error {
+	addresses, err := c.ListInterfaceAddresses(ctx, &agent.Interface{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceKind,
+		},
+		Name: interfaceName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list interface addresses: %v", err)
+	}
+
+	return printer.Print("Interface Addresses", os.Stdout, addresses)
+}
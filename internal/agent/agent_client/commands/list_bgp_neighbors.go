@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	client "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
+
+	"github.com/spf13/cobra"
+)
+
+func ListBGPNeighbors(printer client.PrintRenderer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bgp-neighbors",
+		Short:   "List BGP neighbors and their session state",
+		Example: "agent_cli list bgp-neighbors",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunListBGPNeighbors(cmd.Context(), GetSharedSwitchAgentClient(), printer)
+		},
+	}
+
+	return cmd
+}
+
+func RunListBGPNeighbors(
+	ctx context.Context,
+	c client.SwitchAgentClient,
+	printer client.PrintRenderer,
+) error {
+	neighbors, err := c.ListBGPNeighbors(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list BGP neighbors: %v", err)
+	}
+
+	return printer.Print("BGP Neighbors", os.Stdout, neighbors)
+}
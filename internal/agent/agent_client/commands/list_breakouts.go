@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	client "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
+
+	"github.com/spf13/cobra"
+)
+
+func ListBreakouts(printer client.PrintRenderer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "breakouts",
+		Short:   "List port breakout modes",
+		Example: "agent_cli list breakouts",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunListBreakouts(cmd.Context(), GetSharedSwitchAgentClient(), printer)
+		},
+	}
+
+	return cmd
+}
+
+func RunListBreakouts(
+	ctx context.Context,
+	c client.SwitchAgentClient,
+	printer client.PrintRenderer,
+) error {
+	breakouts, err := c.ListPortBreakouts(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list port breakouts: %v", err)
+	}
+
+	return printer.Print("Port Breakouts", os.Stdout, breakouts)
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	client "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
+
+	"github.com/spf13/cobra"
+)
+
+func ListPortChannels(printer client.PrintRenderer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "portchannels",
+		Short:   "List port channels",
+		Example: "agent_cli list portchannels",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunListPortChannels(cmd.Context(), GetSharedSwitchAgentClient(), printer)
+		},
+	}
+
+	return cmd
+}
+
+func RunListPortChannels(
+	ctx context.Context,
+	c client.SwitchAgentClient,
+	printer client.PrintRenderer,
+) error {
+	portChannels, err := c.ListPortChannels(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list port channels: %v", err)
+	}
+
+	return printer.Print("PortChannels", os.Stdout, portChannels)
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	client "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
+
+	"github.com/spf13/cobra"
+)
+
+func ListVlans(printer client.PrintRenderer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "vlans",
+		Short:   "List VLANs",
+		Example: "agent_cli list vlans",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunListVlans(cmd.Context(), GetSharedSwitchAgentClient(), printer)
+		},
+	}
+
+	return cmd
+}
+
+func RunListVlans(
+	ctx context.Context,
+	c client.SwitchAgentClient,
+	printer client.PrintRenderer,
+) error {
+	vlans, err := c.ListVlans(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list vlans: %v", err)
+	}
+
+	return printer.Print("VLANs", os.Stdout, vlans)
+}
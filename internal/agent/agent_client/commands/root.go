@@ -23,6 +23,7 @@ func SubcommandRequired(cmd *cobra.Command, args []string) error {
 var switchAgentClient client.SwitchAgentClient
 var address string
 var connectTimeout time.Duration
+var caCertFile, certFile, keyFile, token string
 
 func GetSharedSwitchAgentClient() client.SwitchAgentClient {
 	return switchAgentClient
@@ -52,10 +53,21 @@ func Command() *cobra.Command {
 	}
 	cmd.PersistentFlags().StringVar(&address, "address", "localhost:"+grpcPort, "switch proxy address (overrides SWITCH_PROXY_GRPC_PORT).")
 	cmd.PersistentFlags().DurationVar(&connectTimeout, "connect-timeout", 4*time.Second, "Timeout to connect to the switch proxy.")
+	cmd.PersistentFlags().StringVar(&caCertFile, "tls-ca-file", "", "CA bundle to verify the switch proxy certificate. If neither this nor a client certificate is set, TLS is disabled.")
+	cmd.PersistentFlags().StringVar(&certFile, "tls-cert-file", "", "Client certificate presented to the switch proxy.")
+	cmd.PersistentFlags().StringVar(&keyFile, "tls-key-file", "", "Client key presented to the switch proxy.")
+	cmd.PersistentFlags().StringVar(&token, "token", os.Getenv("SWITCH_PROXY_TOKEN"), "Bearer token presented to the switch proxy (overrides SWITCH_PROXY_TOKEN).")
 
 	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
-		var err error
-		switchAgentClient, err = client.NewDefaultSwitchAgentClient(address, connectTimeout)
+		creds, err := loadCredentials()
+		if err != nil {
+			return err
+		}
+		opts, err := creds.DialOptions()
+		if err != nil {
+			return err
+		}
+		switchAgentClient, err = client.NewDefaultSwitchAgentClient(address, connectTimeout, opts...)
 		if err != nil {
 			return err
 		}
@@ -63,3 +75,26 @@ func Command() *cobra.Command {
 	}
 	return cmd
 }
+
+func loadCredentials() (*client.Credentials, error) {
+	creds := &client.Credentials{
+		Token:    token,
+		Insecure: caCertFile == "" && certFile == "",
+	}
+
+	var err error
+	if caCertFile != "" {
+		if creds.CACert, err = os.ReadFile(caCertFile); err != nil {
+			return nil, err
+		}
+	}
+	if certFile != "" {
+		if creds.Cert, err = os.ReadFile(certFile); err != nil {
+			return nil, err
+		}
+		if creds.Key, err = os.ReadFile(keyFile); err != nil {
+			return nil, err
+		}
+	}
+	return creds, nil
+}
@@ -9,6 +9,9 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"strconv"
+	"strings"
+	"time"
 
 	pb "github.com/ironcore-dev/sonic-operator/internal/agent/proto"
 	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
@@ -17,12 +20,27 @@ import (
 	"github.com/ironcore-dev/sonic-operator/internal/agent/sonic"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 )
 
 var (
-	port      = flag.Int("port", 50051, "The server port")
-	redisAddr = flag.String("redis-addr", "127.0.0.1:6379", "The Redis address")
+	port        = flag.Int("port", 50051, "The server port")
+	bindAddress = flag.String("bind-address", "0.0.0.0", "The address the server listens on")
+	redisAddr   = flag.String("redis-addr", "127.0.0.1:6379", "The Redis address")
+
+	bgpStateSource = flag.String("bgp-state-source", "vtysh", "The source of the BGP session state, either vtysh or state-db")
+	vtyshCommand   = flag.String("vtysh-command", "vtysh", "The command used to run vtysh, e.g., 'docker exec bgp vtysh'")
+
+	nameTableFile           = flag.String("name-table-file", "", "A YAML file with explicit tables of native to abstract interface names by HWSKU")
+	deriveNamesFromPlatform = flag.Bool("derive-names-from-platform", false, "Derive the abstract interface names from the lane data of the platform if no name table matches the HWSKU. This renames the interfaces of most platforms, see the documentation before enabling it")
+
+	tlsCertFile     = flag.String("tls-cert-file", "", "The PEM encoded server certificate. If unset, the server does not use TLS")
+	tlsKeyFile      = flag.String("tls-key-file", "", "The PEM encoded server key")
+	tlsClientCAFile = flag.String("tls-client-ca-file", "", "The PEM encoded CA bundle used to verify client certificates. If set, clients must present a certificate (mTLS)")
+	tokenFile       = flag.String("token-file", "", "A file containing the bearer token clients must present. If unset, no token is required")
 )
 
 type proxyServer struct {
@@ -58,6 +76,79 @@ func (s *proxyServer) GetDeviceInfo(ctx context.Context, request *pb.GetDeviceIn
 	}, nil
 }
 
+func (s *proxyServer) GetPlatformHealth(ctx context.Context, request *pb.GetPlatformHealthRequest) (*pb.GetPlatformHealthResponse, error) {
+	log.Printf("GetPlatformHealth called")
+
+	health, status := s.SwitchAgent.GetPlatformHealth(ctx)
+	if status != nil {
+		return &pb.GetPlatformHealthResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to get platform health: %v", status.Message),
+			},
+		}, nil
+	}
+
+	psus := make([]*pb.PSU, 0, len(health.PSUs))
+	for _, psu := range health.PSUs {
+		psus = append(psus, &pb.PSU{
+			Name:     psu.Name,
+			Presence: psu.Presence,
+			Ok:       psu.OK,
+			Model:    psu.Model,
+			Serial:   psu.Serial,
+			Power:    psu.Power,
+		})
+	}
+
+	fans := make([]*pb.Fan, 0, len(health.Fans))
+	for _, fan := range health.Fans {
+		fans = append(fans, &pb.Fan{
+			Name:      fan.Name,
+			Presence:  fan.Presence,
+			Ok:        fan.OK,
+			Speed:     fan.Speed,
+			Direction: fan.Direction,
+		})
+	}
+
+	temperatures := make([]*pb.TemperatureSensor, 0, len(health.Temperatures))
+	for _, sensor := range health.Temperatures {
+		temperatures = append(temperatures, &pb.TemperatureSensor{
+			Name:                  sensor.Name,
+			Temperature:           sensor.Temperature,
+			HighThreshold:         sensor.HighThreshold,
+			CriticalHighThreshold: sensor.CriticalHighThreshold,
+			Warning:               sensor.Warning,
+		})
+	}
+
+	return &pb.GetPlatformHealthResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		Psus:         psus,
+		Fans:         fans,
+		Temperatures: temperatures,
+	}, nil
+}
+
+func interfaceToProto(iface *agent.Interface) *pb.Interface {
+	return &pb.Interface{
+		Name:              iface.Name,
+		NativeName:        iface.NativeName,
+		AliasName:         iface.AliasName,
+		MacAddress:        iface.MacAddress,
+		OperationalStatus: string(iface.OperationStatus),
+		AdminStatus:       string(iface.AdminStatus),
+		Mtu:               iface.MTU,
+		Speed:             iface.Speed,
+		Fec:               iface.FEC,
+		Autoneg:           iface.Autoneg,
+	}
+}
+
 func (s *proxyServer) ListInterfaces(ctx context.Context, request *pb.ListInterfacesRequest) (*pb.ListInterfacesResponse, error) {
 	log.Printf("ListInterfaces called")
 
@@ -73,14 +164,7 @@ func (s *proxyServer) ListInterfaces(ctx context.Context, request *pb.ListInterf
 
 	var interfaces = make([]*pb.Interface, 0, len(interfaceList.Items))
 	for _, iface := range interfaceList.Items {
-		interfaces = append(interfaces, &pb.Interface{
-			Name:              iface.Name,
-			NativeName:        iface.NativeName,
-			AliasName:         iface.AliasName,
-			MacAddress:        iface.MacAddress,
-			OperationalStatus: string(iface.OperationStatus),
-			AdminStatus:       string(iface.AdminStatus),
-		})
+		interfaces = append(interfaces, interfaceToProto(&iface))
 	}
 
 	return &pb.ListInterfacesResponse{
@@ -156,6 +240,71 @@ func (s *proxyServer) ListPorts(ctx context.Context, request *pb.ListPortsReques
 	}, nil
 }
 
+func portBreakoutToProto(breakout *agent.PortBreakout) *pb.PortBreakout {
+	return &pb.PortBreakout{
+		Port:           breakout.Port,
+		Mode:           breakout.Mode,
+		DefaultMode:    breakout.DefaultMode,
+		SupportedModes: breakout.SupportedModes,
+		Ports:          breakout.Ports,
+	}
+}
+
+func (s *proxyServer) ListPortBreakouts(ctx context.Context, request *pb.ListPortBreakoutsRequest) (*pb.ListPortBreakoutsResponse, error) {
+	log.Printf("ListPortBreakouts called")
+
+	breakoutList, status := s.SwitchAgent.ListPortBreakouts(ctx)
+	if status != nil {
+		return &pb.ListPortBreakoutsResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to list port breakouts: %v", status.Message),
+			},
+		}, nil
+	}
+
+	var breakouts = make([]*pb.PortBreakout, 0, len(breakoutList.Items))
+	for _, breakout := range breakoutList.Items {
+		breakouts = append(breakouts, portBreakoutToProto(&breakout))
+	}
+
+	return &pb.ListPortBreakoutsResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		Breakouts: breakouts,
+	}, nil
+}
+
+func (s *proxyServer) SetPortBreakout(ctx context.Context, request *pb.SetPortBreakoutRequest) (*pb.SetPortBreakoutResponse, error) {
+	log.Printf("SetPortBreakout called: port=%s, mode=%s", request.GetPort(), request.GetMode())
+
+	breakout, status := s.SwitchAgent.SetPortBreakout(ctx, &agent.PortBreakout{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortBreakoutKind,
+		},
+		Port: request.GetPort(),
+		Mode: request.GetMode(),
+	})
+	if status != nil {
+		return &pb.SetPortBreakoutResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to set port breakout: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.SetPortBreakoutResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		Breakout: portBreakoutToProto(breakout),
+	}, nil
+}
+
 func (s *proxyServer) GetInterface(ctx context.Context, request *pb.GetInterfaceRequest) (*pb.GetInterfaceResponse, error) {
 	log.Printf("GetInterface called: interface=%s", request.GetInterfaceName())
 
@@ -179,14 +328,7 @@ func (s *proxyServer) GetInterface(ctx context.Context, request *pb.GetInterface
 			Code:    0,
 			Message: "Success",
 		},
-		Interface: &pb.Interface{
-			Name:              iface.Name,
-			NativeName:        iface.NativeName,
-			AliasName:         iface.AliasName,
-			MacAddress:        iface.MacAddress,
-			OperationalStatus: string(iface.OperationStatus),
-			AdminStatus:       string(iface.AdminStatus),
-		},
+		Interface: interfaceToProto(iface),
 	}, nil
 }
 
@@ -226,6 +368,37 @@ func (s *proxyServer) SetInterfaceAliasName(ctx context.Context, request *pb.Set
 	}, nil
 }
 
+func (s *proxyServer) SetInterfacePortAttributes(ctx context.Context, request *pb.SetInterfacePortAttributesRequest) (*pb.SetInterfacePortAttributesResponse, error) {
+	log.Printf("SetInterfacePortAttributes called: interface=%s, mtu=%d, speed=%d, fec=%s, autoneg=%s", request.GetInterfaceName(), request.GetMtu(), request.GetSpeed(), request.GetFec(), request.GetAutoneg())
+
+	iface, status := s.SwitchAgent.SetInterfacePortAttributes(ctx, &agent.Interface{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceKind,
+		},
+		Name:    request.GetInterfaceName(),
+		MTU:     request.GetMtu(),
+		Speed:   request.GetSpeed(),
+		FEC:     request.GetFec(),
+		Autoneg: request.GetAutoneg(),
+	})
+	if status != nil {
+		return &pb.SetInterfacePortAttributesResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to set interface port attributes: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.SetInterfacePortAttributesResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		Interface: interfaceToProto(iface),
+	}, nil
+}
+
 func (s *proxyServer) GetInterfaceNeighbor(ctx context.Context, request *pb.GetInterfaceNeighborRequest) (*pb.GetInterfaceNeighborResponse, error) {
 	log.Printf("GetInterfaceNeighbor called: interface=%s", request.GetInterfaceName())
 
@@ -258,6 +431,137 @@ func (s *proxyServer) GetInterfaceNeighbor(ctx context.Context, request *pb.GetI
 	}, nil
 }
 
+func interfaceCountersToProto(counters *agent.InterfaceCounters) *pb.InterfaceCounters {
+	return &pb.InterfaceCounters{
+		Interface:  counters.Interface,
+		RxOctets:   counters.RxOctets,
+		RxPackets:  counters.RxPackets,
+		RxErrors:   counters.RxErrors,
+		RxDiscards: counters.RxDiscards,
+		TxOctets:   counters.TxOctets,
+		TxPackets:  counters.TxPackets,
+		TxErrors:   counters.TxErrors,
+		TxDiscards: counters.TxDiscards,
+	}
+}
+
+func (s *proxyServer) GetInterfaceCounters(ctx context.Context, request *pb.GetInterfaceCountersRequest) (*pb.GetInterfaceCountersResponse, error) {
+	log.Printf("GetInterfaceCounters called: interface=%s", request.GetInterfaceName())
+
+	counters, status := s.SwitchAgent.GetInterfaceCounters(ctx, &agent.Interface{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceKind,
+		},
+		Name: request.GetInterfaceName(),
+	})
+	if status != nil {
+		return &pb.GetInterfaceCountersResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to get interface counters: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.GetInterfaceCountersResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		Counters: interfaceCountersToProto(counters),
+	}, nil
+}
+
+func (s *proxyServer) ListInterfaceCounters(ctx context.Context, request *pb.ListInterfaceCountersRequest) (*pb.ListInterfaceCountersResponse, error) {
+	log.Printf("ListInterfaceCounters called")
+
+	countersList, status := s.SwitchAgent.ListInterfaceCounters(ctx)
+	if status != nil {
+		return &pb.ListInterfaceCountersResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to list interface counters: %v", status.Message),
+			},
+		}, nil
+	}
+
+	var counters = make([]*pb.InterfaceCounters, 0, len(countersList.Items))
+	for _, c := range countersList.Items {
+		counters = append(counters, interfaceCountersToProto(&c))
+	}
+
+	return &pb.ListInterfaceCountersResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		Counters: counters,
+	}, nil
+}
+
+func (s *proxyServer) GetTransceiver(ctx context.Context, request *pb.GetTransceiverRequest) (*pb.GetTransceiverResponse, error) {
+	log.Printf("GetTransceiver called: interface=%s", request.GetInterfaceName())
+
+	transceiver, status := s.SwitchAgent.GetTransceiver(ctx, &agent.Interface{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceKind,
+		},
+		Name: request.GetInterfaceName(),
+	})
+	if status != nil {
+		return &pb.GetTransceiverResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to get transceiver: %v", status.Message),
+			},
+		}, nil
+	}
+
+	lanes := make([]*pb.TransceiverLane, 0, len(transceiver.Lanes))
+	for _, lane := range transceiver.Lanes {
+		lanes = append(lanes, &pb.TransceiverLane{
+			Lane:    lane.Lane,
+			RxPower: lane.RxPower,
+			TxPower: lane.TxPower,
+		})
+	}
+
+	return &pb.GetTransceiverResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		Transceiver: &pb.Transceiver{
+			Interface:    transceiver.Interface,
+			Vendor:       transceiver.Vendor,
+			PartNumber:   transceiver.PartNumber,
+			SerialNumber: transceiver.SerialNumber,
+			Type:         transceiver.Type,
+			Temperature:  transceiver.Temperature,
+			Voltage:      transceiver.Voltage,
+			Lanes:        lanes,
+		},
+	}, nil
+}
+
+func (s *proxyServer) WatchInterfaces(request *pb.WatchInterfacesRequest, stream grpc.ServerStreamingServer[pb.InterfaceEvent]) error {
+	log.Printf("WatchInterfaces called")
+
+	status := s.SwitchAgent.WatchInterfaces(stream.Context(), func(event *agent.InterfaceEvent) error {
+		return stream.Send(&pb.InterfaceEvent{
+			Name:              event.Name,
+			NativeName:        event.NativeName,
+			AdminStatus:       string(event.AdminStatus),
+			OperationalStatus: string(event.OperationStatus),
+		})
+	})
+	if status != nil {
+		return fmt.Errorf("failed to watch interfaces: %v", status.Message)
+	}
+
+	return nil
+}
+
 func (s *proxyServer) SaveConfig(ctx context.Context, request *pb.SaveConfigRequest) (*pb.SaveConfigResponse, error) {
 	log.Printf("SaveConfig called")
 
@@ -279,6 +583,539 @@ func (s *proxyServer) SaveConfig(ctx context.Context, request *pb.SaveConfigRequ
 	}, nil
 }
 
+func vlanToProto(vlan *agent.Vlan) *pb.Vlan {
+	members := make([]*pb.VlanMember, 0, len(vlan.Members))
+	for _, member := range vlan.Members {
+		members = append(members, &pb.VlanMember{
+			VlanName:      member.VlanName,
+			InterfaceName: member.Interface,
+			TaggingMode:   member.TaggingMode,
+		})
+	}
+
+	return &pb.Vlan{
+		Name:    vlan.Name,
+		VlanId:  vlan.VlanID,
+		Members: members,
+		State:   vlan.State,
+	}
+}
+
+func (s *proxyServer) CreateVlan(ctx context.Context, request *pb.CreateVlanRequest) (*pb.CreateVlanResponse, error) {
+	log.Printf("CreateVlan called: vlan=%d", request.GetVlanId())
+
+	vlan, status := s.SwitchAgent.CreateVlan(ctx, &agent.Vlan{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.VlanKind,
+		},
+		VlanID: request.GetVlanId(),
+	})
+	if status != nil {
+		return &pb.CreateVlanResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to create vlan: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.CreateVlanResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		Vlan: vlanToProto(vlan),
+	}, nil
+}
+
+func (s *proxyServer) DeleteVlan(ctx context.Context, request *pb.DeleteVlanRequest) (*pb.DeleteVlanResponse, error) {
+	log.Printf("DeleteVlan called: vlan=%d", request.GetVlanId())
+
+	status := s.SwitchAgent.DeleteVlan(ctx, &agent.Vlan{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.VlanKind,
+		},
+		VlanID: request.GetVlanId(),
+	})
+	if status != nil {
+		return &pb.DeleteVlanResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to delete vlan: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.DeleteVlanResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+	}, nil
+}
+
+func (s *proxyServer) ListVlans(ctx context.Context, request *pb.ListVlansRequest) (*pb.ListVlansResponse, error) {
+	log.Printf("ListVlans called")
+
+	vlanList, status := s.SwitchAgent.ListVlans(ctx)
+	if status != nil {
+		return &pb.ListVlansResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to list vlans: %v", status.Message),
+			},
+		}, nil
+	}
+
+	var vlans = make([]*pb.Vlan, 0, len(vlanList.Items))
+	for _, vlan := range vlanList.Items {
+		vlans = append(vlans, vlanToProto(&vlan))
+	}
+
+	return &pb.ListVlansResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		Vlans: vlans,
+	}, nil
+}
+
+func (s *proxyServer) AddVlanMember(ctx context.Context, request *pb.AddVlanMemberRequest) (*pb.AddVlanMemberResponse, error) {
+	log.Printf("AddVlanMember called: vlan=%d, interface=%s, tagging_mode=%s", request.GetVlanId(), request.GetInterfaceName(), request.GetTaggingMode())
+
+	member, status := s.SwitchAgent.AddVlanMember(ctx, &agent.VlanMember{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.VlanMemberKind,
+		},
+		VlanName:    fmt.Sprintf("Vlan%d", request.GetVlanId()),
+		Interface:   request.GetInterfaceName(),
+		TaggingMode: request.GetTaggingMode(),
+	})
+	if status != nil {
+		return &pb.AddVlanMemberResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to add vlan member: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.AddVlanMemberResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		Member: &pb.VlanMember{
+			VlanName:      member.VlanName,
+			InterfaceName: member.Interface,
+			TaggingMode:   member.TaggingMode,
+		},
+	}, nil
+}
+
+func (s *proxyServer) RemoveVlanMember(ctx context.Context, request *pb.RemoveVlanMemberRequest) (*pb.RemoveVlanMemberResponse, error) {
+	log.Printf("RemoveVlanMember called: vlan=%d, interface=%s", request.GetVlanId(), request.GetInterfaceName())
+
+	status := s.SwitchAgent.RemoveVlanMember(ctx, &agent.VlanMember{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.VlanMemberKind,
+		},
+		VlanName:  fmt.Sprintf("Vlan%d", request.GetVlanId()),
+		Interface: request.GetInterfaceName(),
+	})
+	if status != nil {
+		return &pb.RemoveVlanMemberResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to remove vlan member: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.RemoveVlanMemberResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+	}, nil
+}
+
+func portChannelToProto(portChannel *agent.PortChannel) *pb.PortChannel {
+	members := make([]*pb.PortChannelMember, 0, len(portChannel.Members))
+	for _, member := range portChannel.Members {
+		members = append(members, &pb.PortChannelMember{
+			PortChannelName: member.PortChannelName,
+			InterfaceName:   member.Interface,
+			Selected:        member.Selected,
+		})
+	}
+
+	return &pb.PortChannel{
+		Name:              portChannel.Name,
+		Members:           members,
+		AdminStatus:       string(portChannel.AdminStatus),
+		OperationalStatus: string(portChannel.OperationStatus),
+	}
+}
+
+func (s *proxyServer) CreatePortChannel(ctx context.Context, request *pb.CreatePortChannelRequest) (*pb.CreatePortChannelResponse, error) {
+	log.Printf("CreatePortChannel called: name=%s, admin_status=%s", request.GetName(), request.GetAdminStatus())
+
+	portChannel, status := s.SwitchAgent.CreatePortChannel(ctx, &agent.PortChannel{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortChannelKind,
+		},
+		Name:        request.GetName(),
+		AdminStatus: agent.DeviceStatus(request.GetAdminStatus()),
+	})
+	if status != nil {
+		return &pb.CreatePortChannelResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to create port channel: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.CreatePortChannelResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		PortChannel: portChannelToProto(portChannel),
+	}, nil
+}
+
+func (s *proxyServer) DeletePortChannel(ctx context.Context, request *pb.DeletePortChannelRequest) (*pb.DeletePortChannelResponse, error) {
+	log.Printf("DeletePortChannel called: name=%s", request.GetName())
+
+	status := s.SwitchAgent.DeletePortChannel(ctx, &agent.PortChannel{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortChannelKind,
+		},
+		Name: request.GetName(),
+	})
+	if status != nil {
+		return &pb.DeletePortChannelResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to delete port channel: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.DeletePortChannelResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+	}, nil
+}
+
+func (s *proxyServer) GetPortChannel(ctx context.Context, request *pb.GetPortChannelRequest) (*pb.GetPortChannelResponse, error) {
+	log.Printf("GetPortChannel called: name=%s", request.GetName())
+
+	portChannel, status := s.SwitchAgent.GetPortChannel(ctx, &agent.PortChannel{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortChannelKind,
+		},
+		Name: request.GetName(),
+	})
+	if status != nil {
+		return &pb.GetPortChannelResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to get port channel: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.GetPortChannelResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		PortChannel: portChannelToProto(portChannel),
+	}, nil
+}
+
+func (s *proxyServer) ListPortChannels(ctx context.Context, request *pb.ListPortChannelsRequest) (*pb.ListPortChannelsResponse, error) {
+	log.Printf("ListPortChannels called")
+
+	portChannelList, status := s.SwitchAgent.ListPortChannels(ctx)
+	if status != nil {
+		return &pb.ListPortChannelsResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to list port channels: %v", status.Message),
+			},
+		}, nil
+	}
+
+	var portChannels = make([]*pb.PortChannel, 0, len(portChannelList.Items))
+	for _, portChannel := range portChannelList.Items {
+		portChannels = append(portChannels, portChannelToProto(&portChannel))
+	}
+
+	return &pb.ListPortChannelsResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		PortChannels: portChannels,
+	}, nil
+}
+
+func (s *proxyServer) AddPortChannelMember(ctx context.Context, request *pb.AddPortChannelMemberRequest) (*pb.AddPortChannelMemberResponse, error) {
+	log.Printf("AddPortChannelMember called: name=%s, interface=%s", request.GetName(), request.GetInterfaceName())
+
+	member, status := s.SwitchAgent.AddPortChannelMember(ctx, &agent.PortChannelMember{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortChannelMemberKind,
+		},
+		PortChannelName: request.GetName(),
+		Interface:       request.GetInterfaceName(),
+	})
+	if status != nil {
+		return &pb.AddPortChannelMemberResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to add port channel member: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.AddPortChannelMemberResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		Member: &pb.PortChannelMember{
+			PortChannelName: member.PortChannelName,
+			InterfaceName:   member.Interface,
+			Selected:        member.Selected,
+		},
+	}, nil
+}
+
+func (s *proxyServer) RemovePortChannelMember(ctx context.Context, request *pb.RemovePortChannelMemberRequest) (*pb.RemovePortChannelMemberResponse, error) {
+	log.Printf("RemovePortChannelMember called: name=%s, interface=%s", request.GetName(), request.GetInterfaceName())
+
+	status := s.SwitchAgent.RemovePortChannelMember(ctx, &agent.PortChannelMember{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortChannelMemberKind,
+		},
+		PortChannelName: request.GetName(),
+		Interface:       request.GetInterfaceName(),
+	})
+	if status != nil {
+		return &pb.RemovePortChannelMemberResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to remove port channel member: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.RemovePortChannelMemberResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+	}, nil
+}
+
+func interfaceAddressToProto(address *agent.InterfaceAddress) *pb.InterfaceAddress {
+	return &pb.InterfaceAddress{
+		InterfaceName: address.Interface,
+		Prefix:        address.Prefix,
+		Configured:    address.Configured,
+		Active:        address.Active,
+	}
+}
+
+func (s *proxyServer) ListInterfaceAddresses(ctx context.Context, request *pb.ListInterfaceAddressesRequest) (*pb.ListInterfaceAddressesResponse, error) {
+	log.Printf("ListInterfaceAddresses called: interface=%s", request.GetInterfaceName())
+
+	addressList, status := s.SwitchAgent.ListInterfaceAddresses(ctx, &agent.Interface{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceKind,
+		},
+		Name: request.GetInterfaceName(),
+	})
+	if status != nil {
+		return &pb.ListInterfaceAddressesResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to list interface addresses: %v", status.Message),
+			},
+		}, nil
+	}
+
+	var addresses = make([]*pb.InterfaceAddress, 0, len(addressList.Items))
+	for _, address := range addressList.Items {
+		addresses = append(addresses, interfaceAddressToProto(&address))
+	}
+
+	return &pb.ListInterfaceAddressesResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		Addresses: addresses,
+	}, nil
+}
+
+func (s *proxyServer) AddInterfaceAddress(ctx context.Context, request *pb.AddInterfaceAddressRequest) (*pb.AddInterfaceAddressResponse, error) {
+	log.Printf("AddInterfaceAddress called: interface=%s, prefix=%s", request.GetInterfaceName(), request.GetPrefix())
+
+	address, status := s.SwitchAgent.AddInterfaceAddress(ctx, &agent.InterfaceAddress{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceAddressKind,
+		},
+		Interface: request.GetInterfaceName(),
+		Prefix:    request.GetPrefix(),
+	})
+	if status != nil {
+		return &pb.AddInterfaceAddressResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to add interface address: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.AddInterfaceAddressResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		Address: interfaceAddressToProto(address),
+	}, nil
+}
+
+func (s *proxyServer) RemoveInterfaceAddress(ctx context.Context, request *pb.RemoveInterfaceAddressRequest) (*pb.RemoveInterfaceAddressResponse, error) {
+	log.Printf("RemoveInterfaceAddress called: interface=%s, prefix=%s", request.GetInterfaceName(), request.GetPrefix())
+
+	status := s.SwitchAgent.RemoveInterfaceAddress(ctx, &agent.InterfaceAddress{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceAddressKind,
+		},
+		Interface: request.GetInterfaceName(),
+		Prefix:    request.GetPrefix(),
+	})
+	if status != nil {
+		return &pb.RemoveInterfaceAddressResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to remove interface address: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.RemoveInterfaceAddressResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+	}, nil
+}
+
+func bgpNeighborToProto(neighbor *agent.BGPNeighbor) *pb.BGPNeighbor {
+	return &pb.BGPNeighbor{
+		Neighbor:         neighbor.Neighbor,
+		Name:             neighbor.Name,
+		RemoteAs:         neighbor.RemoteAS,
+		State:            neighbor.State,
+		PrefixesReceived: neighbor.PrefixesReceived,
+		Uptime:           neighbor.Uptime,
+		Configured:       neighbor.Configured,
+	}
+}
+
+func (s *proxyServer) ListBGPNeighbors(ctx context.Context, request *pb.ListBGPNeighborsRequest) (*pb.ListBGPNeighborsResponse, error) {
+	log.Printf("ListBGPNeighbors called")
+
+	neighborList, status := s.SwitchAgent.ListBGPNeighbors(ctx)
+	if status != nil {
+		return &pb.ListBGPNeighborsResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to list BGP neighbors: %v", status.Message),
+			},
+		}, nil
+	}
+
+	var neighbors = make([]*pb.BGPNeighbor, 0, len(neighborList.Items))
+	for _, neighbor := range neighborList.Items {
+		neighbors = append(neighbors, bgpNeighborToProto(&neighbor))
+	}
+
+	return &pb.ListBGPNeighborsResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		Neighbors: neighbors,
+	}, nil
+}
+
+func (s *proxyServer) AddBGPNeighbor(ctx context.Context, request *pb.AddBGPNeighborRequest) (*pb.AddBGPNeighborResponse, error) {
+	log.Printf("AddBGPNeighbor called: interface=%s, remote_as=%s", request.GetInterfaceName(), request.GetRemoteAs())
+
+	neighbor, status := s.SwitchAgent.AddBGPNeighbor(ctx, &agent.BGPNeighbor{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.BGPNeighborKind,
+		},
+		Neighbor: request.GetInterfaceName(),
+		RemoteAS: request.GetRemoteAs(),
+		Name:     request.GetName(),
+	})
+	if status != nil {
+		return &pb.AddBGPNeighborResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to add BGP neighbor: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.AddBGPNeighborResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+		Neighbor: bgpNeighborToProto(neighbor),
+	}, nil
+}
+
+func (s *proxyServer) RemoveBGPNeighbor(ctx context.Context, request *pb.RemoveBGPNeighborRequest) (*pb.RemoveBGPNeighborResponse, error) {
+	log.Printf("RemoveBGPNeighbor called: interface=%s", request.GetInterfaceName())
+
+	status := s.SwitchAgent.RemoveBGPNeighbor(ctx, &agent.BGPNeighbor{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.BGPNeighborKind,
+		},
+		Neighbor: request.GetInterfaceName(),
+	})
+	if status != nil {
+		return &pb.RemoveBGPNeighborResponse{
+			Status: &pb.Status{
+				Code:    status.Code,
+				Message: fmt.Sprintf("failed to remove BGP neighbor: %v", status.Message),
+			},
+		}, nil
+	}
+
+	return &pb.RemoveBGPNeighborResponse{
+		Status: &pb.Status{
+			Code:    0,
+			Message: "Success",
+		},
+	}, nil
+}
+
 // NewProxyServer creates a proxyServer backed by the given SwitchAgent.
 // This is exported so tests can instantiate a server with a fake agent.
 func NewProxyServer(switchAgentImpl switchAgent.SwitchAgent) pb.SwitchAgentServiceServer {
@@ -288,12 +1125,25 @@ func NewProxyServer(switchAgentImpl switchAgent.SwitchAgent) pb.SwitchAgentServi
 func StartServer() {
 	flag.Parse()
 
-	lis, err := net.Listen("tcp4", fmt.Sprintf("0.0.0.0:%d", *port))
+	opts, err := serverOptions(*tlsCertFile, *tlsKeyFile, *tlsClientCAFile, *tokenFile)
+	if err != nil {
+		log.Fatalf("failed to configure server: %v", err)
+	}
+	if *tlsCertFile == "" {
+		log.Printf("TLS is disabled, the agent API is exposed without transport security")
+	}
+
+	lis, err := net.Listen("tcp", net.JoinHostPort(*bindAddress, strconv.Itoa(*port)))
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	s := grpc.NewServer()
+	opts = append(opts, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+		MinTime:             10 * time.Second,
+		PermitWithoutStream: true,
+	}))
+
+	s := grpc.NewServer(opts...)
 
 	swAgent, err := sonic.NewSonicRedisAgent(*redisAddr)
 	if err != nil {
@@ -301,8 +1151,38 @@ func StartServer() {
 		panic(err)
 	}
 
+	switch *bgpStateSource {
+	case "vtysh":
+		swAgent.BGPStateSource = &sonic.VtyshBGPStateSource{Command: strings.Fields(*vtyshCommand)}
+	case "state-db":
+		swAgent.BGPStateSource = &sonic.StateDBBGPStateSource{Agent: swAgent}
+	default:
+		log.Fatalf("unknown BGP state source: %s", *bgpStateSource)
+	}
+
+	if *nameTableFile != "" || *deriveNamesFromPlatform {
+		var nameTables agent.NameTables
+		if *nameTableFile != "" {
+			if nameTables, err = agent.LoadNameTables(*nameTableFile); err != nil {
+				log.Printf("Failed to load interface name tables, ignoring them: %v", err)
+			}
+		}
+		if nameSource, err := swAgent.LoadNameMapper(context.Background(), nameTables, *deriveNamesFromPlatform); err != nil {
+			log.Printf("Failed to load interface names, using the default 4-lane scheme: %v", err)
+		} else {
+			log.Printf("Using interface names from %s", nameSource)
+		}
+	} else {
+		log.Printf("Using interface names from the default 4-lane scheme")
+	}
+
 	pb.RegisterSwitchAgentServiceServer(s, NewProxyServer(swAgent))
 
+	// Register the health service used by the operator to check the connection
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(s, healthServer)
+
 	// Register reflection service on gRPC server for debugging
 	reflection.Register(s)
 
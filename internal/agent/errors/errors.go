@@ -4,6 +4,9 @@
 package errors
 
 import (
+	"errors"
+	"fmt"
+
 	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
 )
 
@@ -26,3 +29,56 @@ func NewErrorStatus(code uint32, message string) *agent.Status {
 		Message: message,
 	}
 }
+
+// StatusError is returned by the agent client if the agent responded with a non-zero status code.
+type StatusError struct {
+	Code    uint32
+	Message string
+
+	op string
+}
+
+func NewStatusError(op string, code uint32, message string) *StatusError {
+	return &StatusError{
+		Code:    code,
+		Message: message,
+		op:      op,
+	}
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s: %s", e.op, e.Message)
+}
+
+// Reason returns a CamelCase reason for the given status code, suitable for conditions and events.
+func Reason(code uint32) string {
+	switch code {
+	case CLIENT_ERROR:
+		return "ClientError"
+	case SERVER_ERROR:
+		return "ServerError"
+	case BAD_REQUEST:
+		return "BadRequest"
+	case NOT_FOUND:
+		return "NotFound"
+	case ALREADY_EXISTS:
+		return "AlreadyExists"
+	case REDIS_HSET_FAIL:
+		return "RedisWriteFailed"
+	case REDIS_HGET_FAIL:
+		return "RedisReadFailed"
+	case REDIS_KEY_CHECK_FAIL:
+		return "RedisKeyCheckFailed"
+	default:
+		return "Unknown"
+	}
+}
+
+// AsStatusError returns the StatusError wrapped by err, if any.
+func AsStatusError(err error) (*StatusError, bool) {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr, true
+	}
+	return nil, false
+}
@@ -11,15 +11,44 @@ import (
 
 type SwitchAgent interface {
 	GetDeviceInfo(ctx context.Context) (*agent.SwitchDevice, *agent.Status)
+	GetPlatformHealth(ctx context.Context) (*agent.PlatformHealth, *agent.Status)
 	ListInterfaces(ctx context.Context) (*agent.InterfaceList, *agent.Status)
 
 	SetInterfaceAdminStatus(ctx context.Context, iface *agent.Interface) (*agent.Interface, *agent.Status)
 	SetInterfaceAliasName(ctx context.Context, iface *agent.Interface) (*agent.Interface, *agent.Status)
+	SetInterfacePortAttributes(ctx context.Context, iface *agent.Interface) (*agent.Interface, *agent.Status)
 
 	GetInterface(ctx context.Context, iface *agent.Interface) (*agent.Interface, *agent.Status)
 	GetInterfaceNeighbor(ctx context.Context, iface *agent.Interface) (*agent.InterfaceNeighbor, *agent.Status)
+	GetInterfaceCounters(ctx context.Context, iface *agent.Interface) (*agent.InterfaceCounters, *agent.Status)
+	ListInterfaceCounters(ctx context.Context) (*agent.InterfaceCountersList, *agent.Status)
+	GetTransceiver(ctx context.Context, iface *agent.Interface) (*agent.Transceiver, *agent.Status)
+	WatchInterfaces(ctx context.Context, send func(*agent.InterfaceEvent) error) *agent.Status
 
 	ListPorts(ctx context.Context) (*agent.PortList, *agent.Status)
+	ListPortBreakouts(ctx context.Context) (*agent.PortBreakoutList, *agent.Status)
+	SetPortBreakout(ctx context.Context, breakout *agent.PortBreakout) (*agent.PortBreakout, *agent.Status)
+
+	CreateVlan(ctx context.Context, vlan *agent.Vlan) (*agent.Vlan, *agent.Status)
+	DeleteVlan(ctx context.Context, vlan *agent.Vlan) *agent.Status
+	ListVlans(ctx context.Context) (*agent.VlanList, *agent.Status)
+	AddVlanMember(ctx context.Context, member *agent.VlanMember) (*agent.VlanMember, *agent.Status)
+	RemoveVlanMember(ctx context.Context, member *agent.VlanMember) *agent.Status
+
+	CreatePortChannel(ctx context.Context, portChannel *agent.PortChannel) (*agent.PortChannel, *agent.Status)
+	DeletePortChannel(ctx context.Context, portChannel *agent.PortChannel) *agent.Status
+	GetPortChannel(ctx context.Context, portChannel *agent.PortChannel) (*agent.PortChannel, *agent.Status)
+	ListPortChannels(ctx context.Context) (*agent.PortChannelList, *agent.Status)
+	AddPortChannelMember(ctx context.Context, member *agent.PortChannelMember) (*agent.PortChannelMember, *agent.Status)
+	RemovePortChannelMember(ctx context.Context, member *agent.PortChannelMember) *agent.Status
+
+	ListInterfaceAddresses(ctx context.Context, iface *agent.Interface) (*agent.InterfaceAddressList, *agent.Status)
+	AddInterfaceAddress(ctx context.Context, address *agent.InterfaceAddress) (*agent.InterfaceAddress, *agent.Status)
+	RemoveInterfaceAddress(ctx context.Context, address *agent.InterfaceAddress) *agent.Status
+
+	ListBGPNeighbors(ctx context.Context) (*agent.BGPNeighborList, *agent.Status)
+	AddBGPNeighbor(ctx context.Context, neighbor *agent.BGPNeighbor) (*agent.BGPNeighbor, *agent.Status)
+	RemoveBGPNeighbor(ctx context.Context, neighbor *agent.BGPNeighbor) *agent.Status
 
 	SaveConfig(ctx context.Context) *agent.Status
 }
@@ -194,6 +194,346 @@ func (x *GetDeviceInfoResponse) GetReadiness() uint32 {
 	return 0
 }
 
+type PSU struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Presence      bool                   `protobuf:"varint,2,opt,name=presence,proto3" json:"presence,omitempty"`
+	Ok            bool                   `protobuf:"varint,3,opt,name=ok,proto3" json:"ok,omitempty"`
+	Model         string                 `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	Serial        string                 `protobuf:"bytes,5,opt,name=serial,proto3" json:"serial,omitempty"`
+	Power         string                 `protobuf:"bytes,6,opt,name=power,proto3" json:"power,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PSU) Reset() {
+	*x = PSU{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PSU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PSU) ProtoMessage() {}
+
+func (x *PSU) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PSU.ProtoReflect.Descriptor instead.
+func (*PSU) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PSU) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PSU) GetPresence() bool {
+	if x != nil {
+		return x.Presence
+	}
+	return false
+}
+
+func (x *PSU) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *PSU) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *PSU) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *PSU) GetPower() string {
+	if x != nil {
+		return x.Power
+	}
+	return ""
+}
+
+type Fan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Presence      bool                   `protobuf:"varint,2,opt,name=presence,proto3" json:"presence,omitempty"`
+	Ok            bool                   `protobuf:"varint,3,opt,name=ok,proto3" json:"ok,omitempty"`
+	Speed         string                 `protobuf:"bytes,4,opt,name=speed,proto3" json:"speed,omitempty"`
+	Direction     string                 `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fan) Reset() {
+	*x = Fan{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fan) ProtoMessage() {}
+
+func (x *Fan) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fan.ProtoReflect.Descriptor instead.
+func (*Fan) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Fan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Fan) GetPresence() bool {
+	if x != nil {
+		return x.Presence
+	}
+	return false
+}
+
+func (x *Fan) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *Fan) GetSpeed() string {
+	if x != nil {
+		return x.Speed
+	}
+	return ""
+}
+
+func (x *Fan) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+type TemperatureSensor struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Name                  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Temperature           string                 `protobuf:"bytes,2,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	HighThreshold         string                 `protobuf:"bytes,3,opt,name=high_threshold,json=highThreshold,proto3" json:"high_threshold,omitempty"`
+	CriticalHighThreshold string                 `protobuf:"bytes,4,opt,name=critical_high_threshold,json=criticalHighThreshold,proto3" json:"critical_high_threshold,omitempty"`
+	Warning               bool                   `protobuf:"varint,5,opt,name=warning,proto3" json:"warning,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *TemperatureSensor) Reset() {
+	*x = TemperatureSensor{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemperatureSensor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemperatureSensor) ProtoMessage() {}
+
+func (x *TemperatureSensor) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemperatureSensor.ProtoReflect.Descriptor instead.
+func (*TemperatureSensor) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TemperatureSensor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemperatureSensor) GetTemperature() string {
+	if x != nil {
+		return x.Temperature
+	}
+	return ""
+}
+
+func (x *TemperatureSensor) GetHighThreshold() string {
+	if x != nil {
+		return x.HighThreshold
+	}
+	return ""
+}
+
+func (x *TemperatureSensor) GetCriticalHighThreshold() string {
+	if x != nil {
+		return x.CriticalHighThreshold
+	}
+	return ""
+}
+
+func (x *TemperatureSensor) GetWarning() bool {
+	if x != nil {
+		return x.Warning
+	}
+	return false
+}
+
+type GetPlatformHealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlatformHealthRequest) Reset() {
+	*x = GetPlatformHealthRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlatformHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformHealthRequest) ProtoMessage() {}
+
+func (x *GetPlatformHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetPlatformHealthRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{6}
+}
+
+type GetPlatformHealthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Psus          []*PSU                 `protobuf:"bytes,2,rep,name=psus,proto3" json:"psus,omitempty"`
+	Fans          []*Fan                 `protobuf:"bytes,3,rep,name=fans,proto3" json:"fans,omitempty"`
+	Temperatures  []*TemperatureSensor   `protobuf:"bytes,4,rep,name=temperatures,proto3" json:"temperatures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlatformHealthResponse) Reset() {
+	*x = GetPlatformHealthResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlatformHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformHealthResponse) ProtoMessage() {}
+
+func (x *GetPlatformHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetPlatformHealthResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPlatformHealthResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetPlatformHealthResponse) GetPsus() []*PSU {
+	if x != nil {
+		return x.Psus
+	}
+	return nil
+}
+
+func (x *GetPlatformHealthResponse) GetFans() []*Fan {
+	if x != nil {
+		return x.Fans
+	}
+	return nil
+}
+
+func (x *GetPlatformHealthResponse) GetTemperatures() []*TemperatureSensor {
+	if x != nil {
+		return x.Temperatures
+	}
+	return nil
+}
+
 // The interface message containing details about a single interface.
 type Interface struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -203,25 +543,2985 @@ type Interface struct {
 	OperationalStatus string                 `protobuf:"bytes,4,opt,name=operational_status,json=operationalStatus,proto3" json:"operational_status,omitempty"`
 	AdminStatus       string                 `protobuf:"bytes,5,opt,name=admin_status,json=adminStatus,proto3" json:"admin_status,omitempty"`
 	MacAddress        string                 `protobuf:"bytes,6,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	Mtu               uint32                 `protobuf:"varint,7,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Speed             uint32                 `protobuf:"varint,8,opt,name=speed,proto3" json:"speed,omitempty"`
+	Fec               string                 `protobuf:"bytes,9,opt,name=fec,proto3" json:"fec,omitempty"`
+	Autoneg           string                 `protobuf:"bytes,10,opt,name=autoneg,proto3" json:"autoneg,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Interface) Reset() {
-	*x = Interface{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[3]
+func (x *Interface) Reset() {
+	*x = Interface{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface) ProtoMessage() {}
+
+func (x *Interface) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface.ProtoReflect.Descriptor instead.
+func (*Interface) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Interface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Interface) GetNativeName() string {
+	if x != nil {
+		return x.NativeName
+	}
+	return ""
+}
+
+func (x *Interface) GetAliasName() string {
+	if x != nil {
+		return x.AliasName
+	}
+	return ""
+}
+
+func (x *Interface) GetOperationalStatus() string {
+	if x != nil {
+		return x.OperationalStatus
+	}
+	return ""
+}
+
+func (x *Interface) GetAdminStatus() string {
+	if x != nil {
+		return x.AdminStatus
+	}
+	return ""
+}
+
+func (x *Interface) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+func (x *Interface) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *Interface) GetSpeed() uint32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *Interface) GetFec() string {
+	if x != nil {
+		return x.Fec
+	}
+	return ""
+}
+
+func (x *Interface) GetAutoneg() string {
+	if x != nil {
+		return x.Autoneg
+	}
+	return ""
+}
+
+// The request message containing the parameters for the request.
+type ListInterfacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInterfacesRequest) Reset() {
+	*x = ListInterfacesRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInterfacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInterfacesRequest) ProtoMessage() {}
+
+func (x *ListInterfacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInterfacesRequest.ProtoReflect.Descriptor instead.
+func (*ListInterfacesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{9}
+}
+
+// The response message containing the list of interfaces.
+type ListInterfacesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Interfaces    []*Interface           `protobuf:"bytes,2,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInterfacesResponse) Reset() {
+	*x = ListInterfacesResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInterfacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInterfacesResponse) ProtoMessage() {}
+
+func (x *ListInterfacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInterfacesResponse.ProtoReflect.Descriptor instead.
+func (*ListInterfacesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListInterfacesResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListInterfacesResponse) GetInterfaces() []*Interface {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+type SetInterfaceAdminStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterfaceName string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	AdminStatus   string                 `protobuf:"bytes,2,opt,name=admin_status,json=adminStatus,proto3" json:"admin_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInterfaceAdminStatusRequest) Reset() {
+	*x = SetInterfaceAdminStatusRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInterfaceAdminStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInterfaceAdminStatusRequest) ProtoMessage() {}
+
+func (x *SetInterfaceAdminStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInterfaceAdminStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetInterfaceAdminStatusRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetInterfaceAdminStatusRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *SetInterfaceAdminStatusRequest) GetAdminStatus() string {
+	if x != nil {
+		return x.AdminStatus
+	}
+	return ""
+}
+
+type SetInterfaceAdminStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Interface     *Interface             `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInterfaceAdminStatusResponse) Reset() {
+	*x = SetInterfaceAdminStatusResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInterfaceAdminStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInterfaceAdminStatusResponse) ProtoMessage() {}
+
+func (x *SetInterfaceAdminStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInterfaceAdminStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetInterfaceAdminStatusResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetInterfaceAdminStatusResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SetInterfaceAdminStatusResponse) GetInterface() *Interface {
+	if x != nil {
+		return x.Interface
+	}
+	return nil
+}
+
+type SetInterfacePortAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterfaceName string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	Mtu           uint32                 `protobuf:"varint,2,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Speed         uint32                 `protobuf:"varint,3,opt,name=speed,proto3" json:"speed,omitempty"`
+	Fec           string                 `protobuf:"bytes,4,opt,name=fec,proto3" json:"fec,omitempty"`
+	Autoneg       string                 `protobuf:"bytes,5,opt,name=autoneg,proto3" json:"autoneg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInterfacePortAttributesRequest) Reset() {
+	*x = SetInterfacePortAttributesRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInterfacePortAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInterfacePortAttributesRequest) ProtoMessage() {}
+
+func (x *SetInterfacePortAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInterfacePortAttributesRequest.ProtoReflect.Descriptor instead.
+func (*SetInterfacePortAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetInterfacePortAttributesRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *SetInterfacePortAttributesRequest) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *SetInterfacePortAttributesRequest) GetSpeed() uint32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *SetInterfacePortAttributesRequest) GetFec() string {
+	if x != nil {
+		return x.Fec
+	}
+	return ""
+}
+
+func (x *SetInterfacePortAttributesRequest) GetAutoneg() string {
+	if x != nil {
+		return x.Autoneg
+	}
+	return ""
+}
+
+type SetInterfacePortAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Interface     *Interface             `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInterfacePortAttributesResponse) Reset() {
+	*x = SetInterfacePortAttributesResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInterfacePortAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInterfacePortAttributesResponse) ProtoMessage() {}
+
+func (x *SetInterfacePortAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInterfacePortAttributesResponse.ProtoReflect.Descriptor instead.
+func (*SetInterfacePortAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetInterfacePortAttributesResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SetInterfacePortAttributesResponse) GetInterface() *Interface {
+	if x != nil {
+		return x.Interface
+	}
+	return nil
+}
+
+type ListPortsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPortsRequest) Reset() {
+	*x = ListPortsRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPortsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortsRequest) ProtoMessage() {}
+
+func (x *ListPortsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortsRequest.ProtoReflect.Descriptor instead.
+func (*ListPortsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{15}
+}
+
+type ListPortsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Ports         []*Port                `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPortsResponse) Reset() {
+	*x = ListPortsResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPortsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortsResponse) ProtoMessage() {}
+
+func (x *ListPortsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortsResponse.ProtoReflect.Descriptor instead.
+func (*ListPortsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListPortsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListPortsResponse) GetPorts() []*Port {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+type Port struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Alias         string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Port) Reset() {
+	*x = Port{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Port) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Port) ProtoMessage() {}
+
+func (x *Port) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Port.ProtoReflect.Descriptor instead.
+func (*Port) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Port) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Port) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type PortBreakout struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Port           string                 `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	Mode           string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	DefaultMode    string                 `protobuf:"bytes,3,opt,name=default_mode,json=defaultMode,proto3" json:"default_mode,omitempty"`
+	SupportedModes []string               `protobuf:"bytes,4,rep,name=supported_modes,json=supportedModes,proto3" json:"supported_modes,omitempty"`
+	Ports          []string               `protobuf:"bytes,5,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PortBreakout) Reset() {
+	*x = PortBreakout{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortBreakout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortBreakout) ProtoMessage() {}
+
+func (x *PortBreakout) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortBreakout.ProtoReflect.Descriptor instead.
+func (*PortBreakout) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PortBreakout) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *PortBreakout) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *PortBreakout) GetDefaultMode() string {
+	if x != nil {
+		return x.DefaultMode
+	}
+	return ""
+}
+
+func (x *PortBreakout) GetSupportedModes() []string {
+	if x != nil {
+		return x.SupportedModes
+	}
+	return nil
+}
+
+func (x *PortBreakout) GetPorts() []string {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+type ListPortBreakoutsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPortBreakoutsRequest) Reset() {
+	*x = ListPortBreakoutsRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPortBreakoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortBreakoutsRequest) ProtoMessage() {}
+
+func (x *ListPortBreakoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortBreakoutsRequest.ProtoReflect.Descriptor instead.
+func (*ListPortBreakoutsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{19}
+}
+
+type ListPortBreakoutsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Breakouts     []*PortBreakout        `protobuf:"bytes,2,rep,name=breakouts,proto3" json:"breakouts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPortBreakoutsResponse) Reset() {
+	*x = ListPortBreakoutsResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPortBreakoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortBreakoutsResponse) ProtoMessage() {}
+
+func (x *ListPortBreakoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortBreakoutsResponse.ProtoReflect.Descriptor instead.
+func (*ListPortBreakoutsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListPortBreakoutsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListPortBreakoutsResponse) GetBreakouts() []*PortBreakout {
+	if x != nil {
+		return x.Breakouts
+	}
+	return nil
+}
+
+type SetPortBreakoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Port          string                 `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPortBreakoutRequest) Reset() {
+	*x = SetPortBreakoutRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPortBreakoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPortBreakoutRequest) ProtoMessage() {}
+
+func (x *SetPortBreakoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPortBreakoutRequest.ProtoReflect.Descriptor instead.
+func (*SetPortBreakoutRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetPortBreakoutRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *SetPortBreakoutRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type SetPortBreakoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Breakout      *PortBreakout          `protobuf:"bytes,2,opt,name=breakout,proto3" json:"breakout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPortBreakoutResponse) Reset() {
+	*x = SetPortBreakoutResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPortBreakoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPortBreakoutResponse) ProtoMessage() {}
+
+func (x *SetPortBreakoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPortBreakoutResponse.ProtoReflect.Descriptor instead.
+func (*SetPortBreakoutResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetPortBreakoutResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SetPortBreakoutResponse) GetBreakout() *PortBreakout {
+	if x != nil {
+		return x.Breakout
+	}
+	return nil
+}
+
+type GetInterfaceNeighborRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterfaceName string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInterfaceNeighborRequest) Reset() {
+	*x = GetInterfaceNeighborRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInterfaceNeighborRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInterfaceNeighborRequest) ProtoMessage() {}
+
+func (x *GetInterfaceNeighborRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInterfaceNeighborRequest.ProtoReflect.Descriptor instead.
+func (*GetInterfaceNeighborRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetInterfaceNeighborRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+type InterfaceNeighbor struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	NeighborInterfaceName string                 `protobuf:"bytes,1,opt,name=neighbor_interface_name,json=neighborInterfaceName,proto3" json:"neighbor_interface_name,omitempty"`
+	MacAddress            string                 `protobuf:"bytes,2,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	SystemName            string                 `protobuf:"bytes,3,opt,name=system_name,json=systemName,proto3" json:"system_name,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *InterfaceNeighbor) Reset() {
+	*x = InterfaceNeighbor{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterfaceNeighbor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceNeighbor) ProtoMessage() {}
+
+func (x *InterfaceNeighbor) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceNeighbor.ProtoReflect.Descriptor instead.
+func (*InterfaceNeighbor) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *InterfaceNeighbor) GetNeighborInterfaceName() string {
+	if x != nil {
+		return x.NeighborInterfaceName
+	}
+	return ""
+}
+
+func (x *InterfaceNeighbor) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+func (x *InterfaceNeighbor) GetSystemName() string {
+	if x != nil {
+		return x.SystemName
+	}
+	return ""
+}
+
+type GetInterfaceNeighborResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Interface     string                 `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	Neighbor      *InterfaceNeighbor     `protobuf:"bytes,3,opt,name=neighbor,proto3" json:"neighbor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInterfaceNeighborResponse) Reset() {
+	*x = GetInterfaceNeighborResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInterfaceNeighborResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInterfaceNeighborResponse) ProtoMessage() {}
+
+func (x *GetInterfaceNeighborResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInterfaceNeighborResponse.ProtoReflect.Descriptor instead.
+func (*GetInterfaceNeighborResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetInterfaceNeighborResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetInterfaceNeighborResponse) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *GetInterfaceNeighborResponse) GetNeighbor() *InterfaceNeighbor {
+	if x != nil {
+		return x.Neighbor
+	}
+	return nil
+}
+
+type InterfaceCounters struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interface     string                 `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	RxOctets      uint64                 `protobuf:"varint,2,opt,name=rx_octets,json=rxOctets,proto3" json:"rx_octets,omitempty"`
+	RxPackets     uint64                 `protobuf:"varint,3,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
+	RxErrors      uint64                 `protobuf:"varint,4,opt,name=rx_errors,json=rxErrors,proto3" json:"rx_errors,omitempty"`
+	RxDiscards    uint64                 `protobuf:"varint,5,opt,name=rx_discards,json=rxDiscards,proto3" json:"rx_discards,omitempty"`
+	TxOctets      uint64                 `protobuf:"varint,6,opt,name=tx_octets,json=txOctets,proto3" json:"tx_octets,omitempty"`
+	TxPackets     uint64                 `protobuf:"varint,7,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
+	TxErrors      uint64                 `protobuf:"varint,8,opt,name=tx_errors,json=txErrors,proto3" json:"tx_errors,omitempty"`
+	TxDiscards    uint64                 `protobuf:"varint,9,opt,name=tx_discards,json=txDiscards,proto3" json:"tx_discards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterfaceCounters) Reset() {
+	*x = InterfaceCounters{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterfaceCounters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceCounters) ProtoMessage() {}
+
+func (x *InterfaceCounters) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceCounters.ProtoReflect.Descriptor instead.
+func (*InterfaceCounters) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *InterfaceCounters) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *InterfaceCounters) GetRxOctets() uint64 {
+	if x != nil {
+		return x.RxOctets
+	}
+	return 0
+}
+
+func (x *InterfaceCounters) GetRxPackets() uint64 {
+	if x != nil {
+		return x.RxPackets
+	}
+	return 0
+}
+
+func (x *InterfaceCounters) GetRxErrors() uint64 {
+	if x != nil {
+		return x.RxErrors
+	}
+	return 0
+}
+
+func (x *InterfaceCounters) GetRxDiscards() uint64 {
+	if x != nil {
+		return x.RxDiscards
+	}
+	return 0
+}
+
+func (x *InterfaceCounters) GetTxOctets() uint64 {
+	if x != nil {
+		return x.TxOctets
+	}
+	return 0
+}
+
+func (x *InterfaceCounters) GetTxPackets() uint64 {
+	if x != nil {
+		return x.TxPackets
+	}
+	return 0
+}
+
+func (x *InterfaceCounters) GetTxErrors() uint64 {
+	if x != nil {
+		return x.TxErrors
+	}
+	return 0
+}
+
+func (x *InterfaceCounters) GetTxDiscards() uint64 {
+	if x != nil {
+		return x.TxDiscards
+	}
+	return 0
+}
+
+type GetInterfaceCountersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterfaceName string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInterfaceCountersRequest) Reset() {
+	*x = GetInterfaceCountersRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInterfaceCountersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInterfaceCountersRequest) ProtoMessage() {}
+
+func (x *GetInterfaceCountersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInterfaceCountersRequest.ProtoReflect.Descriptor instead.
+func (*GetInterfaceCountersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetInterfaceCountersRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+type GetInterfaceCountersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Counters      *InterfaceCounters     `protobuf:"bytes,2,opt,name=counters,proto3" json:"counters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInterfaceCountersResponse) Reset() {
+	*x = GetInterfaceCountersResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInterfaceCountersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInterfaceCountersResponse) ProtoMessage() {}
+
+func (x *GetInterfaceCountersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInterfaceCountersResponse.ProtoReflect.Descriptor instead.
+func (*GetInterfaceCountersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetInterfaceCountersResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetInterfaceCountersResponse) GetCounters() *InterfaceCounters {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+type ListInterfaceCountersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInterfaceCountersRequest) Reset() {
+	*x = ListInterfaceCountersRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInterfaceCountersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInterfaceCountersRequest) ProtoMessage() {}
+
+func (x *ListInterfaceCountersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInterfaceCountersRequest.ProtoReflect.Descriptor instead.
+func (*ListInterfaceCountersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{29}
+}
+
+type ListInterfaceCountersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Counters      []*InterfaceCounters   `protobuf:"bytes,2,rep,name=counters,proto3" json:"counters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInterfaceCountersResponse) Reset() {
+	*x = ListInterfaceCountersResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInterfaceCountersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInterfaceCountersResponse) ProtoMessage() {}
+
+func (x *ListInterfaceCountersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInterfaceCountersResponse.ProtoReflect.Descriptor instead.
+func (*ListInterfaceCountersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListInterfaceCountersResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListInterfaceCountersResponse) GetCounters() []*InterfaceCounters {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+type TransceiverLane struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lane          uint32                 `protobuf:"varint,1,opt,name=lane,proto3" json:"lane,omitempty"`
+	RxPower       string                 `protobuf:"bytes,2,opt,name=rx_power,json=rxPower,proto3" json:"rx_power,omitempty"`
+	TxPower       string                 `protobuf:"bytes,3,opt,name=tx_power,json=txPower,proto3" json:"tx_power,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransceiverLane) Reset() {
+	*x = TransceiverLane{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransceiverLane) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransceiverLane) ProtoMessage() {}
+
+func (x *TransceiverLane) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransceiverLane.ProtoReflect.Descriptor instead.
+func (*TransceiverLane) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TransceiverLane) GetLane() uint32 {
+	if x != nil {
+		return x.Lane
+	}
+	return 0
+}
+
+func (x *TransceiverLane) GetRxPower() string {
+	if x != nil {
+		return x.RxPower
+	}
+	return ""
+}
+
+func (x *TransceiverLane) GetTxPower() string {
+	if x != nil {
+		return x.TxPower
+	}
+	return ""
+}
+
+type Transceiver struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interface     string                 `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Vendor        string                 `protobuf:"bytes,2,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	PartNumber    string                 `protobuf:"bytes,3,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	SerialNumber  string                 `protobuf:"bytes,4,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Temperature   string                 `protobuf:"bytes,6,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	Voltage       string                 `protobuf:"bytes,7,opt,name=voltage,proto3" json:"voltage,omitempty"`
+	Lanes         []*TransceiverLane     `protobuf:"bytes,8,rep,name=lanes,proto3" json:"lanes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transceiver) Reset() {
+	*x = Transceiver{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transceiver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transceiver) ProtoMessage() {}
+
+func (x *Transceiver) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transceiver.ProtoReflect.Descriptor instead.
+func (*Transceiver) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Transceiver) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *Transceiver) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *Transceiver) GetPartNumber() string {
+	if x != nil {
+		return x.PartNumber
+	}
+	return ""
+}
+
+func (x *Transceiver) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *Transceiver) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Transceiver) GetTemperature() string {
+	if x != nil {
+		return x.Temperature
+	}
+	return ""
+}
+
+func (x *Transceiver) GetVoltage() string {
+	if x != nil {
+		return x.Voltage
+	}
+	return ""
+}
+
+func (x *Transceiver) GetLanes() []*TransceiverLane {
+	if x != nil {
+		return x.Lanes
+	}
+	return nil
+}
+
+type GetTransceiverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterfaceName string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransceiverRequest) Reset() {
+	*x = GetTransceiverRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransceiverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransceiverRequest) ProtoMessage() {}
+
+func (x *GetTransceiverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransceiverRequest.ProtoReflect.Descriptor instead.
+func (*GetTransceiverRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetTransceiverRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+type GetTransceiverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Transceiver   *Transceiver           `protobuf:"bytes,2,opt,name=transceiver,proto3" json:"transceiver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransceiverResponse) Reset() {
+	*x = GetTransceiverResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransceiverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransceiverResponse) ProtoMessage() {}
+
+func (x *GetTransceiverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransceiverResponse.ProtoReflect.Descriptor instead.
+func (*GetTransceiverResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetTransceiverResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetTransceiverResponse) GetTransceiver() *Transceiver {
+	if x != nil {
+		return x.Transceiver
+	}
+	return nil
+}
+
+type WatchInterfacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchInterfacesRequest) Reset() {
+	*x = WatchInterfacesRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchInterfacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchInterfacesRequest) ProtoMessage() {}
+
+func (x *WatchInterfacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchInterfacesRequest.ProtoReflect.Descriptor instead.
+func (*WatchInterfacesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{35}
+}
+
+type InterfaceEvent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NativeName        string                 `protobuf:"bytes,2,opt,name=native_name,json=nativeName,proto3" json:"native_name,omitempty"`
+	AdminStatus       string                 `protobuf:"bytes,3,opt,name=admin_status,json=adminStatus,proto3" json:"admin_status,omitempty"`
+	OperationalStatus string                 `protobuf:"bytes,4,opt,name=operational_status,json=operationalStatus,proto3" json:"operational_status,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InterfaceEvent) Reset() {
+	*x = InterfaceEvent{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterfaceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceEvent) ProtoMessage() {}
+
+func (x *InterfaceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceEvent.ProtoReflect.Descriptor instead.
+func (*InterfaceEvent) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *InterfaceEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InterfaceEvent) GetNativeName() string {
+	if x != nil {
+		return x.NativeName
+	}
+	return ""
+}
+
+func (x *InterfaceEvent) GetAdminStatus() string {
+	if x != nil {
+		return x.AdminStatus
+	}
+	return ""
+}
+
+func (x *InterfaceEvent) GetOperationalStatus() string {
+	if x != nil {
+		return x.OperationalStatus
+	}
+	return ""
+}
+
+type GetInterfaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterfaceName string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInterfaceRequest) Reset() {
+	*x = GetInterfaceRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInterfaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInterfaceRequest) ProtoMessage() {}
+
+func (x *GetInterfaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInterfaceRequest.ProtoReflect.Descriptor instead.
+func (*GetInterfaceRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetInterfaceRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+type GetInterfaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Interface     *Interface             `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInterfaceResponse) Reset() {
+	*x = GetInterfaceResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInterfaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInterfaceResponse) ProtoMessage() {}
+
+func (x *GetInterfaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInterfaceResponse.ProtoReflect.Descriptor instead.
+func (*GetInterfaceResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetInterfaceResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetInterfaceResponse) GetInterface() *Interface {
+	if x != nil {
+		return x.Interface
+	}
+	return nil
+}
+
+type SetInterfaceAliasNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterfaceName string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	AliasName     string                 `protobuf:"bytes,2,opt,name=alias_name,json=aliasName,proto3" json:"alias_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInterfaceAliasNameRequest) Reset() {
+	*x = SetInterfaceAliasNameRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInterfaceAliasNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInterfaceAliasNameRequest) ProtoMessage() {}
+
+func (x *SetInterfaceAliasNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInterfaceAliasNameRequest.ProtoReflect.Descriptor instead.
+func (*SetInterfaceAliasNameRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetInterfaceAliasNameRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *SetInterfaceAliasNameRequest) GetAliasName() string {
+	if x != nil {
+		return x.AliasName
+	}
+	return ""
+}
+
+type SetInterfaceAliasNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Interface     *Interface             `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInterfaceAliasNameResponse) Reset() {
+	*x = SetInterfaceAliasNameResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInterfaceAliasNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInterfaceAliasNameResponse) ProtoMessage() {}
+
+func (x *SetInterfaceAliasNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInterfaceAliasNameResponse.ProtoReflect.Descriptor instead.
+func (*SetInterfaceAliasNameResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetInterfaceAliasNameResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SetInterfaceAliasNameResponse) GetInterface() *Interface {
+	if x != nil {
+		return x.Interface
+	}
+	return nil
+}
+
+type SaveConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveConfigRequest) Reset() {
+	*x = SaveConfigRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveConfigRequest) ProtoMessage() {}
+
+func (x *SaveConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveConfigRequest.ProtoReflect.Descriptor instead.
+func (*SaveConfigRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{41}
+}
+
+type SaveConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveConfigResponse) Reset() {
+	*x = SaveConfigResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveConfigResponse) ProtoMessage() {}
+
+func (x *SaveConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveConfigResponse.ProtoReflect.Descriptor instead.
+func (*SaveConfigResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SaveConfigResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type VlanMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VlanName      string                 `protobuf:"bytes,1,opt,name=vlan_name,json=vlanName,proto3" json:"vlan_name,omitempty"`
+	InterfaceName string                 `protobuf:"bytes,2,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	TaggingMode   string                 `protobuf:"bytes,3,opt,name=tagging_mode,json=taggingMode,proto3" json:"tagging_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VlanMember) Reset() {
+	*x = VlanMember{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VlanMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VlanMember) ProtoMessage() {}
+
+func (x *VlanMember) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VlanMember.ProtoReflect.Descriptor instead.
+func (*VlanMember) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *VlanMember) GetVlanName() string {
+	if x != nil {
+		return x.VlanName
+	}
+	return ""
+}
+
+func (x *VlanMember) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *VlanMember) GetTaggingMode() string {
+	if x != nil {
+		return x.TaggingMode
+	}
+	return ""
+}
+
+type Vlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	VlanId        uint32                 `protobuf:"varint,2,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`
+	Members       []*VlanMember          `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vlan) Reset() {
+	*x = Vlan{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vlan) ProtoMessage() {}
+
+func (x *Vlan) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vlan.ProtoReflect.Descriptor instead.
+func (*Vlan) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Vlan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Vlan) GetVlanId() uint32 {
+	if x != nil {
+		return x.VlanId
+	}
+	return 0
+}
+
+func (x *Vlan) GetMembers() []*VlanMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Vlan) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type CreateVlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VlanId        uint32                 `protobuf:"varint,1,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVlanRequest) Reset() {
+	*x = CreateVlanRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVlanRequest) ProtoMessage() {}
+
+func (x *CreateVlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVlanRequest.ProtoReflect.Descriptor instead.
+func (*CreateVlanRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateVlanRequest) GetVlanId() uint32 {
+	if x != nil {
+		return x.VlanId
+	}
+	return 0
+}
+
+type CreateVlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Vlan          *Vlan                  `protobuf:"bytes,2,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVlanResponse) Reset() {
+	*x = CreateVlanResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVlanResponse) ProtoMessage() {}
+
+func (x *CreateVlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVlanResponse.ProtoReflect.Descriptor instead.
+func (*CreateVlanResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateVlanResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CreateVlanResponse) GetVlan() *Vlan {
+	if x != nil {
+		return x.Vlan
+	}
+	return nil
+}
+
+type DeleteVlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VlanId        uint32                 `protobuf:"varint,1,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVlanRequest) Reset() {
+	*x = DeleteVlanRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVlanRequest) ProtoMessage() {}
+
+func (x *DeleteVlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVlanRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVlanRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteVlanRequest) GetVlanId() uint32 {
+	if x != nil {
+		return x.VlanId
+	}
+	return 0
+}
+
+type DeleteVlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVlanResponse) Reset() {
+	*x = DeleteVlanResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVlanResponse) ProtoMessage() {}
+
+func (x *DeleteVlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVlanResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVlanResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteVlanResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListVlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVlansRequest) Reset() {
+	*x = ListVlansRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVlansRequest) ProtoMessage() {}
+
+func (x *ListVlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVlansRequest.ProtoReflect.Descriptor instead.
+func (*ListVlansRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{49}
+}
+
+type ListVlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Vlans         []*Vlan                `protobuf:"bytes,2,rep,name=vlans,proto3" json:"vlans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVlansResponse) Reset() {
+	*x = ListVlansResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVlansResponse) ProtoMessage() {}
+
+func (x *ListVlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVlansResponse.ProtoReflect.Descriptor instead.
+func (*ListVlansResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListVlansResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListVlansResponse) GetVlans() []*Vlan {
+	if x != nil {
+		return x.Vlans
+	}
+	return nil
+}
+
+type AddVlanMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VlanId        uint32                 `protobuf:"varint,1,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`
+	InterfaceName string                 `protobuf:"bytes,2,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	TaggingMode   string                 `protobuf:"bytes,3,opt,name=tagging_mode,json=taggingMode,proto3" json:"tagging_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVlanMemberRequest) Reset() {
+	*x = AddVlanMemberRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVlanMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVlanMemberRequest) ProtoMessage() {}
+
+func (x *AddVlanMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVlanMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddVlanMemberRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AddVlanMemberRequest) GetVlanId() uint32 {
+	if x != nil {
+		return x.VlanId
+	}
+	return 0
+}
+
+func (x *AddVlanMemberRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *AddVlanMemberRequest) GetTaggingMode() string {
+	if x != nil {
+		return x.TaggingMode
+	}
+	return ""
+}
+
+type AddVlanMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Member        *VlanMember            `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVlanMemberResponse) Reset() {
+	*x = AddVlanMemberResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVlanMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVlanMemberResponse) ProtoMessage() {}
+
+func (x *AddVlanMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVlanMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddVlanMemberResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AddVlanMemberResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *AddVlanMemberResponse) GetMember() *VlanMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type RemoveVlanMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VlanId        uint32                 `protobuf:"varint,1,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`
+	InterfaceName string                 `protobuf:"bytes,2,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveVlanMemberRequest) Reset() {
+	*x = RemoveVlanMemberRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveVlanMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveVlanMemberRequest) ProtoMessage() {}
+
+func (x *RemoveVlanMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveVlanMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveVlanMemberRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RemoveVlanMemberRequest) GetVlanId() uint32 {
+	if x != nil {
+		return x.VlanId
+	}
+	return 0
+}
+
+func (x *RemoveVlanMemberRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+type RemoveVlanMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveVlanMemberResponse) Reset() {
+	*x = RemoveVlanMemberResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveVlanMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveVlanMemberResponse) ProtoMessage() {}
+
+func (x *RemoveVlanMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveVlanMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveVlanMemberResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RemoveVlanMemberResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PortChannelMember struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PortChannelName string                 `protobuf:"bytes,1,opt,name=port_channel_name,json=portChannelName,proto3" json:"port_channel_name,omitempty"`
+	InterfaceName   string                 `protobuf:"bytes,2,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	Selected        bool                   `protobuf:"varint,3,opt,name=selected,proto3" json:"selected,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PortChannelMember) Reset() {
+	*x = PortChannelMember{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortChannelMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortChannelMember) ProtoMessage() {}
+
+func (x *PortChannelMember) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortChannelMember.ProtoReflect.Descriptor instead.
+func (*PortChannelMember) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PortChannelMember) GetPortChannelName() string {
+	if x != nil {
+		return x.PortChannelName
+	}
+	return ""
+}
+
+func (x *PortChannelMember) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *PortChannelMember) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+type PortChannel struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Members           []*PortChannelMember   `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	AdminStatus       string                 `protobuf:"bytes,3,opt,name=admin_status,json=adminStatus,proto3" json:"admin_status,omitempty"`
+	OperationalStatus string                 `protobuf:"bytes,4,opt,name=operational_status,json=operationalStatus,proto3" json:"operational_status,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PortChannel) Reset() {
+	*x = PortChannel{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortChannel) ProtoMessage() {}
+
+func (x *PortChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortChannel.ProtoReflect.Descriptor instead.
+func (*PortChannel) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PortChannel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PortChannel) GetMembers() []*PortChannelMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *PortChannel) GetAdminStatus() string {
+	if x != nil {
+		return x.AdminStatus
+	}
+	return ""
+}
+
+func (x *PortChannel) GetOperationalStatus() string {
+	if x != nil {
+		return x.OperationalStatus
+	}
+	return ""
+}
+
+type CreatePortChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AdminStatus   string                 `protobuf:"bytes,2,opt,name=admin_status,json=adminStatus,proto3" json:"admin_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePortChannelRequest) Reset() {
+	*x = CreatePortChannelRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePortChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePortChannelRequest) ProtoMessage() {}
+
+func (x *CreatePortChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePortChannelRequest.ProtoReflect.Descriptor instead.
+func (*CreatePortChannelRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreatePortChannelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePortChannelRequest) GetAdminStatus() string {
+	if x != nil {
+		return x.AdminStatus
+	}
+	return ""
+}
+
+type CreatePortChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PortChannel   *PortChannel           `protobuf:"bytes,2,opt,name=port_channel,json=portChannel,proto3" json:"port_channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePortChannelResponse) Reset() {
+	*x = CreatePortChannelResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePortChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePortChannelResponse) ProtoMessage() {}
+
+func (x *CreatePortChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePortChannelResponse.ProtoReflect.Descriptor instead.
+func (*CreatePortChannelResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreatePortChannelResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CreatePortChannelResponse) GetPortChannel() *PortChannel {
+	if x != nil {
+		return x.PortChannel
+	}
+	return nil
+}
+
+type DeletePortChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePortChannelRequest) Reset() {
+	*x = DeletePortChannelRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePortChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePortChannelRequest) ProtoMessage() {}
+
+func (x *DeletePortChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePortChannelRequest.ProtoReflect.Descriptor instead.
+func (*DeletePortChannelRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeletePortChannelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeletePortChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePortChannelResponse) Reset() {
+	*x = DeletePortChannelResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePortChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePortChannelResponse) ProtoMessage() {}
+
+func (x *DeletePortChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePortChannelResponse.ProtoReflect.Descriptor instead.
+func (*DeletePortChannelResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeletePortChannelResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetPortChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortChannelRequest) Reset() {
+	*x = GetPortChannelRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortChannelRequest) ProtoMessage() {}
+
+func (x *GetPortChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortChannelRequest.ProtoReflect.Descriptor instead.
+func (*GetPortChannelRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetPortChannelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetPortChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PortChannel   *PortChannel           `protobuf:"bytes,2,opt,name=port_channel,json=portChannel,proto3" json:"port_channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortChannelResponse) Reset() {
+	*x = GetPortChannelResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortChannelResponse) ProtoMessage() {}
+
+func (x *GetPortChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortChannelResponse.ProtoReflect.Descriptor instead.
+func (*GetPortChannelResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetPortChannelResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetPortChannelResponse) GetPortChannel() *PortChannel {
+	if x != nil {
+		return x.PortChannel
+	}
+	return nil
+}
+
+type ListPortChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPortChannelsRequest) Reset() {
+	*x = ListPortChannelsRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Interface) String() string {
+func (x *ListPortChannelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Interface) ProtoMessage() {}
+func (*ListPortChannelsRequest) ProtoMessage() {}
 
-func (x *Interface) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[3]
+func (x *ListPortChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -232,75 +3532,138 @@ func (x *Interface) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Interface.ProtoReflect.Descriptor instead.
-func (*Interface) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListPortChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListPortChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *Interface) GetName() string {
+type ListPortChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PortChannels  []*PortChannel         `protobuf:"bytes,2,rep,name=port_channels,json=portChannels,proto3" json:"port_channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPortChannelsResponse) Reset() {
+	*x = ListPortChannelsResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPortChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortChannelsResponse) ProtoMessage() {}
+
+func (x *ListPortChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[64]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Interface) GetNativeName() string {
+// Deprecated: Use ListPortChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListPortChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListPortChannelsResponse) GetStatus() *Status {
 	if x != nil {
-		return x.NativeName
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-func (x *Interface) GetAliasName() string {
+func (x *ListPortChannelsResponse) GetPortChannels() []*PortChannel {
 	if x != nil {
-		return x.AliasName
+		return x.PortChannels
 	}
-	return ""
+	return nil
 }
 
-func (x *Interface) GetOperationalStatus() string {
+type AddPortChannelMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	InterfaceName string                 `protobuf:"bytes,2,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPortChannelMemberRequest) Reset() {
+	*x = AddPortChannelMemberRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPortChannelMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPortChannelMemberRequest) ProtoMessage() {}
+
+func (x *AddPortChannelMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[65]
 	if x != nil {
-		return x.OperationalStatus
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Interface) GetAdminStatus() string {
+// Deprecated: Use AddPortChannelMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddPortChannelMemberRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AddPortChannelMemberRequest) GetName() string {
 	if x != nil {
-		return x.AdminStatus
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Interface) GetMacAddress() string {
+func (x *AddPortChannelMemberRequest) GetInterfaceName() string {
 	if x != nil {
-		return x.MacAddress
+		return x.InterfaceName
 	}
 	return ""
 }
 
-// The request message containing the parameters for the request.
-type ListInterfacesRequest struct {
+type AddPortChannelMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Member        *PortChannelMember     `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInterfacesRequest) Reset() {
-	*x = ListInterfacesRequest{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[4]
+func (x *AddPortChannelMemberResponse) Reset() {
+	*x = AddPortChannelMemberResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInterfacesRequest) String() string {
+func (x *AddPortChannelMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInterfacesRequest) ProtoMessage() {}
+func (*AddPortChannelMemberResponse) ProtoMessage() {}
 
-func (x *ListInterfacesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[4]
+func (x *AddPortChannelMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,35 +3674,48 @@ func (x *ListInterfacesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInterfacesRequest.ProtoReflect.Descriptor instead.
-func (*ListInterfacesRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use AddPortChannelMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddPortChannelMemberResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{66}
 }
 
-// The response message containing the list of interfaces.
-type ListInterfacesResponse struct {
+func (x *AddPortChannelMemberResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *AddPortChannelMemberResponse) GetMember() *PortChannelMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type RemovePortChannelMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Interfaces    []*Interface           `protobuf:"bytes,2,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	InterfaceName string                 `protobuf:"bytes,2,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInterfacesResponse) Reset() {
-	*x = ListInterfacesResponse{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[5]
+func (x *RemovePortChannelMemberRequest) Reset() {
+	*x = RemovePortChannelMemberRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInterfacesResponse) String() string {
+func (x *RemovePortChannelMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInterfacesResponse) ProtoMessage() {}
+func (*RemovePortChannelMemberRequest) ProtoMessage() {}
 
-func (x *ListInterfacesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[5]
+func (x *RemovePortChannelMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,48 +3726,94 @@ func (x *ListInterfacesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInterfacesResponse.ProtoReflect.Descriptor instead.
-func (*ListInterfacesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use RemovePortChannelMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemovePortChannelMemberRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ListInterfacesResponse) GetStatus() *Status {
+func (x *RemovePortChannelMemberRequest) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ListInterfacesResponse) GetInterfaces() []*Interface {
+func (x *RemovePortChannelMemberRequest) GetInterfaceName() string {
 	if x != nil {
-		return x.Interfaces
+		return x.InterfaceName
+	}
+	return ""
+}
+
+type RemovePortChannelMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePortChannelMemberResponse) Reset() {
+	*x = RemovePortChannelMemberResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePortChannelMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePortChannelMemberResponse) ProtoMessage() {}
+
+func (x *RemovePortChannelMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePortChannelMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemovePortChannelMemberResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RemovePortChannelMemberResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
 	}
 	return nil
 }
 
-type SetInterfaceAdminStatusRequest struct {
+type InterfaceAddress struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InterfaceName string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
-	AdminStatus   string                 `protobuf:"bytes,2,opt,name=admin_status,json=adminStatus,proto3" json:"admin_status,omitempty"`
+	Prefix        string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Configured    bool                   `protobuf:"varint,3,opt,name=configured,proto3" json:"configured,omitempty"`
+	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetInterfaceAdminStatusRequest) Reset() {
-	*x = SetInterfaceAdminStatusRequest{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[6]
+func (x *InterfaceAddress) Reset() {
+	*x = InterfaceAddress{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetInterfaceAdminStatusRequest) String() string {
+func (x *InterfaceAddress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetInterfaceAdminStatusRequest) ProtoMessage() {}
+func (*InterfaceAddress) ProtoMessage() {}
 
-func (x *SetInterfaceAdminStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[6]
+func (x *InterfaceAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,48 +3824,106 @@ func (x *SetInterfaceAdminStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetInterfaceAdminStatusRequest.ProtoReflect.Descriptor instead.
-func (*SetInterfaceAdminStatusRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use InterfaceAddress.ProtoReflect.Descriptor instead.
+func (*InterfaceAddress) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *SetInterfaceAdminStatusRequest) GetInterfaceName() string {
+func (x *InterfaceAddress) GetInterfaceName() string {
 	if x != nil {
 		return x.InterfaceName
 	}
 	return ""
 }
 
-func (x *SetInterfaceAdminStatusRequest) GetAdminStatus() string {
+func (x *InterfaceAddress) GetPrefix() string {
 	if x != nil {
-		return x.AdminStatus
+		return x.Prefix
 	}
 	return ""
 }
 
-type SetInterfaceAdminStatusResponse struct {
+func (x *InterfaceAddress) GetConfigured() bool {
+	if x != nil {
+		return x.Configured
+	}
+	return false
+}
+
+func (x *InterfaceAddress) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type ListInterfaceAddressesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterfaceName string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInterfaceAddressesRequest) Reset() {
+	*x = ListInterfaceAddressesRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInterfaceAddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInterfaceAddressesRequest) ProtoMessage() {}
+
+func (x *ListInterfaceAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInterfaceAddressesRequest.ProtoReflect.Descriptor instead.
+func (*ListInterfaceAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListInterfaceAddressesRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+type ListInterfaceAddressesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Interface     *Interface             `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	Addresses     []*InterfaceAddress    `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetInterfaceAdminStatusResponse) Reset() {
-	*x = SetInterfaceAdminStatusResponse{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[7]
+func (x *ListInterfaceAddressesResponse) Reset() {
+	*x = ListInterfaceAddressesResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetInterfaceAdminStatusResponse) String() string {
+func (x *ListInterfaceAddressesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetInterfaceAdminStatusResponse) ProtoMessage() {}
+func (*ListInterfaceAddressesResponse) ProtoMessage() {}
 
-func (x *SetInterfaceAdminStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[7]
+func (x *ListInterfaceAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,46 +3934,48 @@ func (x *SetInterfaceAdminStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetInterfaceAdminStatusResponse.ProtoReflect.Descriptor instead.
-func (*SetInterfaceAdminStatusResponse) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListInterfaceAddressesResponse.ProtoReflect.Descriptor instead.
+func (*ListInterfaceAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *SetInterfaceAdminStatusResponse) GetStatus() *Status {
+func (x *ListInterfaceAddressesResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *SetInterfaceAdminStatusResponse) GetInterface() *Interface {
+func (x *ListInterfaceAddressesResponse) GetAddresses() []*InterfaceAddress {
 	if x != nil {
-		return x.Interface
+		return x.Addresses
 	}
 	return nil
 }
 
-type ListPortsRequest struct {
+type AddInterfaceAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterfaceName string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPortsRequest) Reset() {
-	*x = ListPortsRequest{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[8]
+func (x *AddInterfaceAddressRequest) Reset() {
+	*x = AddInterfaceAddressRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPortsRequest) String() string {
+func (x *AddInterfaceAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPortsRequest) ProtoMessage() {}
+func (*AddInterfaceAddressRequest) ProtoMessage() {}
 
-func (x *ListPortsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[8]
+func (x *AddInterfaceAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,34 +3986,48 @@ func (x *ListPortsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPortsRequest.ProtoReflect.Descriptor instead.
-func (*ListPortsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use AddInterfaceAddressRequest.ProtoReflect.Descriptor instead.
+func (*AddInterfaceAddressRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{72}
 }
 
-type ListPortsResponse struct {
+func (x *AddInterfaceAddressRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *AddInterfaceAddressRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type AddInterfaceAddressResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Ports         []*Port                `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
+	Address       *InterfaceAddress      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPortsResponse) Reset() {
-	*x = ListPortsResponse{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[9]
+func (x *AddInterfaceAddressResponse) Reset() {
+	*x = AddInterfaceAddressResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPortsResponse) String() string {
+func (x *AddInterfaceAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPortsResponse) ProtoMessage() {}
+func (*AddInterfaceAddressResponse) ProtoMessage() {}
 
-func (x *ListPortsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[9]
+func (x *AddInterfaceAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,48 +4038,48 @@ func (x *ListPortsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPortsResponse.ProtoReflect.Descriptor instead.
-func (*ListPortsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use AddInterfaceAddressResponse.ProtoReflect.Descriptor instead.
+func (*AddInterfaceAddressResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *ListPortsResponse) GetStatus() *Status {
+func (x *AddInterfaceAddressResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *ListPortsResponse) GetPorts() []*Port {
+func (x *AddInterfaceAddressResponse) GetAddress() *InterfaceAddress {
 	if x != nil {
-		return x.Ports
+		return x.Address
 	}
 	return nil
 }
 
-type Port struct {
+type RemoveInterfaceAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Alias         string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	InterfaceName string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Port) Reset() {
-	*x = Port{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[10]
+func (x *RemoveInterfaceAddressRequest) Reset() {
+	*x = RemoveInterfaceAddressRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Port) String() string {
+func (x *RemoveInterfaceAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Port) ProtoMessage() {}
+func (*RemoveInterfaceAddressRequest) ProtoMessage() {}
 
-func (x *Port) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[10]
+func (x *RemoveInterfaceAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,47 +4090,47 @@ func (x *Port) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Port.ProtoReflect.Descriptor instead.
-func (*Port) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RemoveInterfaceAddressRequest.ProtoReflect.Descriptor instead.
+func (*RemoveInterfaceAddressRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *Port) GetName() string {
+func (x *RemoveInterfaceAddressRequest) GetInterfaceName() string {
 	if x != nil {
-		return x.Name
+		return x.InterfaceName
 	}
 	return ""
 }
 
-func (x *Port) GetAlias() string {
+func (x *RemoveInterfaceAddressRequest) GetPrefix() string {
 	if x != nil {
-		return x.Alias
+		return x.Prefix
 	}
 	return ""
 }
 
-type GetInterfaceNeighborRequest struct {
+type RemoveInterfaceAddressResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InterfaceName string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInterfaceNeighborRequest) Reset() {
-	*x = GetInterfaceNeighborRequest{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[11]
+func (x *RemoveInterfaceAddressResponse) Reset() {
+	*x = RemoveInterfaceAddressResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInterfaceNeighborRequest) String() string {
+func (x *RemoveInterfaceAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInterfaceNeighborRequest) ProtoMessage() {}
+func (*RemoveInterfaceAddressResponse) ProtoMessage() {}
 
-func (x *GetInterfaceNeighborRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[11]
+func (x *RemoveInterfaceAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -645,42 +4141,46 @@ func (x *GetInterfaceNeighborRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInterfaceNeighborRequest.ProtoReflect.Descriptor instead.
-func (*GetInterfaceNeighborRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RemoveInterfaceAddressResponse.ProtoReflect.Descriptor instead.
+func (*RemoveInterfaceAddressResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetInterfaceNeighborRequest) GetInterfaceName() string {
+func (x *RemoveInterfaceAddressResponse) GetStatus() *Status {
 	if x != nil {
-		return x.InterfaceName
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-type InterfaceNeighbor struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	NeighborInterfaceName string                 `protobuf:"bytes,1,opt,name=neighbor_interface_name,json=neighborInterfaceName,proto3" json:"neighbor_interface_name,omitempty"`
-	MacAddress            string                 `protobuf:"bytes,2,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
-	SystemName            string                 `protobuf:"bytes,3,opt,name=system_name,json=systemName,proto3" json:"system_name,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type BGPNeighbor struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Neighbor         string                 `protobuf:"bytes,1,opt,name=neighbor,proto3" json:"neighbor,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RemoteAs         string                 `protobuf:"bytes,3,opt,name=remote_as,json=remoteAs,proto3" json:"remote_as,omitempty"`
+	State            string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	PrefixesReceived uint64                 `protobuf:"varint,5,opt,name=prefixes_received,json=prefixesReceived,proto3" json:"prefixes_received,omitempty"`
+	Uptime           string                 `protobuf:"bytes,6,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	Configured       bool                   `protobuf:"varint,7,opt,name=configured,proto3" json:"configured,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *InterfaceNeighbor) Reset() {
-	*x = InterfaceNeighbor{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[12]
+func (x *BGPNeighbor) Reset() {
+	*x = BGPNeighbor{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InterfaceNeighbor) String() string {
+func (x *BGPNeighbor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InterfaceNeighbor) ProtoMessage() {}
+func (*BGPNeighbor) ProtoMessage() {}
 
-func (x *InterfaceNeighbor) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[12]
+func (x *BGPNeighbor) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,114 +4191,81 @@ func (x *InterfaceNeighbor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InterfaceNeighbor.ProtoReflect.Descriptor instead.
-func (*InterfaceNeighbor) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use BGPNeighbor.ProtoReflect.Descriptor instead.
+func (*BGPNeighbor) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *InterfaceNeighbor) GetNeighborInterfaceName() string {
+func (x *BGPNeighbor) GetNeighbor() string {
 	if x != nil {
-		return x.NeighborInterfaceName
+		return x.Neighbor
 	}
 	return ""
 }
 
-func (x *InterfaceNeighbor) GetMacAddress() string {
+func (x *BGPNeighbor) GetName() string {
 	if x != nil {
-		return x.MacAddress
+		return x.Name
 	}
 	return ""
 }
 
-func (x *InterfaceNeighbor) GetSystemName() string {
+func (x *BGPNeighbor) GetRemoteAs() string {
 	if x != nil {
-		return x.SystemName
+		return x.RemoteAs
 	}
 	return ""
 }
 
-type GetInterfaceNeighborResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Interface     string                 `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
-	Neighbor      *InterfaceNeighbor     `protobuf:"bytes,3,opt,name=neighbor,proto3" json:"neighbor,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetInterfaceNeighborResponse) Reset() {
-	*x = GetInterfaceNeighborResponse{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetInterfaceNeighborResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetInterfaceNeighborResponse) ProtoMessage() {}
-
-func (x *GetInterfaceNeighborResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[13]
+func (x *BGPNeighbor) GetState() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.State
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetInterfaceNeighborResponse.ProtoReflect.Descriptor instead.
-func (*GetInterfaceNeighborResponse) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{13}
+	return ""
 }
 
-func (x *GetInterfaceNeighborResponse) GetStatus() *Status {
+func (x *BGPNeighbor) GetPrefixesReceived() uint64 {
 	if x != nil {
-		return x.Status
+		return x.PrefixesReceived
 	}
-	return nil
+	return 0
 }
 
-func (x *GetInterfaceNeighborResponse) GetInterface() string {
+func (x *BGPNeighbor) GetUptime() string {
 	if x != nil {
-		return x.Interface
+		return x.Uptime
 	}
 	return ""
 }
 
-func (x *GetInterfaceNeighborResponse) GetNeighbor() *InterfaceNeighbor {
+func (x *BGPNeighbor) GetConfigured() bool {
 	if x != nil {
-		return x.Neighbor
+		return x.Configured
 	}
-	return nil
+	return false
 }
 
-type GetInterfaceRequest struct {
+type ListBGPNeighborsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InterfaceName string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInterfaceRequest) Reset() {
-	*x = GetInterfaceRequest{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[14]
+func (x *ListBGPNeighborsRequest) Reset() {
+	*x = ListBGPNeighborsRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInterfaceRequest) String() string {
+func (x *ListBGPNeighborsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInterfaceRequest) ProtoMessage() {}
+func (*ListBGPNeighborsRequest) ProtoMessage() {}
 
-func (x *GetInterfaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[14]
+func (x *ListBGPNeighborsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,41 +4276,34 @@ func (x *GetInterfaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInterfaceRequest.ProtoReflect.Descriptor instead.
-func (*GetInterfaceRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetInterfaceRequest) GetInterfaceName() string {
-	if x != nil {
-		return x.InterfaceName
-	}
-	return ""
+// Deprecated: Use ListBGPNeighborsRequest.ProtoReflect.Descriptor instead.
+func (*ListBGPNeighborsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{77}
 }
 
-type GetInterfaceResponse struct {
+type ListBGPNeighborsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Interface     *Interface             `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	Neighbors     []*BGPNeighbor         `protobuf:"bytes,2,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInterfaceResponse) Reset() {
-	*x = GetInterfaceResponse{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[15]
+func (x *ListBGPNeighborsResponse) Reset() {
+	*x = ListBGPNeighborsResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInterfaceResponse) String() string {
+func (x *ListBGPNeighborsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInterfaceResponse) ProtoMessage() {}
+func (*ListBGPNeighborsResponse) ProtoMessage() {}
 
-func (x *GetInterfaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[15]
+func (x *ListBGPNeighborsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,48 +4314,49 @@ func (x *GetInterfaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInterfaceResponse.ProtoReflect.Descriptor instead.
-func (*GetInterfaceResponse) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListBGPNeighborsResponse.ProtoReflect.Descriptor instead.
+func (*ListBGPNeighborsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetInterfaceResponse) GetStatus() *Status {
+func (x *ListBGPNeighborsResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *GetInterfaceResponse) GetInterface() *Interface {
+func (x *ListBGPNeighborsResponse) GetNeighbors() []*BGPNeighbor {
 	if x != nil {
-		return x.Interface
+		return x.Neighbors
 	}
 	return nil
 }
 
-type SetInterfaceAliasNameRequest struct {
+type AddBGPNeighborRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InterfaceName string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
-	AliasName     string                 `protobuf:"bytes,2,opt,name=alias_name,json=aliasName,proto3" json:"alias_name,omitempty"`
+	RemoteAs      string                 `protobuf:"bytes,2,opt,name=remote_as,json=remoteAs,proto3" json:"remote_as,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetInterfaceAliasNameRequest) Reset() {
-	*x = SetInterfaceAliasNameRequest{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[16]
+func (x *AddBGPNeighborRequest) Reset() {
+	*x = AddBGPNeighborRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetInterfaceAliasNameRequest) String() string {
+func (x *AddBGPNeighborRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetInterfaceAliasNameRequest) ProtoMessage() {}
+func (*AddBGPNeighborRequest) ProtoMessage() {}
 
-func (x *SetInterfaceAliasNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[16]
+func (x *AddBGPNeighborRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,48 +4367,55 @@ func (x *SetInterfaceAliasNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetInterfaceAliasNameRequest.ProtoReflect.Descriptor instead.
-func (*SetInterfaceAliasNameRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use AddBGPNeighborRequest.ProtoReflect.Descriptor instead.
+func (*AddBGPNeighborRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *SetInterfaceAliasNameRequest) GetInterfaceName() string {
+func (x *AddBGPNeighborRequest) GetInterfaceName() string {
 	if x != nil {
 		return x.InterfaceName
 	}
 	return ""
 }
 
-func (x *SetInterfaceAliasNameRequest) GetAliasName() string {
+func (x *AddBGPNeighborRequest) GetRemoteAs() string {
 	if x != nil {
-		return x.AliasName
+		return x.RemoteAs
 	}
 	return ""
 }
 
-type SetInterfaceAliasNameResponse struct {
+func (x *AddBGPNeighborRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AddBGPNeighborResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Interface     *Interface             `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	Neighbor      *BGPNeighbor           `protobuf:"bytes,2,opt,name=neighbor,proto3" json:"neighbor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetInterfaceAliasNameResponse) Reset() {
-	*x = SetInterfaceAliasNameResponse{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[17]
+func (x *AddBGPNeighborResponse) Reset() {
+	*x = AddBGPNeighborResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetInterfaceAliasNameResponse) String() string {
+func (x *AddBGPNeighborResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetInterfaceAliasNameResponse) ProtoMessage() {}
+func (*AddBGPNeighborResponse) ProtoMessage() {}
 
-func (x *SetInterfaceAliasNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[17]
+func (x *AddBGPNeighborResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,46 +4426,47 @@ func (x *SetInterfaceAliasNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetInterfaceAliasNameResponse.ProtoReflect.Descriptor instead.
-func (*SetInterfaceAliasNameResponse) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use AddBGPNeighborResponse.ProtoReflect.Descriptor instead.
+func (*AddBGPNeighborResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *SetInterfaceAliasNameResponse) GetStatus() *Status {
+func (x *AddBGPNeighborResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *SetInterfaceAliasNameResponse) GetInterface() *Interface {
+func (x *AddBGPNeighborResponse) GetNeighbor() *BGPNeighbor {
 	if x != nil {
-		return x.Interface
+		return x.Neighbor
 	}
 	return nil
 }
 
-type SaveConfigRequest struct {
+type RemoveBGPNeighborRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterfaceName string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveConfigRequest) Reset() {
-	*x = SaveConfigRequest{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[18]
+func (x *RemoveBGPNeighborRequest) Reset() {
+	*x = RemoveBGPNeighborRequest{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveConfigRequest) String() string {
+func (x *RemoveBGPNeighborRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveConfigRequest) ProtoMessage() {}
+func (*RemoveBGPNeighborRequest) ProtoMessage() {}
 
-func (x *SaveConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[18]
+func (x *RemoveBGPNeighborRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1008,33 +4477,40 @@ func (x *SaveConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveConfigRequest.ProtoReflect.Descriptor instead.
-func (*SaveConfigRequest) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use RemoveBGPNeighborRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBGPNeighborRequest) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{81}
 }
 
-type SaveConfigResponse struct {
+func (x *RemoveBGPNeighborRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+type RemoveBGPNeighborResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveConfigResponse) Reset() {
-	*x = SaveConfigResponse{}
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[19]
+func (x *RemoveBGPNeighborResponse) Reset() {
+	*x = RemoveBGPNeighborResponse{}
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveConfigResponse) String() string {
+func (x *RemoveBGPNeighborResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveConfigResponse) ProtoMessage() {}
+func (*RemoveBGPNeighborResponse) ProtoMessage() {}
 
-func (x *SaveConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[19]
+func (x *RemoveBGPNeighborResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_agent_proto_switch_agent_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1045,12 +4521,12 @@ func (x *SaveConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveConfigResponse.ProtoReflect.Descriptor instead.
-func (*SaveConfigResponse) Descriptor() ([]byte, []int) {
-	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RemoveBGPNeighborResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBGPNeighborResponse) Descriptor() ([]byte, []int) {
+	return file_internal_agent_proto_switch_agent_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *SaveConfigResponse) GetStatus() *Status {
+func (x *RemoveBGPNeighborResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
@@ -1072,7 +4548,32 @@ const file_internal_agent_proto_switch_agent_proto_rawDesc = "" +
 	"\x11local_mac_address\x18\x03 \x01(\tR\x0flocalMacAddress\x12(\n" +
 	"\x10sonic_os_version\x18\x04 \x01(\tR\x0esonicOsVersion\x12\x1b\n" +
 	"\tasic_type\x18\x05 \x01(\tR\basicType\x12\x1c\n" +
-	"\treadiness\x18\x06 \x01(\rR\treadiness\"\xd2\x01\n" +
+	"\treadiness\x18\x06 \x01(\rR\treadiness\"\x89\x01\n" +
+	"\x03PSU\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpresence\x18\x02 \x01(\bR\bpresence\x12\x0e\n" +
+	"\x02ok\x18\x03 \x01(\bR\x02ok\x12\x14\n" +
+	"\x05model\x18\x04 \x01(\tR\x05model\x12\x16\n" +
+	"\x06serial\x18\x05 \x01(\tR\x06serial\x12\x14\n" +
+	"\x05power\x18\x06 \x01(\tR\x05power\"y\n" +
+	"\x03Fan\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpresence\x18\x02 \x01(\bR\bpresence\x12\x0e\n" +
+	"\x02ok\x18\x03 \x01(\bR\x02ok\x12\x14\n" +
+	"\x05speed\x18\x04 \x01(\tR\x05speed\x12\x1c\n" +
+	"\tdirection\x18\x05 \x01(\tR\tdirection\"\xc2\x01\n" +
+	"\x11TemperatureSensor\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vtemperature\x18\x02 \x01(\tR\vtemperature\x12%\n" +
+	"\x0ehigh_threshold\x18\x03 \x01(\tR\rhighThreshold\x126\n" +
+	"\x17critical_high_threshold\x18\x04 \x01(\tR\x15criticalHighThreshold\x12\x18\n" +
+	"\awarning\x18\x05 \x01(\bR\awarning\"\x1a\n" +
+	"\x18GetPlatformHealthRequest\"\xe4\x01\n" +
+	"\x19GetPlatformHealthResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x12'\n" +
+	"\x04psus\x18\x02 \x03(\v2\x13.switchagent.v1.PSUR\x04psus\x12'\n" +
+	"\x04fans\x18\x03 \x03(\v2\x13.switchagent.v1.FanR\x04fans\x12E\n" +
+	"\ftemperatures\x18\x04 \x03(\v2!.switchagent.v1.TemperatureSensorR\ftemperatures\"\xa6\x02\n" +
 	"\tInterface\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
 	"\vnative_name\x18\x02 \x01(\tR\n" +
@@ -1082,7 +4583,12 @@ const file_internal_agent_proto_switch_agent_proto_rawDesc = "" +
 	"\x12operational_status\x18\x04 \x01(\tR\x11operationalStatus\x12!\n" +
 	"\fadmin_status\x18\x05 \x01(\tR\vadminStatus\x12\x1f\n" +
 	"\vmac_address\x18\x06 \x01(\tR\n" +
-	"macAddress\"\x17\n" +
+	"macAddress\x12\x10\n" +
+	"\x03mtu\x18\a \x01(\rR\x03mtu\x12\x14\n" +
+	"\x05speed\x18\b \x01(\rR\x05speed\x12\x10\n" +
+	"\x03fec\x18\t \x01(\tR\x03fec\x12\x18\n" +
+	"\aautoneg\x18\n" +
+	" \x01(\tR\aautoneg\"\x17\n" +
 	"\x15ListInterfacesRequest\"\x83\x01\n" +
 	"\x16ListInterfacesResponse\x12.\n" +
 	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x129\n" +
@@ -1094,6 +4600,15 @@ const file_internal_agent_proto_switch_agent_proto_rawDesc = "" +
 	"\fadmin_status\x18\x02 \x01(\tR\vadminStatus\"\x8a\x01\n" +
 	"\x1fSetInterfaceAdminStatusResponse\x12.\n" +
 	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x127\n" +
+	"\tinterface\x18\x02 \x01(\v2\x19.switchagent.v1.InterfaceR\tinterface\"\x9e\x01\n" +
+	"!SetInterfacePortAttributesRequest\x12%\n" +
+	"\x0einterface_name\x18\x01 \x01(\tR\rinterfaceName\x12\x10\n" +
+	"\x03mtu\x18\x02 \x01(\rR\x03mtu\x12\x14\n" +
+	"\x05speed\x18\x03 \x01(\rR\x05speed\x12\x10\n" +
+	"\x03fec\x18\x04 \x01(\tR\x03fec\x12\x18\n" +
+	"\aautoneg\x18\x05 \x01(\tR\aautoneg\"\x8d\x01\n" +
+	"\"SetInterfacePortAttributesResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x127\n" +
 	"\tinterface\x18\x02 \x01(\v2\x19.switchagent.v1.InterfaceR\tinterface\"\x12\n" +
 	"\x10ListPortsRequest\"o\n" +
 	"\x11ListPortsResponse\x12.\n" +
@@ -1101,7 +4616,23 @@ const file_internal_agent_proto_switch_agent_proto_rawDesc = "" +
 	"\x05ports\x18\x02 \x03(\v2\x14.switchagent.v1.PortR\x05ports\"0\n" +
 	"\x04Port\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05alias\x18\x02 \x01(\tR\x05alias\"D\n" +
+	"\x05alias\x18\x02 \x01(\tR\x05alias\"\x98\x01\n" +
+	"\fPortBreakout\x12\x12\n" +
+	"\x04port\x18\x01 \x01(\tR\x04port\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12!\n" +
+	"\fdefault_mode\x18\x03 \x01(\tR\vdefaultMode\x12'\n" +
+	"\x0fsupported_modes\x18\x04 \x03(\tR\x0esupportedModes\x12\x14\n" +
+	"\x05ports\x18\x05 \x03(\tR\x05ports\"\x1a\n" +
+	"\x18ListPortBreakoutsRequest\"\x87\x01\n" +
+	"\x19ListPortBreakoutsResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x12:\n" +
+	"\tbreakouts\x18\x02 \x03(\v2\x1c.switchagent.v1.PortBreakoutR\tbreakouts\"@\n" +
+	"\x16SetPortBreakoutRequest\x12\x12\n" +
+	"\x04port\x18\x01 \x01(\tR\x04port\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\"\x83\x01\n" +
+	"\x17SetPortBreakoutResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x128\n" +
+	"\bbreakout\x18\x02 \x01(\v2\x1c.switchagent.v1.PortBreakoutR\bbreakout\"D\n" +
 	"\x1bGetInterfaceNeighborRequest\x12%\n" +
 	"\x0einterface_name\x18\x01 \x01(\tR\rinterfaceName\"\x8d\x01\n" +
 	"\x11InterfaceNeighbor\x126\n" +
@@ -1113,7 +4644,56 @@ const file_internal_agent_proto_switch_agent_proto_rawDesc = "" +
 	"\x1cGetInterfaceNeighborResponse\x12.\n" +
 	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x12\x1c\n" +
 	"\tinterface\x18\x02 \x01(\tR\tinterface\x12=\n" +
-	"\bneighbor\x18\x03 \x01(\v2!.switchagent.v1.InterfaceNeighborR\bneighbor\"<\n" +
+	"\bneighbor\x18\x03 \x01(\v2!.switchagent.v1.InterfaceNeighborR\bneighbor\"\xa5\x02\n" +
+	"\x11InterfaceCounters\x12\x1c\n" +
+	"\tinterface\x18\x01 \x01(\tR\tinterface\x12\x1b\n" +
+	"\trx_octets\x18\x02 \x01(\x04R\brxOctets\x12\x1d\n" +
+	"\n" +
+	"rx_packets\x18\x03 \x01(\x04R\trxPackets\x12\x1b\n" +
+	"\trx_errors\x18\x04 \x01(\x04R\brxErrors\x12\x1f\n" +
+	"\vrx_discards\x18\x05 \x01(\x04R\n" +
+	"rxDiscards\x12\x1b\n" +
+	"\ttx_octets\x18\x06 \x01(\x04R\btxOctets\x12\x1d\n" +
+	"\n" +
+	"tx_packets\x18\a \x01(\x04R\ttxPackets\x12\x1b\n" +
+	"\ttx_errors\x18\b \x01(\x04R\btxErrors\x12\x1f\n" +
+	"\vtx_discards\x18\t \x01(\x04R\n" +
+	"txDiscards\"D\n" +
+	"\x1bGetInterfaceCountersRequest\x12%\n" +
+	"\x0einterface_name\x18\x01 \x01(\tR\rinterfaceName\"\x8d\x01\n" +
+	"\x1cGetInterfaceCountersResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x12=\n" +
+	"\bcounters\x18\x02 \x01(\v2!.switchagent.v1.InterfaceCountersR\bcounters\"\x1e\n" +
+	"\x1cListInterfaceCountersRequest\"\x8e\x01\n" +
+	"\x1dListInterfaceCountersResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x12=\n" +
+	"\bcounters\x18\x02 \x03(\v2!.switchagent.v1.InterfaceCountersR\bcounters\"[\n" +
+	"\x0fTransceiverLane\x12\x12\n" +
+	"\x04lane\x18\x01 \x01(\rR\x04lane\x12\x19\n" +
+	"\brx_power\x18\x02 \x01(\tR\arxPower\x12\x19\n" +
+	"\btx_power\x18\x03 \x01(\tR\atxPower\"\x90\x02\n" +
+	"\vTransceiver\x12\x1c\n" +
+	"\tinterface\x18\x01 \x01(\tR\tinterface\x12\x16\n" +
+	"\x06vendor\x18\x02 \x01(\tR\x06vendor\x12\x1f\n" +
+	"\vpart_number\x18\x03 \x01(\tR\n" +
+	"partNumber\x12#\n" +
+	"\rserial_number\x18\x04 \x01(\tR\fserialNumber\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12 \n" +
+	"\vtemperature\x18\x06 \x01(\tR\vtemperature\x12\x18\n" +
+	"\avoltage\x18\a \x01(\tR\avoltage\x125\n" +
+	"\x05lanes\x18\b \x03(\v2\x1f.switchagent.v1.TransceiverLaneR\x05lanes\">\n" +
+	"\x15GetTransceiverRequest\x12%\n" +
+	"\x0einterface_name\x18\x01 \x01(\tR\rinterfaceName\"\x87\x01\n" +
+	"\x16GetTransceiverResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x12=\n" +
+	"\vtransceiver\x18\x02 \x01(\v2\x1b.switchagent.v1.TransceiverR\vtransceiver\"\x18\n" +
+	"\x16WatchInterfacesRequest\"\x97\x01\n" +
+	"\x0eInterfaceEvent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vnative_name\x18\x02 \x01(\tR\n" +
+	"nativeName\x12!\n" +
+	"\fadmin_status\x18\x03 \x01(\tR\vadminStatus\x12-\n" +
+	"\x12operational_status\x18\x04 \x01(\tR\x11operationalStatus\"<\n" +
 	"\x13GetInterfaceRequest\x12%\n" +
 	"\x0einterface_name\x18\x01 \x01(\tR\rinterfaceName\"\x7f\n" +
 	"\x14GetInterfaceResponse\x12.\n" +
@@ -1128,15 +4708,164 @@ const file_internal_agent_proto_switch_agent_proto_rawDesc = "" +
 	"\tinterface\x18\x02 \x01(\v2\x19.switchagent.v1.InterfaceR\tinterface\"\x13\n" +
 	"\x11SaveConfigRequest\"D\n" +
 	"\x12SaveConfigResponse\x12.\n" +
-	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status2\xba\x06\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\"s\n" +
+	"\n" +
+	"VlanMember\x12\x1b\n" +
+	"\tvlan_name\x18\x01 \x01(\tR\bvlanName\x12%\n" +
+	"\x0einterface_name\x18\x02 \x01(\tR\rinterfaceName\x12!\n" +
+	"\ftagging_mode\x18\x03 \x01(\tR\vtaggingMode\"\x7f\n" +
+	"\x04Vlan\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\avlan_id\x18\x02 \x01(\rR\x06vlanId\x124\n" +
+	"\amembers\x18\x03 \x03(\v2\x1a.switchagent.v1.VlanMemberR\amembers\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\",\n" +
+	"\x11CreateVlanRequest\x12\x17\n" +
+	"\avlan_id\x18\x01 \x01(\rR\x06vlanId\"n\n" +
+	"\x12CreateVlanResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x12(\n" +
+	"\x04vlan\x18\x02 \x01(\v2\x14.switchagent.v1.VlanR\x04vlan\",\n" +
+	"\x11DeleteVlanRequest\x12\x17\n" +
+	"\avlan_id\x18\x01 \x01(\rR\x06vlanId\"D\n" +
+	"\x12DeleteVlanResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\"\x12\n" +
+	"\x10ListVlansRequest\"o\n" +
+	"\x11ListVlansResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x12*\n" +
+	"\x05vlans\x18\x02 \x03(\v2\x14.switchagent.v1.VlanR\x05vlans\"y\n" +
+	"\x14AddVlanMemberRequest\x12\x17\n" +
+	"\avlan_id\x18\x01 \x01(\rR\x06vlanId\x12%\n" +
+	"\x0einterface_name\x18\x02 \x01(\tR\rinterfaceName\x12!\n" +
+	"\ftagging_mode\x18\x03 \x01(\tR\vtaggingMode\"{\n" +
+	"\x15AddVlanMemberResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x122\n" +
+	"\x06member\x18\x02 \x01(\v2\x1a.switchagent.v1.VlanMemberR\x06member\"Y\n" +
+	"\x17RemoveVlanMemberRequest\x12\x17\n" +
+	"\avlan_id\x18\x01 \x01(\rR\x06vlanId\x12%\n" +
+	"\x0einterface_name\x18\x02 \x01(\tR\rinterfaceName\"J\n" +
+	"\x18RemoveVlanMemberResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\"\x82\x01\n" +
+	"\x11PortChannelMember\x12*\n" +
+	"\x11port_channel_name\x18\x01 \x01(\tR\x0fportChannelName\x12%\n" +
+	"\x0einterface_name\x18\x02 \x01(\tR\rinterfaceName\x12\x1a\n" +
+	"\bselected\x18\x03 \x01(\bR\bselected\"\xb0\x01\n" +
+	"\vPortChannel\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12;\n" +
+	"\amembers\x18\x02 \x03(\v2!.switchagent.v1.PortChannelMemberR\amembers\x12!\n" +
+	"\fadmin_status\x18\x03 \x01(\tR\vadminStatus\x12-\n" +
+	"\x12operational_status\x18\x04 \x01(\tR\x11operationalStatus\"Q\n" +
+	"\x18CreatePortChannelRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fadmin_status\x18\x02 \x01(\tR\vadminStatus\"\x8b\x01\n" +
+	"\x19CreatePortChannelResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x12>\n" +
+	"\fport_channel\x18\x02 \x01(\v2\x1b.switchagent.v1.PortChannelR\vportChannel\".\n" +
+	"\x18DeletePortChannelRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"K\n" +
+	"\x19DeletePortChannelResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\"+\n" +
+	"\x15GetPortChannelRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x88\x01\n" +
+	"\x16GetPortChannelResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x12>\n" +
+	"\fport_channel\x18\x02 \x01(\v2\x1b.switchagent.v1.PortChannelR\vportChannel\"\x19\n" +
+	"\x17ListPortChannelsRequest\"\x8c\x01\n" +
+	"\x18ListPortChannelsResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x12@\n" +
+	"\rport_channels\x18\x02 \x03(\v2\x1b.switchagent.v1.PortChannelR\fportChannels\"X\n" +
+	"\x1bAddPortChannelMemberRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0einterface_name\x18\x02 \x01(\tR\rinterfaceName\"\x89\x01\n" +
+	"\x1cAddPortChannelMemberResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x129\n" +
+	"\x06member\x18\x02 \x01(\v2!.switchagent.v1.PortChannelMemberR\x06member\"[\n" +
+	"\x1eRemovePortChannelMemberRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0einterface_name\x18\x02 \x01(\tR\rinterfaceName\"Q\n" +
+	"\x1fRemovePortChannelMemberResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\"\x89\x01\n" +
+	"\x10InterfaceAddress\x12%\n" +
+	"\x0einterface_name\x18\x01 \x01(\tR\rinterfaceName\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x1e\n" +
+	"\n" +
+	"configured\x18\x03 \x01(\bR\n" +
+	"configured\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\"F\n" +
+	"\x1dListInterfaceAddressesRequest\x12%\n" +
+	"\x0einterface_name\x18\x01 \x01(\tR\rinterfaceName\"\x90\x01\n" +
+	"\x1eListInterfaceAddressesResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x12>\n" +
+	"\taddresses\x18\x02 \x03(\v2 .switchagent.v1.InterfaceAddressR\taddresses\"[\n" +
+	"\x1aAddInterfaceAddressRequest\x12%\n" +
+	"\x0einterface_name\x18\x01 \x01(\tR\rinterfaceName\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\"\x89\x01\n" +
+	"\x1bAddInterfaceAddressResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x12:\n" +
+	"\aaddress\x18\x02 \x01(\v2 .switchagent.v1.InterfaceAddressR\aaddress\"^\n" +
+	"\x1dRemoveInterfaceAddressRequest\x12%\n" +
+	"\x0einterface_name\x18\x01 \x01(\tR\rinterfaceName\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\"P\n" +
+	"\x1eRemoveInterfaceAddressResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\"\xd5\x01\n" +
+	"\vBGPNeighbor\x12\x1a\n" +
+	"\bneighbor\x18\x01 \x01(\tR\bneighbor\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tremote_as\x18\x03 \x01(\tR\bremoteAs\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12+\n" +
+	"\x11prefixes_received\x18\x05 \x01(\x04R\x10prefixesReceived\x12\x16\n" +
+	"\x06uptime\x18\x06 \x01(\tR\x06uptime\x12\x1e\n" +
+	"\n" +
+	"configured\x18\a \x01(\bR\n" +
+	"configured\"\x19\n" +
+	"\x17ListBGPNeighborsRequest\"\x85\x01\n" +
+	"\x18ListBGPNeighborsResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x129\n" +
+	"\tneighbors\x18\x02 \x03(\v2\x1b.switchagent.v1.BGPNeighborR\tneighbors\"o\n" +
+	"\x15AddBGPNeighborRequest\x12%\n" +
+	"\x0einterface_name\x18\x01 \x01(\tR\rinterfaceName\x12\x1b\n" +
+	"\tremote_as\x18\x02 \x01(\tR\bremoteAs\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x81\x01\n" +
+	"\x16AddBGPNeighborResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status\x127\n" +
+	"\bneighbor\x18\x02 \x01(\v2\x1b.switchagent.v1.BGPNeighborR\bneighbor\"A\n" +
+	"\x18RemoveBGPNeighborRequest\x12%\n" +
+	"\x0einterface_name\x18\x01 \x01(\tR\rinterfaceName\"K\n" +
+	"\x19RemoveBGPNeighborResponse\x12.\n" +
+	"\x06status\x18\x01 \x01(\v2\x16.switchagent.v1.StatusR\x06status2\xff\x1a\n" +
 	"\x12SwitchAgentService\x12\\\n" +
-	"\rGetDeviceInfo\x12$.switchagent.v1.GetDeviceInfoRequest\x1a%.switchagent.v1.GetDeviceInfoResponse\x12_\n" +
+	"\rGetDeviceInfo\x12$.switchagent.v1.GetDeviceInfoRequest\x1a%.switchagent.v1.GetDeviceInfoResponse\x12h\n" +
+	"\x11GetPlatformHealth\x12(.switchagent.v1.GetPlatformHealthRequest\x1a).switchagent.v1.GetPlatformHealthResponse\x12_\n" +
 	"\x0eListInterfaces\x12%.switchagent.v1.ListInterfacesRequest\x1a&.switchagent.v1.ListInterfacesResponse\x12z\n" +
 	"\x17SetInterfaceAdminStatus\x12..switchagent.v1.SetInterfaceAdminStatusRequest\x1a/.switchagent.v1.SetInterfaceAdminStatusResponse\x12t\n" +
-	"\x15SetInterfaceAliasName\x12,.switchagent.v1.SetInterfaceAliasNameRequest\x1a-.switchagent.v1.SetInterfaceAliasNameResponse\x12Y\n" +
+	"\x15SetInterfaceAliasName\x12,.switchagent.v1.SetInterfaceAliasNameRequest\x1a-.switchagent.v1.SetInterfaceAliasNameResponse\x12\x83\x01\n" +
+	"\x1aSetInterfacePortAttributes\x121.switchagent.v1.SetInterfacePortAttributesRequest\x1a2.switchagent.v1.SetInterfacePortAttributesResponse\x12Y\n" +
 	"\fGetInterface\x12#.switchagent.v1.GetInterfaceRequest\x1a$.switchagent.v1.GetInterfaceResponse\x12q\n" +
-	"\x14GetInterfaceNeighbor\x12+.switchagent.v1.GetInterfaceNeighborRequest\x1a,.switchagent.v1.GetInterfaceNeighborResponse\x12P\n" +
-	"\tListPorts\x12 .switchagent.v1.ListPortsRequest\x1a!.switchagent.v1.ListPortsResponse\x12S\n" +
+	"\x14GetInterfaceNeighbor\x12+.switchagent.v1.GetInterfaceNeighborRequest\x1a,.switchagent.v1.GetInterfaceNeighborResponse\x12q\n" +
+	"\x14GetInterfaceCounters\x12+.switchagent.v1.GetInterfaceCountersRequest\x1a,.switchagent.v1.GetInterfaceCountersResponse\x12t\n" +
+	"\x15ListInterfaceCounters\x12,.switchagent.v1.ListInterfaceCountersRequest\x1a-.switchagent.v1.ListInterfaceCountersResponse\x12_\n" +
+	"\x0eGetTransceiver\x12%.switchagent.v1.GetTransceiverRequest\x1a&.switchagent.v1.GetTransceiverResponse\x12[\n" +
+	"\x0fWatchInterfaces\x12&.switchagent.v1.WatchInterfacesRequest\x1a\x1e.switchagent.v1.InterfaceEvent0\x01\x12P\n" +
+	"\tListPorts\x12 .switchagent.v1.ListPortsRequest\x1a!.switchagent.v1.ListPortsResponse\x12h\n" +
+	"\x11ListPortBreakouts\x12(.switchagent.v1.ListPortBreakoutsRequest\x1a).switchagent.v1.ListPortBreakoutsResponse\x12b\n" +
+	"\x0fSetPortBreakout\x12&.switchagent.v1.SetPortBreakoutRequest\x1a'.switchagent.v1.SetPortBreakoutResponse\x12S\n" +
+	"\n" +
+	"CreateVlan\x12!.switchagent.v1.CreateVlanRequest\x1a\".switchagent.v1.CreateVlanResponse\x12S\n" +
+	"\n" +
+	"DeleteVlan\x12!.switchagent.v1.DeleteVlanRequest\x1a\".switchagent.v1.DeleteVlanResponse\x12P\n" +
+	"\tListVlans\x12 .switchagent.v1.ListVlansRequest\x1a!.switchagent.v1.ListVlansResponse\x12\\\n" +
+	"\rAddVlanMember\x12$.switchagent.v1.AddVlanMemberRequest\x1a%.switchagent.v1.AddVlanMemberResponse\x12e\n" +
+	"\x10RemoveVlanMember\x12'.switchagent.v1.RemoveVlanMemberRequest\x1a(.switchagent.v1.RemoveVlanMemberResponse\x12h\n" +
+	"\x11CreatePortChannel\x12(.switchagent.v1.CreatePortChannelRequest\x1a).switchagent.v1.CreatePortChannelResponse\x12h\n" +
+	"\x11DeletePortChannel\x12(.switchagent.v1.DeletePortChannelRequest\x1a).switchagent.v1.DeletePortChannelResponse\x12_\n" +
+	"\x0eGetPortChannel\x12%.switchagent.v1.GetPortChannelRequest\x1a&.switchagent.v1.GetPortChannelResponse\x12e\n" +
+	"\x10ListPortChannels\x12'.switchagent.v1.ListPortChannelsRequest\x1a(.switchagent.v1.ListPortChannelsResponse\x12q\n" +
+	"\x14AddPortChannelMember\x12+.switchagent.v1.AddPortChannelMemberRequest\x1a,.switchagent.v1.AddPortChannelMemberResponse\x12z\n" +
+	"\x17RemovePortChannelMember\x12..switchagent.v1.RemovePortChannelMemberRequest\x1a/.switchagent.v1.RemovePortChannelMemberResponse\x12w\n" +
+	"\x16ListInterfaceAddresses\x12-.switchagent.v1.ListInterfaceAddressesRequest\x1a..switchagent.v1.ListInterfaceAddressesResponse\x12n\n" +
+	"\x13AddInterfaceAddress\x12*.switchagent.v1.AddInterfaceAddressRequest\x1a+.switchagent.v1.AddInterfaceAddressResponse\x12w\n" +
+	"\x16RemoveInterfaceAddress\x12-.switchagent.v1.RemoveInterfaceAddressRequest\x1a..switchagent.v1.RemoveInterfaceAddressResponse\x12e\n" +
+	"\x10ListBGPNeighbors\x12'.switchagent.v1.ListBGPNeighborsRequest\x1a(.switchagent.v1.ListBGPNeighborsResponse\x12_\n" +
+	"\x0eAddBGPNeighbor\x12%.switchagent.v1.AddBGPNeighborRequest\x1a&.switchagent.v1.AddBGPNeighborResponse\x12h\n" +
+	"\x11RemoveBGPNeighbor\x12(.switchagent.v1.RemoveBGPNeighborRequest\x1a).switchagent.v1.RemoveBGPNeighborResponse\x12S\n" +
 	"\n" +
 	"SaveConfig\x12!.switchagent.v1.SaveConfigRequest\x1a\".switchagent.v1.SaveConfigResponseB\x14Z\x12./switchagentprotob\x06proto3"
 
@@ -1152,65 +4881,225 @@ func file_internal_agent_proto_switch_agent_proto_rawDescGZIP() []byte {
 	return file_internal_agent_proto_switch_agent_proto_rawDescData
 }
 
-var file_internal_agent_proto_switch_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_internal_agent_proto_switch_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
 var file_internal_agent_proto_switch_agent_proto_goTypes = []any{
-	(*Status)(nil),                          // 0: switchagent.v1.Status
-	(*GetDeviceInfoRequest)(nil),            // 1: switchagent.v1.GetDeviceInfoRequest
-	(*GetDeviceInfoResponse)(nil),           // 2: switchagent.v1.GetDeviceInfoResponse
-	(*Interface)(nil),                       // 3: switchagent.v1.Interface
-	(*ListInterfacesRequest)(nil),           // 4: switchagent.v1.ListInterfacesRequest
-	(*ListInterfacesResponse)(nil),          // 5: switchagent.v1.ListInterfacesResponse
-	(*SetInterfaceAdminStatusRequest)(nil),  // 6: switchagent.v1.SetInterfaceAdminStatusRequest
-	(*SetInterfaceAdminStatusResponse)(nil), // 7: switchagent.v1.SetInterfaceAdminStatusResponse
-	(*ListPortsRequest)(nil),                // 8: switchagent.v1.ListPortsRequest
-	(*ListPortsResponse)(nil),               // 9: switchagent.v1.ListPortsResponse
-	(*Port)(nil),                            // 10: switchagent.v1.Port
-	(*GetInterfaceNeighborRequest)(nil),     // 11: switchagent.v1.GetInterfaceNeighborRequest
-	(*InterfaceNeighbor)(nil),               // 12: switchagent.v1.InterfaceNeighbor
-	(*GetInterfaceNeighborResponse)(nil),    // 13: switchagent.v1.GetInterfaceNeighborResponse
-	(*GetInterfaceRequest)(nil),             // 14: switchagent.v1.GetInterfaceRequest
-	(*GetInterfaceResponse)(nil),            // 15: switchagent.v1.GetInterfaceResponse
-	(*SetInterfaceAliasNameRequest)(nil),    // 16: switchagent.v1.SetInterfaceAliasNameRequest
-	(*SetInterfaceAliasNameResponse)(nil),   // 17: switchagent.v1.SetInterfaceAliasNameResponse
-	(*SaveConfigRequest)(nil),               // 18: switchagent.v1.SaveConfigRequest
-	(*SaveConfigResponse)(nil),              // 19: switchagent.v1.SaveConfigResponse
+	(*Status)(nil),                             // 0: switchagent.v1.Status
+	(*GetDeviceInfoRequest)(nil),               // 1: switchagent.v1.GetDeviceInfoRequest
+	(*GetDeviceInfoResponse)(nil),              // 2: switchagent.v1.GetDeviceInfoResponse
+	(*PSU)(nil),                                // 3: switchagent.v1.PSU
+	(*Fan)(nil),                                // 4: switchagent.v1.Fan
+	(*TemperatureSensor)(nil),                  // 5: switchagent.v1.TemperatureSensor
+	(*GetPlatformHealthRequest)(nil),           // 6: switchagent.v1.GetPlatformHealthRequest
+	(*GetPlatformHealthResponse)(nil),          // 7: switchagent.v1.GetPlatformHealthResponse
+	(*Interface)(nil),                          // 8: switchagent.v1.Interface
+	(*ListInterfacesRequest)(nil),              // 9: switchagent.v1.ListInterfacesRequest
+	(*ListInterfacesResponse)(nil),             // 10: switchagent.v1.ListInterfacesResponse
+	(*SetInterfaceAdminStatusRequest)(nil),     // 11: switchagent.v1.SetInterfaceAdminStatusRequest
+	(*SetInterfaceAdminStatusResponse)(nil),    // 12: switchagent.v1.SetInterfaceAdminStatusResponse
+	(*SetInterfacePortAttributesRequest)(nil),  // 13: switchagent.v1.SetInterfacePortAttributesRequest
+	(*SetInterfacePortAttributesResponse)(nil), // 14: switchagent.v1.SetInterfacePortAttributesResponse
+	(*ListPortsRequest)(nil),                   // 15: switchagent.v1.ListPortsRequest
+	(*ListPortsResponse)(nil),                  // 16: switchagent.v1.ListPortsResponse
+	(*Port)(nil),                               // 17: switchagent.v1.Port
+	(*PortBreakout)(nil),                       // 18: switchagent.v1.PortBreakout
+	(*ListPortBreakoutsRequest)(nil),           // 19: switchagent.v1.ListPortBreakoutsRequest
+	(*ListPortBreakoutsResponse)(nil),          // 20: switchagent.v1.ListPortBreakoutsResponse
+	(*SetPortBreakoutRequest)(nil),             // 21: switchagent.v1.SetPortBreakoutRequest
+	(*SetPortBreakoutResponse)(nil),            // 22: switchagent.v1.SetPortBreakoutResponse
+	(*GetInterfaceNeighborRequest)(nil),        // 23: switchagent.v1.GetInterfaceNeighborRequest
+	(*InterfaceNeighbor)(nil),                  // 24: switchagent.v1.InterfaceNeighbor
+	(*GetInterfaceNeighborResponse)(nil),       // 25: switchagent.v1.GetInterfaceNeighborResponse
+	(*InterfaceCounters)(nil),                  // 26: switchagent.v1.InterfaceCounters
+	(*GetInterfaceCountersRequest)(nil),        // 27: switchagent.v1.GetInterfaceCountersRequest
+	(*GetInterfaceCountersResponse)(nil),       // 28: switchagent.v1.GetInterfaceCountersResponse
+	(*ListInterfaceCountersRequest)(nil),       // 29: switchagent.v1.ListInterfaceCountersRequest
+	(*ListInterfaceCountersResponse)(nil),      // 30: switchagent.v1.ListInterfaceCountersResponse
+	(*TransceiverLane)(nil),                    // 31: switchagent.v1.TransceiverLane
+	(*Transceiver)(nil),                        // 32: switchagent.v1.Transceiver
+	(*GetTransceiverRequest)(nil),              // 33: switchagent.v1.GetTransceiverRequest
+	(*GetTransceiverResponse)(nil),             // 34: switchagent.v1.GetTransceiverResponse
+	(*WatchInterfacesRequest)(nil),             // 35: switchagent.v1.WatchInterfacesRequest
+	(*InterfaceEvent)(nil),                     // 36: switchagent.v1.InterfaceEvent
+	(*GetInterfaceRequest)(nil),                // 37: switchagent.v1.GetInterfaceRequest
+	(*GetInterfaceResponse)(nil),               // 38: switchagent.v1.GetInterfaceResponse
+	(*SetInterfaceAliasNameRequest)(nil),       // 39: switchagent.v1.SetInterfaceAliasNameRequest
+	(*SetInterfaceAliasNameResponse)(nil),      // 40: switchagent.v1.SetInterfaceAliasNameResponse
+	(*SaveConfigRequest)(nil),                  // 41: switchagent.v1.SaveConfigRequest
+	(*SaveConfigResponse)(nil),                 // 42: switchagent.v1.SaveConfigResponse
+	(*VlanMember)(nil),                         // 43: switchagent.v1.VlanMember
+	(*Vlan)(nil),                               // 44: switchagent.v1.Vlan
+	(*CreateVlanRequest)(nil),                  // 45: switchagent.v1.CreateVlanRequest
+	(*CreateVlanResponse)(nil),                 // 46: switchagent.v1.CreateVlanResponse
+	(*DeleteVlanRequest)(nil),                  // 47: switchagent.v1.DeleteVlanRequest
+	(*DeleteVlanResponse)(nil),                 // 48: switchagent.v1.DeleteVlanResponse
+	(*ListVlansRequest)(nil),                   // 49: switchagent.v1.ListVlansRequest
+	(*ListVlansResponse)(nil),                  // 50: switchagent.v1.ListVlansResponse
+	(*AddVlanMemberRequest)(nil),               // 51: switchagent.v1.AddVlanMemberRequest
+	(*AddVlanMemberResponse)(nil),              // 52: switchagent.v1.AddVlanMemberResponse
+	(*RemoveVlanMemberRequest)(nil),            // 53: switchagent.v1.RemoveVlanMemberRequest
+	(*RemoveVlanMemberResponse)(nil),           // 54: switchagent.v1.RemoveVlanMemberResponse
+	(*PortChannelMember)(nil),                  // 55: switchagent.v1.PortChannelMember
+	(*PortChannel)(nil),                        // 56: switchagent.v1.PortChannel
+	(*CreatePortChannelRequest)(nil),           // 57: switchagent.v1.CreatePortChannelRequest
+	(*CreatePortChannelResponse)(nil),          // 58: switchagent.v1.CreatePortChannelResponse
+	(*DeletePortChannelRequest)(nil),           // 59: switchagent.v1.DeletePortChannelRequest
+	(*DeletePortChannelResponse)(nil),          // 60: switchagent.v1.DeletePortChannelResponse
+	(*GetPortChannelRequest)(nil),              // 61: switchagent.v1.GetPortChannelRequest
+	(*GetPortChannelResponse)(nil),             // 62: switchagent.v1.GetPortChannelResponse
+	(*ListPortChannelsRequest)(nil),            // 63: switchagent.v1.ListPortChannelsRequest
+	(*ListPortChannelsResponse)(nil),           // 64: switchagent.v1.ListPortChannelsResponse
+	(*AddPortChannelMemberRequest)(nil),        // 65: switchagent.v1.AddPortChannelMemberRequest
+	(*AddPortChannelMemberResponse)(nil),       // 66: switchagent.v1.AddPortChannelMemberResponse
+	(*RemovePortChannelMemberRequest)(nil),     // 67: switchagent.v1.RemovePortChannelMemberRequest
+	(*RemovePortChannelMemberResponse)(nil),    // 68: switchagent.v1.RemovePortChannelMemberResponse
+	(*InterfaceAddress)(nil),                   // 69: switchagent.v1.InterfaceAddress
+	(*ListInterfaceAddressesRequest)(nil),      // 70: switchagent.v1.ListInterfaceAddressesRequest
+	(*ListInterfaceAddressesResponse)(nil),     // 71: switchagent.v1.ListInterfaceAddressesResponse
+	(*AddInterfaceAddressRequest)(nil),         // 72: switchagent.v1.AddInterfaceAddressRequest
+	(*AddInterfaceAddressResponse)(nil),        // 73: switchagent.v1.AddInterfaceAddressResponse
+	(*RemoveInterfaceAddressRequest)(nil),      // 74: switchagent.v1.RemoveInterfaceAddressRequest
+	(*RemoveInterfaceAddressResponse)(nil),     // 75: switchagent.v1.RemoveInterfaceAddressResponse
+	(*BGPNeighbor)(nil),                        // 76: switchagent.v1.BGPNeighbor
+	(*ListBGPNeighborsRequest)(nil),            // 77: switchagent.v1.ListBGPNeighborsRequest
+	(*ListBGPNeighborsResponse)(nil),           // 78: switchagent.v1.ListBGPNeighborsResponse
+	(*AddBGPNeighborRequest)(nil),              // 79: switchagent.v1.AddBGPNeighborRequest
+	(*AddBGPNeighborResponse)(nil),             // 80: switchagent.v1.AddBGPNeighborResponse
+	(*RemoveBGPNeighborRequest)(nil),           // 81: switchagent.v1.RemoveBGPNeighborRequest
+	(*RemoveBGPNeighborResponse)(nil),          // 82: switchagent.v1.RemoveBGPNeighborResponse
 }
 var file_internal_agent_proto_switch_agent_proto_depIdxs = []int32{
 	0,  // 0: switchagent.v1.GetDeviceInfoResponse.status:type_name -> switchagent.v1.Status
-	0,  // 1: switchagent.v1.ListInterfacesResponse.status:type_name -> switchagent.v1.Status
-	3,  // 2: switchagent.v1.ListInterfacesResponse.interfaces:type_name -> switchagent.v1.Interface
-	0,  // 3: switchagent.v1.SetInterfaceAdminStatusResponse.status:type_name -> switchagent.v1.Status
-	3,  // 4: switchagent.v1.SetInterfaceAdminStatusResponse.interface:type_name -> switchagent.v1.Interface
-	0,  // 5: switchagent.v1.ListPortsResponse.status:type_name -> switchagent.v1.Status
-	10, // 6: switchagent.v1.ListPortsResponse.ports:type_name -> switchagent.v1.Port
-	0,  // 7: switchagent.v1.GetInterfaceNeighborResponse.status:type_name -> switchagent.v1.Status
-	12, // 8: switchagent.v1.GetInterfaceNeighborResponse.neighbor:type_name -> switchagent.v1.InterfaceNeighbor
-	0,  // 9: switchagent.v1.GetInterfaceResponse.status:type_name -> switchagent.v1.Status
-	3,  // 10: switchagent.v1.GetInterfaceResponse.interface:type_name -> switchagent.v1.Interface
-	0,  // 11: switchagent.v1.SetInterfaceAliasNameResponse.status:type_name -> switchagent.v1.Status
-	3,  // 12: switchagent.v1.SetInterfaceAliasNameResponse.interface:type_name -> switchagent.v1.Interface
-	0,  // 13: switchagent.v1.SaveConfigResponse.status:type_name -> switchagent.v1.Status
-	1,  // 14: switchagent.v1.SwitchAgentService.GetDeviceInfo:input_type -> switchagent.v1.GetDeviceInfoRequest
-	4,  // 15: switchagent.v1.SwitchAgentService.ListInterfaces:input_type -> switchagent.v1.ListInterfacesRequest
-	6,  // 16: switchagent.v1.SwitchAgentService.SetInterfaceAdminStatus:input_type -> switchagent.v1.SetInterfaceAdminStatusRequest
-	16, // 17: switchagent.v1.SwitchAgentService.SetInterfaceAliasName:input_type -> switchagent.v1.SetInterfaceAliasNameRequest
-	14, // 18: switchagent.v1.SwitchAgentService.GetInterface:input_type -> switchagent.v1.GetInterfaceRequest
-	11, // 19: switchagent.v1.SwitchAgentService.GetInterfaceNeighbor:input_type -> switchagent.v1.GetInterfaceNeighborRequest
-	8,  // 20: switchagent.v1.SwitchAgentService.ListPorts:input_type -> switchagent.v1.ListPortsRequest
-	18, // 21: switchagent.v1.SwitchAgentService.SaveConfig:input_type -> switchagent.v1.SaveConfigRequest
-	2,  // 22: switchagent.v1.SwitchAgentService.GetDeviceInfo:output_type -> switchagent.v1.GetDeviceInfoResponse
-	5,  // 23: switchagent.v1.SwitchAgentService.ListInterfaces:output_type -> switchagent.v1.ListInterfacesResponse
-	7,  // 24: switchagent.v1.SwitchAgentService.SetInterfaceAdminStatus:output_type -> switchagent.v1.SetInterfaceAdminStatusResponse
-	17, // 25: switchagent.v1.SwitchAgentService.SetInterfaceAliasName:output_type -> switchagent.v1.SetInterfaceAliasNameResponse
-	15, // 26: switchagent.v1.SwitchAgentService.GetInterface:output_type -> switchagent.v1.GetInterfaceResponse
-	13, // 27: switchagent.v1.SwitchAgentService.GetInterfaceNeighbor:output_type -> switchagent.v1.GetInterfaceNeighborResponse
-	9,  // 28: switchagent.v1.SwitchAgentService.ListPorts:output_type -> switchagent.v1.ListPortsResponse
-	19, // 29: switchagent.v1.SwitchAgentService.SaveConfig:output_type -> switchagent.v1.SaveConfigResponse
-	22, // [22:30] is the sub-list for method output_type
-	14, // [14:22] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	0,  // 1: switchagent.v1.GetPlatformHealthResponse.status:type_name -> switchagent.v1.Status
+	3,  // 2: switchagent.v1.GetPlatformHealthResponse.psus:type_name -> switchagent.v1.PSU
+	4,  // 3: switchagent.v1.GetPlatformHealthResponse.fans:type_name -> switchagent.v1.Fan
+	5,  // 4: switchagent.v1.GetPlatformHealthResponse.temperatures:type_name -> switchagent.v1.TemperatureSensor
+	0,  // 5: switchagent.v1.ListInterfacesResponse.status:type_name -> switchagent.v1.Status
+	8,  // 6: switchagent.v1.ListInterfacesResponse.interfaces:type_name -> switchagent.v1.Interface
+	0,  // 7: switchagent.v1.SetInterfaceAdminStatusResponse.status:type_name -> switchagent.v1.Status
+	8,  // 8: switchagent.v1.SetInterfaceAdminStatusResponse.interface:type_name -> switchagent.v1.Interface
+	0,  // 9: switchagent.v1.SetInterfacePortAttributesResponse.status:type_name -> switchagent.v1.Status
+	8,  // 10: switchagent.v1.SetInterfacePortAttributesResponse.interface:type_name -> switchagent.v1.Interface
+	0,  // 11: switchagent.v1.ListPortsResponse.status:type_name -> switchagent.v1.Status
+	17, // 12: switchagent.v1.ListPortsResponse.ports:type_name -> switchagent.v1.Port
+	0,  // 13: switchagent.v1.ListPortBreakoutsResponse.status:type_name -> switchagent.v1.Status
+	18, // 14: switchagent.v1.ListPortBreakoutsResponse.breakouts:type_name -> switchagent.v1.PortBreakout
+	0,  // 15: switchagent.v1.SetPortBreakoutResponse.status:type_name -> switchagent.v1.Status
+	18, // 16: switchagent.v1.SetPortBreakoutResponse.breakout:type_name -> switchagent.v1.PortBreakout
+	0,  // 17: switchagent.v1.GetInterfaceNeighborResponse.status:type_name -> switchagent.v1.Status
+	24, // 18: switchagent.v1.GetInterfaceNeighborResponse.neighbor:type_name -> switchagent.v1.InterfaceNeighbor
+	0,  // 19: switchagent.v1.GetInterfaceCountersResponse.status:type_name -> switchagent.v1.Status
+	26, // 20: switchagent.v1.GetInterfaceCountersResponse.counters:type_name -> switchagent.v1.InterfaceCounters
+	0,  // 21: switchagent.v1.ListInterfaceCountersResponse.status:type_name -> switchagent.v1.Status
+	26, // 22: switchagent.v1.ListInterfaceCountersResponse.counters:type_name -> switchagent.v1.InterfaceCounters
+	31, // 23: switchagent.v1.Transceiver.lanes:type_name -> switchagent.v1.TransceiverLane
+	0,  // 24: switchagent.v1.GetTransceiverResponse.status:type_name -> switchagent.v1.Status
+	32, // 25: switchagent.v1.GetTransceiverResponse.transceiver:type_name -> switchagent.v1.Transceiver
+	0,  // 26: switchagent.v1.GetInterfaceResponse.status:type_name -> switchagent.v1.Status
+	8,  // 27: switchagent.v1.GetInterfaceResponse.interface:type_name -> switchagent.v1.Interface
+	0,  // 28: switchagent.v1.SetInterfaceAliasNameResponse.status:type_name -> switchagent.v1.Status
+	8,  // 29: switchagent.v1.SetInterfaceAliasNameResponse.interface:type_name -> switchagent.v1.Interface
+	0,  // 30: switchagent.v1.SaveConfigResponse.status:type_name -> switchagent.v1.Status
+	43, // 31: switchagent.v1.Vlan.members:type_name -> switchagent.v1.VlanMember
+	0,  // 32: switchagent.v1.CreateVlanResponse.status:type_name -> switchagent.v1.Status
+	44, // 33: switchagent.v1.CreateVlanResponse.vlan:type_name -> switchagent.v1.Vlan
+	0,  // 34: switchagent.v1.DeleteVlanResponse.status:type_name -> switchagent.v1.Status
+	0,  // 35: switchagent.v1.ListVlansResponse.status:type_name -> switchagent.v1.Status
+	44, // 36: switchagent.v1.ListVlansResponse.vlans:type_name -> switchagent.v1.Vlan
+	0,  // 37: switchagent.v1.AddVlanMemberResponse.status:type_name -> switchagent.v1.Status
+	43, // 38: switchagent.v1.AddVlanMemberResponse.member:type_name -> switchagent.v1.VlanMember
+	0,  // 39: switchagent.v1.RemoveVlanMemberResponse.status:type_name -> switchagent.v1.Status
+	55, // 40: switchagent.v1.PortChannel.members:type_name -> switchagent.v1.PortChannelMember
+	0,  // 41: switchagent.v1.CreatePortChannelResponse.status:type_name -> switchagent.v1.Status
+	56, // 42: switchagent.v1.CreatePortChannelResponse.port_channel:type_name -> switchagent.v1.PortChannel
+	0,  // 43: switchagent.v1.DeletePortChannelResponse.status:type_name -> switchagent.v1.Status
+	0,  // 44: switchagent.v1.GetPortChannelResponse.status:type_name -> switchagent.v1.Status
+	56, // 45: switchagent.v1.GetPortChannelResponse.port_channel:type_name -> switchagent.v1.PortChannel
+	0,  // 46: switchagent.v1.ListPortChannelsResponse.status:type_name -> switchagent.v1.Status
+	56, // 47: switchagent.v1.ListPortChannelsResponse.port_channels:type_name -> switchagent.v1.PortChannel
+	0,  // 48: switchagent.v1.AddPortChannelMemberResponse.status:type_name -> switchagent.v1.Status
+	55, // 49: switchagent.v1.AddPortChannelMemberResponse.member:type_name -> switchagent.v1.PortChannelMember
+	0,  // 50: switchagent.v1.RemovePortChannelMemberResponse.status:type_name -> switchagent.v1.Status
+	0,  // 51: switchagent.v1.ListInterfaceAddressesResponse.status:type_name -> switchagent.v1.Status
+	69, // 52: switchagent.v1.ListInterfaceAddressesResponse.addresses:type_name -> switchagent.v1.InterfaceAddress
+	0,  // 53: switchagent.v1.AddInterfaceAddressResponse.status:type_name -> switchagent.v1.Status
+	69, // 54: switchagent.v1.AddInterfaceAddressResponse.address:type_name -> switchagent.v1.InterfaceAddress
+	0,  // 55: switchagent.v1.RemoveInterfaceAddressResponse.status:type_name -> switchagent.v1.Status
+	0,  // 56: switchagent.v1.ListBGPNeighborsResponse.status:type_name -> switchagent.v1.Status
+	76, // 57: switchagent.v1.ListBGPNeighborsResponse.neighbors:type_name -> switchagent.v1.BGPNeighbor
+	0,  // 58: switchagent.v1.AddBGPNeighborResponse.status:type_name -> switchagent.v1.Status
+	76, // 59: switchagent.v1.AddBGPNeighborResponse.neighbor:type_name -> switchagent.v1.BGPNeighbor
+	0,  // 60: switchagent.v1.RemoveBGPNeighborResponse.status:type_name -> switchagent.v1.Status
+	1,  // 61: switchagent.v1.SwitchAgentService.GetDeviceInfo:input_type -> switchagent.v1.GetDeviceInfoRequest
+	6,  // 62: switchagent.v1.SwitchAgentService.GetPlatformHealth:input_type -> switchagent.v1.GetPlatformHealthRequest
+	9,  // 63: switchagent.v1.SwitchAgentService.ListInterfaces:input_type -> switchagent.v1.ListInterfacesRequest
+	11, // 64: switchagent.v1.SwitchAgentService.SetInterfaceAdminStatus:input_type -> switchagent.v1.SetInterfaceAdminStatusRequest
+	39, // 65: switchagent.v1.SwitchAgentService.SetInterfaceAliasName:input_type -> switchagent.v1.SetInterfaceAliasNameRequest
+	13, // 66: switchagent.v1.SwitchAgentService.SetInterfacePortAttributes:input_type -> switchagent.v1.SetInterfacePortAttributesRequest
+	37, // 67: switchagent.v1.SwitchAgentService.GetInterface:input_type -> switchagent.v1.GetInterfaceRequest
+	23, // 68: switchagent.v1.SwitchAgentService.GetInterfaceNeighbor:input_type -> switchagent.v1.GetInterfaceNeighborRequest
+	27, // 69: switchagent.v1.SwitchAgentService.GetInterfaceCounters:input_type -> switchagent.v1.GetInterfaceCountersRequest
+	29, // 70: switchagent.v1.SwitchAgentService.ListInterfaceCounters:input_type -> switchagent.v1.ListInterfaceCountersRequest
+	33, // 71: switchagent.v1.SwitchAgentService.GetTransceiver:input_type -> switchagent.v1.GetTransceiverRequest
+	35, // 72: switchagent.v1.SwitchAgentService.WatchInterfaces:input_type -> switchagent.v1.WatchInterfacesRequest
+	15, // 73: switchagent.v1.SwitchAgentService.ListPorts:input_type -> switchagent.v1.ListPortsRequest
+	19, // 74: switchagent.v1.SwitchAgentService.ListPortBreakouts:input_type -> switchagent.v1.ListPortBreakoutsRequest
+	21, // 75: switchagent.v1.SwitchAgentService.SetPortBreakout:input_type -> switchagent.v1.SetPortBreakoutRequest
+	45, // 76: switchagent.v1.SwitchAgentService.CreateVlan:input_type -> switchagent.v1.CreateVlanRequest
+	47, // 77: switchagent.v1.SwitchAgentService.DeleteVlan:input_type -> switchagent.v1.DeleteVlanRequest
+	49, // 78: switchagent.v1.SwitchAgentService.ListVlans:input_type -> switchagent.v1.ListVlansRequest
+	51, // 79: switchagent.v1.SwitchAgentService.AddVlanMember:input_type -> switchagent.v1.AddVlanMemberRequest
+	53, // 80: switchagent.v1.SwitchAgentService.RemoveVlanMember:input_type -> switchagent.v1.RemoveVlanMemberRequest
+	57, // 81: switchagent.v1.SwitchAgentService.CreatePortChannel:input_type -> switchagent.v1.CreatePortChannelRequest
+	59, // 82: switchagent.v1.SwitchAgentService.DeletePortChannel:input_type -> switchagent.v1.DeletePortChannelRequest
+	61, // 83: switchagent.v1.SwitchAgentService.GetPortChannel:input_type -> switchagent.v1.GetPortChannelRequest
+	63, // 84: switchagent.v1.SwitchAgentService.ListPortChannels:input_type -> switchagent.v1.ListPortChannelsRequest
+	65, // 85: switchagent.v1.SwitchAgentService.AddPortChannelMember:input_type -> switchagent.v1.AddPortChannelMemberRequest
+	67, // 86: switchagent.v1.SwitchAgentService.RemovePortChannelMember:input_type -> switchagent.v1.RemovePortChannelMemberRequest
+	70, // 87: switchagent.v1.SwitchAgentService.ListInterfaceAddresses:input_type -> switchagent.v1.ListInterfaceAddressesRequest
+	72, // 88: switchagent.v1.SwitchAgentService.AddInterfaceAddress:input_type -> switchagent.v1.AddInterfaceAddressRequest
+	74, // 89: switchagent.v1.SwitchAgentService.RemoveInterfaceAddress:input_type -> switchagent.v1.RemoveInterfaceAddressRequest
+	77, // 90: switchagent.v1.SwitchAgentService.ListBGPNeighbors:input_type -> switchagent.v1.ListBGPNeighborsRequest
+	79, // 91: switchagent.v1.SwitchAgentService.AddBGPNeighbor:input_type -> switchagent.v1.AddBGPNeighborRequest
+	81, // 92: switchagent.v1.SwitchAgentService.RemoveBGPNeighbor:input_type -> switchagent.v1.RemoveBGPNeighborRequest
+	41, // 93: switchagent.v1.SwitchAgentService.SaveConfig:input_type -> switchagent.v1.SaveConfigRequest
+	2,  // 94: switchagent.v1.SwitchAgentService.GetDeviceInfo:output_type -> switchagent.v1.GetDeviceInfoResponse
+	7,  // 95: switchagent.v1.SwitchAgentService.GetPlatformHealth:output_type -> switchagent.v1.GetPlatformHealthResponse
+	10, // 96: switchagent.v1.SwitchAgentService.ListInterfaces:output_type -> switchagent.v1.ListInterfacesResponse
+	12, // 97: switchagent.v1.SwitchAgentService.SetInterfaceAdminStatus:output_type -> switchagent.v1.SetInterfaceAdminStatusResponse
+	40, // 98: switchagent.v1.SwitchAgentService.SetInterfaceAliasName:output_type -> switchagent.v1.SetInterfaceAliasNameResponse
+	14, // 99: switchagent.v1.SwitchAgentService.SetInterfacePortAttributes:output_type -> switchagent.v1.SetInterfacePortAttributesResponse
+	38, // 100: switchagent.v1.SwitchAgentService.GetInterface:output_type -> switchagent.v1.GetInterfaceResponse
+	25, // 101: switchagent.v1.SwitchAgentService.GetInterfaceNeighbor:output_type -> switchagent.v1.GetInterfaceNeighborResponse
+	28, // 102: switchagent.v1.SwitchAgentService.GetInterfaceCounters:output_type -> switchagent.v1.GetInterfaceCountersResponse
+	30, // 103: switchagent.v1.SwitchAgentService.ListInterfaceCounters:output_type -> switchagent.v1.ListInterfaceCountersResponse
+	34, // 104: switchagent.v1.SwitchAgentService.GetTransceiver:output_type -> switchagent.v1.GetTransceiverResponse
+	36, // 105: switchagent.v1.SwitchAgentService.WatchInterfaces:output_type -> switchagent.v1.InterfaceEvent
+	16, // 106: switchagent.v1.SwitchAgentService.ListPorts:output_type -> switchagent.v1.ListPortsResponse
+	20, // 107: switchagent.v1.SwitchAgentService.ListPortBreakouts:output_type -> switchagent.v1.ListPortBreakoutsResponse
+	22, // 108: switchagent.v1.SwitchAgentService.SetPortBreakout:output_type -> switchagent.v1.SetPortBreakoutResponse
+	46, // 109: switchagent.v1.SwitchAgentService.CreateVlan:output_type -> switchagent.v1.CreateVlanResponse
+	48, // 110: switchagent.v1.SwitchAgentService.DeleteVlan:output_type -> switchagent.v1.DeleteVlanResponse
+	50, // 111: switchagent.v1.SwitchAgentService.ListVlans:output_type -> switchagent.v1.ListVlansResponse
+	52, // 112: switchagent.v1.SwitchAgentService.AddVlanMember:output_type -> switchagent.v1.AddVlanMemberResponse
+	54, // 113: switchagent.v1.SwitchAgentService.RemoveVlanMember:output_type -> switchagent.v1.RemoveVlanMemberResponse
+	58, // 114: switchagent.v1.SwitchAgentService.CreatePortChannel:output_type -> switchagent.v1.CreatePortChannelResponse
+	60, // 115: switchagent.v1.SwitchAgentService.DeletePortChannel:output_type -> switchagent.v1.DeletePortChannelResponse
+	62, // 116: switchagent.v1.SwitchAgentService.GetPortChannel:output_type -> switchagent.v1.GetPortChannelResponse
+	64, // 117: switchagent.v1.SwitchAgentService.ListPortChannels:output_type -> switchagent.v1.ListPortChannelsResponse
+	66, // 118: switchagent.v1.SwitchAgentService.AddPortChannelMember:output_type -> switchagent.v1.AddPortChannelMemberResponse
+	68, // 119: switchagent.v1.SwitchAgentService.RemovePortChannelMember:output_type -> switchagent.v1.RemovePortChannelMemberResponse
+	71, // 120: switchagent.v1.SwitchAgentService.ListInterfaceAddresses:output_type -> switchagent.v1.ListInterfaceAddressesResponse
+	73, // 121: switchagent.v1.SwitchAgentService.AddInterfaceAddress:output_type -> switchagent.v1.AddInterfaceAddressResponse
+	75, // 122: switchagent.v1.SwitchAgentService.RemoveInterfaceAddress:output_type -> switchagent.v1.RemoveInterfaceAddressResponse
+	78, // 123: switchagent.v1.SwitchAgentService.ListBGPNeighbors:output_type -> switchagent.v1.ListBGPNeighborsResponse
+	80, // 124: switchagent.v1.SwitchAgentService.AddBGPNeighbor:output_type -> switchagent.v1.AddBGPNeighborResponse
+	82, // 125: switchagent.v1.SwitchAgentService.RemoveBGPNeighbor:output_type -> switchagent.v1.RemoveBGPNeighborResponse
+	42, // 126: switchagent.v1.SwitchAgentService.SaveConfig:output_type -> switchagent.v1.SaveConfigResponse
+	94, // [94:127] is the sub-list for method output_type
+	61, // [61:94] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_internal_agent_proto_switch_agent_proto_init() }
@@ -1224,7 +5113,7 @@ func file_internal_agent_proto_switch_agent_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_agent_proto_switch_agent_proto_rawDesc), len(file_internal_agent_proto_switch_agent_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,14 +20,39 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SwitchAgentService_GetDeviceInfo_FullMethodName           = "/switchagent.v1.SwitchAgentService/GetDeviceInfo"
-	SwitchAgentService_ListInterfaces_FullMethodName          = "/switchagent.v1.SwitchAgentService/ListInterfaces"
-	SwitchAgentService_SetInterfaceAdminStatus_FullMethodName = "/switchagent.v1.SwitchAgentService/SetInterfaceAdminStatus"
-	SwitchAgentService_SetInterfaceAliasName_FullMethodName   = "/switchagent.v1.SwitchAgentService/SetInterfaceAliasName"
-	SwitchAgentService_GetInterface_FullMethodName            = "/switchagent.v1.SwitchAgentService/GetInterface"
-	SwitchAgentService_GetInterfaceNeighbor_FullMethodName    = "/switchagent.v1.SwitchAgentService/GetInterfaceNeighbor"
-	SwitchAgentService_ListPorts_FullMethodName               = "/switchagent.v1.SwitchAgentService/ListPorts"
-	SwitchAgentService_SaveConfig_FullMethodName              = "/switchagent.v1.SwitchAgentService/SaveConfig"
+	SwitchAgentService_GetDeviceInfo_FullMethodName              = "/switchagent.v1.SwitchAgentService/GetDeviceInfo"
+	SwitchAgentService_GetPlatformHealth_FullMethodName          = "/switchagent.v1.SwitchAgentService/GetPlatformHealth"
+	SwitchAgentService_ListInterfaces_FullMethodName             = "/switchagent.v1.SwitchAgentService/ListInterfaces"
+	SwitchAgentService_SetInterfaceAdminStatus_FullMethodName    = "/switchagent.v1.SwitchAgentService/SetInterfaceAdminStatus"
+	SwitchAgentService_SetInterfaceAliasName_FullMethodName      = "/switchagent.v1.SwitchAgentService/SetInterfaceAliasName"
+	SwitchAgentService_SetInterfacePortAttributes_FullMethodName = "/switchagent.v1.SwitchAgentService/SetInterfacePortAttributes"
+	SwitchAgentService_GetInterface_FullMethodName               = "/switchagent.v1.SwitchAgentService/GetInterface"
+	SwitchAgentService_GetInterfaceNeighbor_FullMethodName       = "/switchagent.v1.SwitchAgentService/GetInterfaceNeighbor"
+	SwitchAgentService_GetInterfaceCounters_FullMethodName       = "/switchagent.v1.SwitchAgentService/GetInterfaceCounters"
+	SwitchAgentService_ListInterfaceCounters_FullMethodName      = "/switchagent.v1.SwitchAgentService/ListInterfaceCounters"
+	SwitchAgentService_GetTransceiver_FullMethodName             = "/switchagent.v1.SwitchAgentService/GetTransceiver"
+	SwitchAgentService_WatchInterfaces_FullMethodName            = "/switchagent.v1.SwitchAgentService/WatchInterfaces"
+	SwitchAgentService_ListPorts_FullMethodName                  = "/switchagent.v1.SwitchAgentService/ListPorts"
+	SwitchAgentService_ListPortBreakouts_FullMethodName          = "/switchagent.v1.SwitchAgentService/ListPortBreakouts"
+	SwitchAgentService_SetPortBreakout_FullMethodName            = "/switchagent.v1.SwitchAgentService/SetPortBreakout"
+	SwitchAgentService_CreateVlan_FullMethodName                 = "/switchagent.v1.SwitchAgentService/CreateVlan"
+	SwitchAgentService_DeleteVlan_FullMethodName                 = "/switchagent.v1.SwitchAgentService/DeleteVlan"
+	SwitchAgentService_ListVlans_FullMethodName                  = "/switchagent.v1.SwitchAgentService/ListVlans"
+	SwitchAgentService_AddVlanMember_FullMethodName              = "/switchagent.v1.SwitchAgentService/AddVlanMember"
+	SwitchAgentService_RemoveVlanMember_FullMethodName           = "/switchagent.v1.SwitchAgentService/RemoveVlanMember"
+	SwitchAgentService_CreatePortChannel_FullMethodName          = "/switchagent.v1.SwitchAgentService/CreatePortChannel"
+	SwitchAgentService_DeletePortChannel_FullMethodName          = "/switchagent.v1.SwitchAgentService/DeletePortChannel"
+	SwitchAgentService_GetPortChannel_FullMethodName             = "/switchagent.v1.SwitchAgentService/GetPortChannel"
+	SwitchAgentService_ListPortChannels_FullMethodName           = "/switchagent.v1.SwitchAgentService/ListPortChannels"
+	SwitchAgentService_AddPortChannelMember_FullMethodName       = "/switchagent.v1.SwitchAgentService/AddPortChannelMember"
+	SwitchAgentService_RemovePortChannelMember_FullMethodName    = "/switchagent.v1.SwitchAgentService/RemovePortChannelMember"
+	SwitchAgentService_ListInterfaceAddresses_FullMethodName     = "/switchagent.v1.SwitchAgentService/ListInterfaceAddresses"
+	SwitchAgentService_AddInterfaceAddress_FullMethodName        = "/switchagent.v1.SwitchAgentService/AddInterfaceAddress"
+	SwitchAgentService_RemoveInterfaceAddress_FullMethodName     = "/switchagent.v1.SwitchAgentService/RemoveInterfaceAddress"
+	SwitchAgentService_ListBGPNeighbors_FullMethodName           = "/switchagent.v1.SwitchAgentService/ListBGPNeighbors"
+	SwitchAgentService_AddBGPNeighbor_FullMethodName             = "/switchagent.v1.SwitchAgentService/AddBGPNeighbor"
+	SwitchAgentService_RemoveBGPNeighbor_FullMethodName          = "/switchagent.v1.SwitchAgentService/RemoveBGPNeighbor"
+	SwitchAgentService_SaveConfig_FullMethodName                 = "/switchagent.v1.SwitchAgentService/SaveConfig"
 )
 
 // SwitchAgentServiceClient is the client API for SwitchAgentService service.
@@ -37,12 +62,37 @@ const (
 // The interface service definition.
 type SwitchAgentServiceClient interface {
 	GetDeviceInfo(ctx context.Context, in *GetDeviceInfoRequest, opts ...grpc.CallOption) (*GetDeviceInfoResponse, error)
+	GetPlatformHealth(ctx context.Context, in *GetPlatformHealthRequest, opts ...grpc.CallOption) (*GetPlatformHealthResponse, error)
 	ListInterfaces(ctx context.Context, in *ListInterfacesRequest, opts ...grpc.CallOption) (*ListInterfacesResponse, error)
 	SetInterfaceAdminStatus(ctx context.Context, in *SetInterfaceAdminStatusRequest, opts ...grpc.CallOption) (*SetInterfaceAdminStatusResponse, error)
 	SetInterfaceAliasName(ctx context.Context, in *SetInterfaceAliasNameRequest, opts ...grpc.CallOption) (*SetInterfaceAliasNameResponse, error)
+	SetInterfacePortAttributes(ctx context.Context, in *SetInterfacePortAttributesRequest, opts ...grpc.CallOption) (*SetInterfacePortAttributesResponse, error)
 	GetInterface(ctx context.Context, in *GetInterfaceRequest, opts ...grpc.CallOption) (*GetInterfaceResponse, error)
 	GetInterfaceNeighbor(ctx context.Context, in *GetInterfaceNeighborRequest, opts ...grpc.CallOption) (*GetInterfaceNeighborResponse, error)
+	GetInterfaceCounters(ctx context.Context, in *GetInterfaceCountersRequest, opts ...grpc.CallOption) (*GetInterfaceCountersResponse, error)
+	ListInterfaceCounters(ctx context.Context, in *ListInterfaceCountersRequest, opts ...grpc.CallOption) (*ListInterfaceCountersResponse, error)
+	GetTransceiver(ctx context.Context, in *GetTransceiverRequest, opts ...grpc.CallOption) (*GetTransceiverResponse, error)
+	WatchInterfaces(ctx context.Context, in *WatchInterfacesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InterfaceEvent], error)
 	ListPorts(ctx context.Context, in *ListPortsRequest, opts ...grpc.CallOption) (*ListPortsResponse, error)
+	ListPortBreakouts(ctx context.Context, in *ListPortBreakoutsRequest, opts ...grpc.CallOption) (*ListPortBreakoutsResponse, error)
+	SetPortBreakout(ctx context.Context, in *SetPortBreakoutRequest, opts ...grpc.CallOption) (*SetPortBreakoutResponse, error)
+	CreateVlan(ctx context.Context, in *CreateVlanRequest, opts ...grpc.CallOption) (*CreateVlanResponse, error)
+	DeleteVlan(ctx context.Context, in *DeleteVlanRequest, opts ...grpc.CallOption) (*DeleteVlanResponse, error)
+	ListVlans(ctx context.Context, in *ListVlansRequest, opts ...grpc.CallOption) (*ListVlansResponse, error)
+	AddVlanMember(ctx context.Context, in *AddVlanMemberRequest, opts ...grpc.CallOption) (*AddVlanMemberResponse, error)
+	RemoveVlanMember(ctx context.Context, in *RemoveVlanMemberRequest, opts ...grpc.CallOption) (*RemoveVlanMemberResponse, error)
+	CreatePortChannel(ctx context.Context, in *CreatePortChannelRequest, opts ...grpc.CallOption) (*CreatePortChannelResponse, error)
+	DeletePortChannel(ctx context.Context, in *DeletePortChannelRequest, opts ...grpc.CallOption) (*DeletePortChannelResponse, error)
+	GetPortChannel(ctx context.Context, in *GetPortChannelRequest, opts ...grpc.CallOption) (*GetPortChannelResponse, error)
+	ListPortChannels(ctx context.Context, in *ListPortChannelsRequest, opts ...grpc.CallOption) (*ListPortChannelsResponse, error)
+	AddPortChannelMember(ctx context.Context, in *AddPortChannelMemberRequest, opts ...grpc.CallOption) (*AddPortChannelMemberResponse, error)
+	RemovePortChannelMember(ctx context.Context, in *RemovePortChannelMemberRequest, opts ...grpc.CallOption) (*RemovePortChannelMemberResponse, error)
+	ListInterfaceAddresses(ctx context.Context, in *ListInterfaceAddressesRequest, opts ...grpc.CallOption) (*ListInterfaceAddressesResponse, error)
+	AddInterfaceAddress(ctx context.Context, in *AddInterfaceAddressRequest, opts ...grpc.CallOption) (*AddInterfaceAddressResponse, error)
+	RemoveInterfaceAddress(ctx context.Context, in *RemoveInterfaceAddressRequest, opts ...grpc.CallOption) (*RemoveInterfaceAddressResponse, error)
+	ListBGPNeighbors(ctx context.Context, in *ListBGPNeighborsRequest, opts ...grpc.CallOption) (*ListBGPNeighborsResponse, error)
+	AddBGPNeighbor(ctx context.Context, in *AddBGPNeighborRequest, opts ...grpc.CallOption) (*AddBGPNeighborResponse, error)
+	RemoveBGPNeighbor(ctx context.Context, in *RemoveBGPNeighborRequest, opts ...grpc.CallOption) (*RemoveBGPNeighborResponse, error)
 	// gNOI alternatives
 	SaveConfig(ctx context.Context, in *SaveConfigRequest, opts ...grpc.CallOption) (*SaveConfigResponse, error)
 }
@@ -65,6 +115,16 @@ func (c *switchAgentServiceClient) GetDeviceInfo(ctx context.Context, in *GetDev
 	return out, nil
 }
 
+func (c *switchAgentServiceClient) GetPlatformHealth(ctx context.Context, in *GetPlatformHealthRequest, opts ...grpc.CallOption) (*GetPlatformHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlatformHealthResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_GetPlatformHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *switchAgentServiceClient) ListInterfaces(ctx context.Context, in *ListInterfacesRequest, opts ...grpc.CallOption) (*ListInterfacesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListInterfacesResponse)
@@ -95,6 +155,16 @@ func (c *switchAgentServiceClient) SetInterfaceAliasName(ctx context.Context, in
 	return out, nil
 }
 
+func (c *switchAgentServiceClient) SetInterfacePortAttributes(ctx context.Context, in *SetInterfacePortAttributesRequest, opts ...grpc.CallOption) (*SetInterfacePortAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetInterfacePortAttributesResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_SetInterfacePortAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *switchAgentServiceClient) GetInterface(ctx context.Context, in *GetInterfaceRequest, opts ...grpc.CallOption) (*GetInterfaceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetInterfaceResponse)
@@ -115,6 +185,55 @@ func (c *switchAgentServiceClient) GetInterfaceNeighbor(ctx context.Context, in
 	return out, nil
 }
 
+func (c *switchAgentServiceClient) GetInterfaceCounters(ctx context.Context, in *GetInterfaceCountersRequest, opts ...grpc.CallOption) (*GetInterfaceCountersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInterfaceCountersResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_GetInterfaceCounters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) ListInterfaceCounters(ctx context.Context, in *ListInterfaceCountersRequest, opts ...grpc.CallOption) (*ListInterfaceCountersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInterfaceCountersResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_ListInterfaceCounters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) GetTransceiver(ctx context.Context, in *GetTransceiverRequest, opts ...grpc.CallOption) (*GetTransceiverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransceiverResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_GetTransceiver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) WatchInterfaces(ctx context.Context, in *WatchInterfacesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InterfaceEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SwitchAgentService_ServiceDesc.Streams[0], SwitchAgentService_WatchInterfaces_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchInterfacesRequest, InterfaceEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SwitchAgentService_WatchInterfacesClient = grpc.ServerStreamingClient[InterfaceEvent]
+
 func (c *switchAgentServiceClient) ListPorts(ctx context.Context, in *ListPortsRequest, opts ...grpc.CallOption) (*ListPortsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPortsResponse)
@@ -125,6 +244,196 @@ func (c *switchAgentServiceClient) ListPorts(ctx context.Context, in *ListPortsR
 	return out, nil
 }
 
+func (c *switchAgentServiceClient) ListPortBreakouts(ctx context.Context, in *ListPortBreakoutsRequest, opts ...grpc.CallOption) (*ListPortBreakoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPortBreakoutsResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_ListPortBreakouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) SetPortBreakout(ctx context.Context, in *SetPortBreakoutRequest, opts ...grpc.CallOption) (*SetPortBreakoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPortBreakoutResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_SetPortBreakout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) CreateVlan(ctx context.Context, in *CreateVlanRequest, opts ...grpc.CallOption) (*CreateVlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateVlanResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_CreateVlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) DeleteVlan(ctx context.Context, in *DeleteVlanRequest, opts ...grpc.CallOption) (*DeleteVlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVlanResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_DeleteVlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) ListVlans(ctx context.Context, in *ListVlansRequest, opts ...grpc.CallOption) (*ListVlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVlansResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_ListVlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) AddVlanMember(ctx context.Context, in *AddVlanMemberRequest, opts ...grpc.CallOption) (*AddVlanMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddVlanMemberResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_AddVlanMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) RemoveVlanMember(ctx context.Context, in *RemoveVlanMemberRequest, opts ...grpc.CallOption) (*RemoveVlanMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveVlanMemberResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_RemoveVlanMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) CreatePortChannel(ctx context.Context, in *CreatePortChannelRequest, opts ...grpc.CallOption) (*CreatePortChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePortChannelResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_CreatePortChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) DeletePortChannel(ctx context.Context, in *DeletePortChannelRequest, opts ...grpc.CallOption) (*DeletePortChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePortChannelResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_DeletePortChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) GetPortChannel(ctx context.Context, in *GetPortChannelRequest, opts ...grpc.CallOption) (*GetPortChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortChannelResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_GetPortChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) ListPortChannels(ctx context.Context, in *ListPortChannelsRequest, opts ...grpc.CallOption) (*ListPortChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPortChannelsResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_ListPortChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) AddPortChannelMember(ctx context.Context, in *AddPortChannelMemberRequest, opts ...grpc.CallOption) (*AddPortChannelMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPortChannelMemberResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_AddPortChannelMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) RemovePortChannelMember(ctx context.Context, in *RemovePortChannelMemberRequest, opts ...grpc.CallOption) (*RemovePortChannelMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemovePortChannelMemberResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_RemovePortChannelMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) ListInterfaceAddresses(ctx context.Context, in *ListInterfaceAddressesRequest, opts ...grpc.CallOption) (*ListInterfaceAddressesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInterfaceAddressesResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_ListInterfaceAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) AddInterfaceAddress(ctx context.Context, in *AddInterfaceAddressRequest, opts ...grpc.CallOption) (*AddInterfaceAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddInterfaceAddressResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_AddInterfaceAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) RemoveInterfaceAddress(ctx context.Context, in *RemoveInterfaceAddressRequest, opts ...grpc.CallOption) (*RemoveInterfaceAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveInterfaceAddressResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_RemoveInterfaceAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) ListBGPNeighbors(ctx context.Context, in *ListBGPNeighborsRequest, opts ...grpc.CallOption) (*ListBGPNeighborsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBGPNeighborsResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_ListBGPNeighbors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) AddBGPNeighbor(ctx context.Context, in *AddBGPNeighborRequest, opts ...grpc.CallOption) (*AddBGPNeighborResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBGPNeighborResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_AddBGPNeighbor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *switchAgentServiceClient) RemoveBGPNeighbor(ctx context.Context, in *RemoveBGPNeighborRequest, opts ...grpc.CallOption) (*RemoveBGPNeighborResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveBGPNeighborResponse)
+	err := c.cc.Invoke(ctx, SwitchAgentService_RemoveBGPNeighbor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *switchAgentServiceClient) SaveConfig(ctx context.Context, in *SaveConfigRequest, opts ...grpc.CallOption) (*SaveConfigResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SaveConfigResponse)
@@ -142,12 +451,37 @@ func (c *switchAgentServiceClient) SaveConfig(ctx context.Context, in *SaveConfi
 // The interface service definition.
 type SwitchAgentServiceServer interface {
 	GetDeviceInfo(context.Context, *GetDeviceInfoRequest) (*GetDeviceInfoResponse, error)
+	GetPlatformHealth(context.Context, *GetPlatformHealthRequest) (*GetPlatformHealthResponse, error)
 	ListInterfaces(context.Context, *ListInterfacesRequest) (*ListInterfacesResponse, error)
 	SetInterfaceAdminStatus(context.Context, *SetInterfaceAdminStatusRequest) (*SetInterfaceAdminStatusResponse, error)
 	SetInterfaceAliasName(context.Context, *SetInterfaceAliasNameRequest) (*SetInterfaceAliasNameResponse, error)
+	SetInterfacePortAttributes(context.Context, *SetInterfacePortAttributesRequest) (*SetInterfacePortAttributesResponse, error)
 	GetInterface(context.Context, *GetInterfaceRequest) (*GetInterfaceResponse, error)
 	GetInterfaceNeighbor(context.Context, *GetInterfaceNeighborRequest) (*GetInterfaceNeighborResponse, error)
+	GetInterfaceCounters(context.Context, *GetInterfaceCountersRequest) (*GetInterfaceCountersResponse, error)
+	ListInterfaceCounters(context.Context, *ListInterfaceCountersRequest) (*ListInterfaceCountersResponse, error)
+	GetTransceiver(context.Context, *GetTransceiverRequest) (*GetTransceiverResponse, error)
+	WatchInterfaces(*WatchInterfacesRequest, grpc.ServerStreamingServer[InterfaceEvent]) error
 	ListPorts(context.Context, *ListPortsRequest) (*ListPortsResponse, error)
+	ListPortBreakouts(context.Context, *ListPortBreakoutsRequest) (*ListPortBreakoutsResponse, error)
+	SetPortBreakout(context.Context, *SetPortBreakoutRequest) (*SetPortBreakoutResponse, error)
+	CreateVlan(context.Context, *CreateVlanRequest) (*CreateVlanResponse, error)
+	DeleteVlan(context.Context, *DeleteVlanRequest) (*DeleteVlanResponse, error)
+	ListVlans(context.Context, *ListVlansRequest) (*ListVlansResponse, error)
+	AddVlanMember(context.Context, *AddVlanMemberRequest) (*AddVlanMemberResponse, error)
+	RemoveVlanMember(context.Context, *RemoveVlanMemberRequest) (*RemoveVlanMemberResponse, error)
+	CreatePortChannel(context.Context, *CreatePortChannelRequest) (*CreatePortChannelResponse, error)
+	DeletePortChannel(context.Context, *DeletePortChannelRequest) (*DeletePortChannelResponse, error)
+	GetPortChannel(context.Context, *GetPortChannelRequest) (*GetPortChannelResponse, error)
+	ListPortChannels(context.Context, *ListPortChannelsRequest) (*ListPortChannelsResponse, error)
+	AddPortChannelMember(context.Context, *AddPortChannelMemberRequest) (*AddPortChannelMemberResponse, error)
+	RemovePortChannelMember(context.Context, *RemovePortChannelMemberRequest) (*RemovePortChannelMemberResponse, error)
+	ListInterfaceAddresses(context.Context, *ListInterfaceAddressesRequest) (*ListInterfaceAddressesResponse, error)
+	AddInterfaceAddress(context.Context, *AddInterfaceAddressRequest) (*AddInterfaceAddressResponse, error)
+	RemoveInterfaceAddress(context.Context, *RemoveInterfaceAddressRequest) (*RemoveInterfaceAddressResponse, error)
+	ListBGPNeighbors(context.Context, *ListBGPNeighborsRequest) (*ListBGPNeighborsResponse, error)
+	AddBGPNeighbor(context.Context, *AddBGPNeighborRequest) (*AddBGPNeighborResponse, error)
+	RemoveBGPNeighbor(context.Context, *RemoveBGPNeighborRequest) (*RemoveBGPNeighborResponse, error)
 	// gNOI alternatives
 	SaveConfig(context.Context, *SaveConfigRequest) (*SaveConfigResponse, error)
 	mustEmbedUnimplementedSwitchAgentServiceServer()
@@ -163,6 +497,9 @@ type UnimplementedSwitchAgentServiceServer struct{}
 func (UnimplementedSwitchAgentServiceServer) GetDeviceInfo(context.Context, *GetDeviceInfoRequest) (*GetDeviceInfoResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetDeviceInfo not implemented")
 }
+func (UnimplementedSwitchAgentServiceServer) GetPlatformHealth(context.Context, *GetPlatformHealthRequest) (*GetPlatformHealthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPlatformHealth not implemented")
+}
 func (UnimplementedSwitchAgentServiceServer) ListInterfaces(context.Context, *ListInterfacesRequest) (*ListInterfacesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListInterfaces not implemented")
 }
@@ -172,15 +509,87 @@ func (UnimplementedSwitchAgentServiceServer) SetInterfaceAdminStatus(context.Con
 func (UnimplementedSwitchAgentServiceServer) SetInterfaceAliasName(context.Context, *SetInterfaceAliasNameRequest) (*SetInterfaceAliasNameResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetInterfaceAliasName not implemented")
 }
+func (UnimplementedSwitchAgentServiceServer) SetInterfacePortAttributes(context.Context, *SetInterfacePortAttributesRequest) (*SetInterfacePortAttributesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetInterfacePortAttributes not implemented")
+}
 func (UnimplementedSwitchAgentServiceServer) GetInterface(context.Context, *GetInterfaceRequest) (*GetInterfaceResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetInterface not implemented")
 }
 func (UnimplementedSwitchAgentServiceServer) GetInterfaceNeighbor(context.Context, *GetInterfaceNeighborRequest) (*GetInterfaceNeighborResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetInterfaceNeighbor not implemented")
 }
+func (UnimplementedSwitchAgentServiceServer) GetInterfaceCounters(context.Context, *GetInterfaceCountersRequest) (*GetInterfaceCountersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInterfaceCounters not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) ListInterfaceCounters(context.Context, *ListInterfaceCountersRequest) (*ListInterfaceCountersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInterfaceCounters not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) GetTransceiver(context.Context, *GetTransceiverRequest) (*GetTransceiverResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransceiver not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) WatchInterfaces(*WatchInterfacesRequest, grpc.ServerStreamingServer[InterfaceEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchInterfaces not implemented")
+}
 func (UnimplementedSwitchAgentServiceServer) ListPorts(context.Context, *ListPortsRequest) (*ListPortsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListPorts not implemented")
 }
+func (UnimplementedSwitchAgentServiceServer) ListPortBreakouts(context.Context, *ListPortBreakoutsRequest) (*ListPortBreakoutsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPortBreakouts not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) SetPortBreakout(context.Context, *SetPortBreakoutRequest) (*SetPortBreakoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPortBreakout not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) CreateVlan(context.Context, *CreateVlanRequest) (*CreateVlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateVlan not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) DeleteVlan(context.Context, *DeleteVlanRequest) (*DeleteVlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteVlan not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) ListVlans(context.Context, *ListVlansRequest) (*ListVlansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVlans not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) AddVlanMember(context.Context, *AddVlanMemberRequest) (*AddVlanMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddVlanMember not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) RemoveVlanMember(context.Context, *RemoveVlanMemberRequest) (*RemoveVlanMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveVlanMember not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) CreatePortChannel(context.Context, *CreatePortChannelRequest) (*CreatePortChannelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePortChannel not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) DeletePortChannel(context.Context, *DeletePortChannelRequest) (*DeletePortChannelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePortChannel not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) GetPortChannel(context.Context, *GetPortChannelRequest) (*GetPortChannelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPortChannel not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) ListPortChannels(context.Context, *ListPortChannelsRequest) (*ListPortChannelsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPortChannels not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) AddPortChannelMember(context.Context, *AddPortChannelMemberRequest) (*AddPortChannelMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddPortChannelMember not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) RemovePortChannelMember(context.Context, *RemovePortChannelMemberRequest) (*RemovePortChannelMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemovePortChannelMember not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) ListInterfaceAddresses(context.Context, *ListInterfaceAddressesRequest) (*ListInterfaceAddressesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInterfaceAddresses not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) AddInterfaceAddress(context.Context, *AddInterfaceAddressRequest) (*AddInterfaceAddressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddInterfaceAddress not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) RemoveInterfaceAddress(context.Context, *RemoveInterfaceAddressRequest) (*RemoveInterfaceAddressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveInterfaceAddress not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) ListBGPNeighbors(context.Context, *ListBGPNeighborsRequest) (*ListBGPNeighborsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBGPNeighbors not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) AddBGPNeighbor(context.Context, *AddBGPNeighborRequest) (*AddBGPNeighborResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddBGPNeighbor not implemented")
+}
+func (UnimplementedSwitchAgentServiceServer) RemoveBGPNeighbor(context.Context, *RemoveBGPNeighborRequest) (*RemoveBGPNeighborResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveBGPNeighbor not implemented")
+}
 func (UnimplementedSwitchAgentServiceServer) SaveConfig(context.Context, *SaveConfigRequest) (*SaveConfigResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SaveConfig not implemented")
 }
@@ -223,6 +632,24 @@ func _SwitchAgentService_GetDeviceInfo_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SwitchAgentService_GetPlatformHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlatformHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).GetPlatformHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_GetPlatformHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).GetPlatformHealth(ctx, req.(*GetPlatformHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SwitchAgentService_ListInterfaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListInterfacesRequest)
 	if err := dec(in); err != nil {
@@ -277,6 +704,24 @@ func _SwitchAgentService_SetInterfaceAliasName_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SwitchAgentService_SetInterfacePortAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInterfacePortAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).SetInterfacePortAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_SetInterfacePortAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).SetInterfacePortAttributes(ctx, req.(*SetInterfacePortAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SwitchAgentService_GetInterface_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInterfaceRequest)
 	if err := dec(in); err != nil {
@@ -313,58 +758,469 @@ func _SwitchAgentService_GetInterfaceNeighbor_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwitchAgentService_ListPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPortsRequest)
+func _SwitchAgentService_GetInterfaceCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInterfaceCountersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwitchAgentServiceServer).ListPorts(ctx, in)
+		return srv.(SwitchAgentServiceServer).GetInterfaceCounters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SwitchAgentService_ListPorts_FullMethodName,
+		FullMethod: SwitchAgentService_GetInterfaceCounters_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwitchAgentServiceServer).ListPorts(ctx, req.(*ListPortsRequest))
+		return srv.(SwitchAgentServiceServer).GetInterfaceCounters(ctx, req.(*GetInterfaceCountersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwitchAgentService_SaveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveConfigRequest)
+func _SwitchAgentService_ListInterfaceCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInterfaceCountersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwitchAgentServiceServer).SaveConfig(ctx, in)
+		return srv.(SwitchAgentServiceServer).ListInterfaceCounters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SwitchAgentService_SaveConfig_FullMethodName,
+		FullMethod: SwitchAgentService_ListInterfaceCounters_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwitchAgentServiceServer).SaveConfig(ctx, req.(*SaveConfigRequest))
+		return srv.(SwitchAgentServiceServer).ListInterfaceCounters(ctx, req.(*ListInterfaceCountersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// SwitchAgentService_ServiceDesc is the grpc.ServiceDesc for SwitchAgentService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var SwitchAgentService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "switchagent.v1.SwitchAgentService",
-	HandlerType: (*SwitchAgentServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetDeviceInfo",
-			Handler:    _SwitchAgentService_GetDeviceInfo_Handler,
-		},
-		{
-			MethodName: "ListInterfaces",
-			Handler:    _SwitchAgentService_ListInterfaces_Handler,
-		},
-		{
+func _SwitchAgentService_GetTransceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransceiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).GetTransceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_GetTransceiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).GetTransceiver(ctx, req.(*GetTransceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_WatchInterfaces_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchInterfacesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SwitchAgentServiceServer).WatchInterfaces(m, &grpc.GenericServerStream[WatchInterfacesRequest, InterfaceEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SwitchAgentService_WatchInterfacesServer = grpc.ServerStreamingServer[InterfaceEvent]
+
+func _SwitchAgentService_ListPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).ListPorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_ListPorts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).ListPorts(ctx, req.(*ListPortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_ListPortBreakouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPortBreakoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).ListPortBreakouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_ListPortBreakouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).ListPortBreakouts(ctx, req.(*ListPortBreakoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_SetPortBreakout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPortBreakoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).SetPortBreakout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_SetPortBreakout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).SetPortBreakout(ctx, req.(*SetPortBreakoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_CreateVlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).CreateVlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_CreateVlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).CreateVlan(ctx, req.(*CreateVlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_DeleteVlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).DeleteVlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_DeleteVlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).DeleteVlan(ctx, req.(*DeleteVlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_ListVlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).ListVlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_ListVlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).ListVlans(ctx, req.(*ListVlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_AddVlanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVlanMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).AddVlanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_AddVlanMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).AddVlanMember(ctx, req.(*AddVlanMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_RemoveVlanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveVlanMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).RemoveVlanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_RemoveVlanMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).RemoveVlanMember(ctx, req.(*RemoveVlanMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_CreatePortChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePortChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).CreatePortChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_CreatePortChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).CreatePortChannel(ctx, req.(*CreatePortChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_DeletePortChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePortChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).DeletePortChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_DeletePortChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).DeletePortChannel(ctx, req.(*DeletePortChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_GetPortChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).GetPortChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_GetPortChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).GetPortChannel(ctx, req.(*GetPortChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_ListPortChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPortChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).ListPortChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_ListPortChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).ListPortChannels(ctx, req.(*ListPortChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_AddPortChannelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPortChannelMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).AddPortChannelMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_AddPortChannelMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).AddPortChannelMember(ctx, req.(*AddPortChannelMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_RemovePortChannelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePortChannelMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).RemovePortChannelMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_RemovePortChannelMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).RemovePortChannelMember(ctx, req.(*RemovePortChannelMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_ListInterfaceAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInterfaceAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).ListInterfaceAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_ListInterfaceAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).ListInterfaceAddresses(ctx, req.(*ListInterfaceAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_AddInterfaceAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddInterfaceAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).AddInterfaceAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_AddInterfaceAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).AddInterfaceAddress(ctx, req.(*AddInterfaceAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_RemoveInterfaceAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveInterfaceAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).RemoveInterfaceAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_RemoveInterfaceAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).RemoveInterfaceAddress(ctx, req.(*RemoveInterfaceAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_ListBGPNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBGPNeighborsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).ListBGPNeighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_ListBGPNeighbors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).ListBGPNeighbors(ctx, req.(*ListBGPNeighborsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_AddBGPNeighbor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBGPNeighborRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).AddBGPNeighbor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_AddBGPNeighbor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).AddBGPNeighbor(ctx, req.(*AddBGPNeighborRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_RemoveBGPNeighbor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBGPNeighborRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).RemoveBGPNeighbor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_RemoveBGPNeighbor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).RemoveBGPNeighbor(ctx, req.(*RemoveBGPNeighborRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwitchAgentService_SaveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwitchAgentServiceServer).SaveConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwitchAgentService_SaveConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwitchAgentServiceServer).SaveConfig(ctx, req.(*SaveConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SwitchAgentService_ServiceDesc is the grpc.ServiceDesc for SwitchAgentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SwitchAgentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "switchagent.v1.SwitchAgentService",
+	HandlerType: (*SwitchAgentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDeviceInfo",
+			Handler:    _SwitchAgentService_GetDeviceInfo_Handler,
+		},
+		{
+			MethodName: "GetPlatformHealth",
+			Handler:    _SwitchAgentService_GetPlatformHealth_Handler,
+		},
+		{
+			MethodName: "ListInterfaces",
+			Handler:    _SwitchAgentService_ListInterfaces_Handler,
+		},
+		{
 			MethodName: "SetInterfaceAdminStatus",
 			Handler:    _SwitchAgentService_SetInterfaceAdminStatus_Handler,
 		},
@@ -372,6 +1228,10 @@ var SwitchAgentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetInterfaceAliasName",
 			Handler:    _SwitchAgentService_SetInterfaceAliasName_Handler,
 		},
+		{
+			MethodName: "SetInterfacePortAttributes",
+			Handler:    _SwitchAgentService_SetInterfacePortAttributes_Handler,
+		},
 		{
 			MethodName: "GetInterface",
 			Handler:    _SwitchAgentService_GetInterface_Handler,
@@ -380,15 +1240,109 @@ var SwitchAgentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInterfaceNeighbor",
 			Handler:    _SwitchAgentService_GetInterfaceNeighbor_Handler,
 		},
+		{
+			MethodName: "GetInterfaceCounters",
+			Handler:    _SwitchAgentService_GetInterfaceCounters_Handler,
+		},
+		{
+			MethodName: "ListInterfaceCounters",
+			Handler:    _SwitchAgentService_ListInterfaceCounters_Handler,
+		},
+		{
+			MethodName: "GetTransceiver",
+			Handler:    _SwitchAgentService_GetTransceiver_Handler,
+		},
 		{
 			MethodName: "ListPorts",
 			Handler:    _SwitchAgentService_ListPorts_Handler,
 		},
+		{
+			MethodName: "ListPortBreakouts",
+			Handler:    _SwitchAgentService_ListPortBreakouts_Handler,
+		},
+		{
+			MethodName: "SetPortBreakout",
+			Handler:    _SwitchAgentService_SetPortBreakout_Handler,
+		},
+		{
+			MethodName: "CreateVlan",
+			Handler:    _SwitchAgentService_CreateVlan_Handler,
+		},
+		{
+			MethodName: "DeleteVlan",
+			Handler:    _SwitchAgentService_DeleteVlan_Handler,
+		},
+		{
+			MethodName: "ListVlans",
+			Handler:    _SwitchAgentService_ListVlans_Handler,
+		},
+		{
+			MethodName: "AddVlanMember",
+			Handler:    _SwitchAgentService_AddVlanMember_Handler,
+		},
+		{
+			MethodName: "RemoveVlanMember",
+			Handler:    _SwitchAgentService_RemoveVlanMember_Handler,
+		},
+		{
+			MethodName: "CreatePortChannel",
+			Handler:    _SwitchAgentService_CreatePortChannel_Handler,
+		},
+		{
+			MethodName: "DeletePortChannel",
+			Handler:    _SwitchAgentService_DeletePortChannel_Handler,
+		},
+		{
+			MethodName: "GetPortChannel",
+			Handler:    _SwitchAgentService_GetPortChannel_Handler,
+		},
+		{
+			MethodName: "ListPortChannels",
+			Handler:    _SwitchAgentService_ListPortChannels_Handler,
+		},
+		{
+			MethodName: "AddPortChannelMember",
+			Handler:    _SwitchAgentService_AddPortChannelMember_Handler,
+		},
+		{
+			MethodName: "RemovePortChannelMember",
+			Handler:    _SwitchAgentService_RemovePortChannelMember_Handler,
+		},
+		{
+			MethodName: "ListInterfaceAddresses",
+			Handler:    _SwitchAgentService_ListInterfaceAddresses_Handler,
+		},
+		{
+			MethodName: "AddInterfaceAddress",
+			Handler:    _SwitchAgentService_AddInterfaceAddress_Handler,
+		},
+		{
+			MethodName: "RemoveInterfaceAddress",
+			Handler:    _SwitchAgentService_RemoveInterfaceAddress_Handler,
+		},
+		{
+			MethodName: "ListBGPNeighbors",
+			Handler:    _SwitchAgentService_ListBGPNeighbors_Handler,
+		},
+		{
+			MethodName: "AddBGPNeighbor",
+			Handler:    _SwitchAgentService_AddBGPNeighbor_Handler,
+		},
+		{
+			MethodName: "RemoveBGPNeighbor",
+			Handler:    _SwitchAgentService_RemoveBGPNeighbor_Handler,
+		},
 		{
 			MethodName: "SaveConfig",
 			Handler:    _SwitchAgentService_SaveConfig_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchInterfaces",
+			Handler:       _SwitchAgentService_WatchInterfaces_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "internal/agent/proto/switch_agent.proto",
 }
@@ -0,0 +1,260 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeRedis is a minimal RESP2 server holding hashes per database. It
+// implements the commands needed to read and write the SONiC databases.
+type fakeRedis struct {
+	mu  sync.Mutex
+	dbs map[int]map[string]map[string]string
+	// writes counts the HSET and HDEL commands received.
+	writes int
+}
+
+// startFakeRedis serves an empty fakeRedis on a local port and returns it with its address.
+func startFakeRedis() (*fakeRedis, string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(lis.Close)
+
+	r := &fakeRedis{dbs: map[int]map[string]map[string]string{}}
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go r.serve(conn)
+		}
+	}()
+	return r, lis.Addr().String()
+}
+
+// hset sets the fields of the hash with the given key in the database with the given name.
+func (r *fakeRedis) hset(dbName, key string, fields map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	db := getRedisDBIDByName(dbName)
+	if r.dbs[db] == nil {
+		r.dbs[db] = map[string]map[string]string{}
+	}
+	if r.dbs[db][key] == nil {
+		r.dbs[db][key] = map[string]string{}
+	}
+	for field, value := range fields {
+		r.dbs[db][key][field] = value
+	}
+}
+
+// get returns a copy of the hash with the given key in the database with the given name.
+func (r *fakeRedis) get(dbName, key string) map[string]string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fields := map[string]string{}
+	for field, value := range r.dbs[getRedisDBIDByName(dbName)][key] {
+		fields[field] = value
+	}
+	return fields
+}
+
+// writeCount returns the number of HSET and HDEL commands received.
+func (r *fakeRedis) writeCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.writes
+}
+
+func (r *fakeRedis) serve(conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	reader := bufio.NewReader(conn)
+	db := 0
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+
+		var reply string
+		switch strings.ToUpper(args[0]) {
+		case "PING":
+			reply = "+PONG\r\n"
+		case "CLIENT":
+			reply = "+OK\r\n"
+		case "SELECT":
+			db, _ = strconv.Atoi(args[1])
+			reply = "+OK\r\n"
+		case "KEYS":
+			reply = r.keys(db, args[1])
+		case "HGETALL":
+			reply = r.hgetall(db, args[1])
+		case "HGET":
+			reply = r.hgetField(db, args[1], args[2])
+		case "EXISTS":
+			reply = r.exists(db, args[1:])
+		case "HSET":
+			reply = r.hsetFields(db, args[1], args[2:])
+		case "HDEL":
+			reply = r.hdel(db, args[1], args[2:])
+		default:
+			reply = fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0])
+		}
+		if _, err := io.WriteString(conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+func (r *fakeRedis) keys(db int, pattern string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var keys []string
+	for key := range r.dbs[db] {
+		if ok, _ := path.Match(pattern, key); ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return bulkArray(keys)
+}
+
+func (r *fakeRedis) hgetall(db int, key string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var values []string
+	for field, value := range r.dbs[db][key] {
+		values = append(values, field, value)
+	}
+	return bulkArray(values)
+}
+
+func (r *fakeRedis) hgetField(db int, key, field string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	value, ok := r.dbs[db][key][field]
+	if !ok {
+		return "$-1\r\n"
+	}
+	return fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+}
+
+func (r *fakeRedis) exists(db int, keys []string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := 0
+	for _, key := range keys {
+		if _, ok := r.dbs[db][key]; ok {
+			n++
+		}
+	}
+	return fmt.Sprintf(":%d\r\n", n)
+}
+
+func (r *fakeRedis) hsetFields(db int, key string, fieldValues []string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.writes++
+	if r.dbs[db] == nil {
+		r.dbs[db] = map[string]map[string]string{}
+	}
+	if r.dbs[db][key] == nil {
+		r.dbs[db][key] = map[string]string{}
+	}
+	added := 0
+	for i := 0; i+1 < len(fieldValues); i += 2 {
+		if _, ok := r.dbs[db][key][fieldValues[i]]; !ok {
+			added++
+		}
+		r.dbs[db][key][fieldValues[i]] = fieldValues[i+1]
+	}
+	return fmt.Sprintf(":%d\r\n", added)
+}
+
+func (r *fakeRedis) hdel(db int, key string, fields []string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.writes++
+	removed := 0
+	for _, field := range fields {
+		if _, ok := r.dbs[db][key][field]; ok {
+			delete(r.dbs[db][key], field)
+			removed++
+		}
+	}
+	if len(r.dbs[db][key]) == 0 {
+		delete(r.dbs[db], key)
+	}
+	return fmt.Sprintf(":%d\r\n", removed)
+}
+
+func bulkArray(values []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(values))
+	for _, v := range values {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(v), v)
+	}
+	return b.String()
+}
+
+// readCommand reads a command sent as an array of bulk strings.
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "*") {
+		return nil, fmt.Errorf("unexpected command %q", line)
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, 0, n)
+	for range n {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(header, "$")))
+		if err != nil {
+			return nil, err
+		}
+		arg := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, arg); err != nil {
+			return nil, err
+		}
+		args = append(args, string(arg[:size]))
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("empty command")
+	}
+	return args, nil
+}
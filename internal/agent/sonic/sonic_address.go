@@ -0,0 +1,289 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	errors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// interfaceAddressTable resolves the CONFIG_DB table holding the addresses of
+// the given interface along with the table of the interface itself, if any.
+func (m *SonicAgent) interfaceAddressTable(name string) (nativeName, addressTable, parentTable string, status *agent.Status) {
+	switch {
+	case strings.HasPrefix(name, "Loopback"):
+		return name, "LOOPBACK_INTERFACE", "", nil
+	case strings.HasPrefix(name, "Vlan"):
+		return name, "VLAN_INTERFACE", "VLAN", nil
+	case strings.HasPrefix(name, "PortChannel"):
+		return name, "PORTCHANNEL_INTERFACE", "PORTCHANNEL", nil
+	}
+
+	nativeName, status = m.resolveNativeInterfaceName(name)
+	if status != nil {
+		return "", "", "", status
+	}
+	return nativeName, "INTERFACE", "PORT", nil
+}
+
+// parseInterfacePrefix validates the given address and returns it in the
+// notation SONiC uses for its keys, e.g. 10.0.0.1/31.
+func parseInterfacePrefix(prefix string) (string, *agent.Status) {
+	p, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		return "", errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("invalid address %q: %v", prefix, err))
+	}
+	return p.String(), nil
+}
+
+func (m *SonicAgent) ListInterfaceAddresses(ctx context.Context, iface *agent.Interface) (*agent.InterfaceAddressList, *agent.Status) {
+	if iface == nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "interface cannot be empty")
+	}
+
+	nativeName, addressTable, _, status := m.interfaceAddressTable(iface.Name)
+	if status != nil {
+		return nil, status
+	}
+
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	applDB, err := m.Connect("APPL_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to APPL_DB: %v", err))
+	}
+
+	addresses := map[string]*agent.InterfaceAddress{}
+	address := func(prefix string) *agent.InterfaceAddress {
+		if _, ok := addresses[prefix]; !ok {
+			addresses[prefix] = &agent.InterfaceAddress{
+				TypeMeta: agent.TypeMeta{
+					Kind: agent.InterfaceAddressKind,
+				},
+				Interface: nativeName,
+				Prefix:    prefix,
+			}
+		}
+		return addresses[prefix]
+	}
+
+	configKeyPrefix := fmt.Sprintf("%s|%s|", addressTable, nativeName)
+	configKeys, err := configDB.Keys(ctx, configKeyPrefix+"*").Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to obtain interface address keys: %v", err))
+	}
+	for _, key := range configKeys {
+		address(strings.TrimPrefix(key, configKeyPrefix)).Configured = true
+	}
+
+	// IPv6 prefixes contain colons themselves, so only strip the known key prefix
+	applKeyPrefix := fmt.Sprintf("INTF_TABLE:%s:", nativeName)
+	applKeys, err := applDB.Keys(ctx, applKeyPrefix+"*").Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to obtain interface address keys from APPL_DB: %v", err))
+	}
+	for _, key := range applKeys {
+		address(strings.TrimPrefix(key, applKeyPrefix)).Active = true
+	}
+
+	prefixes := make([]string, 0, len(addresses))
+	for prefix := range addresses {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	items := make([]agent.InterfaceAddress, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		items = append(items, *addresses[prefix])
+	}
+
+	return &agent.InterfaceAddressList{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceAddressListKind,
+		},
+		Items:  items,
+		Status: agent.Status{Code: 0, Message: "ok"},
+	}, nil
+}
+
+func (m *SonicAgent) AddInterfaceAddress(ctx context.Context, address *agent.InterfaceAddress) (*agent.InterfaceAddress, *agent.Status) {
+	if address == nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "interface address cannot be empty")
+	}
+
+	nativeName, addressTable, parentTable, status := m.interfaceAddressTable(address.Interface)
+	if status != nil {
+		return nil, status
+	}
+
+	prefix, status := parseInterfacePrefix(address.Prefix)
+	if status != nil {
+		return nil, status
+	}
+
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	if parentTable != "" {
+		exists, err := configDB.Exists(ctx, fmt.Sprintf("%s|%s", parentTable, nativeName)).Result()
+		if err != nil {
+			return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to check interface existence: %v", err))
+		}
+		if exists == 0 {
+			return nil, errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("interface %s not found", nativeName))
+		}
+	}
+
+	if addressTable == "INTERFACE" {
+		keys, err := configDB.Keys(ctx, fmt.Sprintf("PORTCHANNEL_MEMBER|*|%s", nativeName)).Result()
+		if err != nil {
+			return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to obtain port channel member keys: %v", err))
+		}
+		if len(keys) > 0 {
+			return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("interface %s is a port channel member (%s)", nativeName, keys[0]))
+		}
+	}
+
+	addressKey := fmt.Sprintf("%s|%s|%s", addressTable, nativeName, prefix)
+	exists, err := configDB.Exists(ctx, addressKey).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to check interface address existence: %v", err))
+	}
+	if exists != 0 {
+		return nil, errors.NewErrorStatus(errors.ALREADY_EXISTS, fmt.Sprintf("address %s already exists on interface %s", prefix, nativeName))
+	}
+
+	// SONiC only enables routing on an interface with an interface key present
+	interfaceKey := fmt.Sprintf("%s|%s", addressTable, nativeName)
+	interfaceExists, err := configDB.Exists(ctx, interfaceKey).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to check interface existence: %v", err))
+	}
+
+	pipe := configDB.TxPipeline()
+	if interfaceExists == 0 {
+		pipe.HSet(ctx, interfaceKey, "NULL", "NULL")
+	}
+	pipe.HSet(ctx, addressKey, "NULL", "NULL")
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HSET_FAIL, fmt.Sprintf("failed to add interface address: %v", err))
+	}
+
+	// Persist changes to config_db.json
+	if status := m.SaveConfig(ctx); status != nil {
+		// Try to rollback if save fails
+		_ = configDB.Del(ctx, addressKey).Err()
+		if interfaceExists == 0 {
+			_ = configDB.Del(ctx, interfaceKey).Err()
+		}
+		return nil, status
+	}
+
+	return &agent.InterfaceAddress{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceAddressKind,
+		},
+		Interface:  nativeName,
+		Prefix:     prefix,
+		Configured: true,
+		Status:     agent.Status{Code: 0, Message: "ok"},
+	}, nil
+}
+
+func (m *SonicAgent) RemoveInterfaceAddress(ctx context.Context, address *agent.InterfaceAddress) *agent.Status {
+	if address == nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, "interface address cannot be empty")
+	}
+
+	nativeName, addressTable, _, status := m.interfaceAddressTable(address.Interface)
+	if status != nil {
+		return status
+	}
+
+	prefix, status := parseInterfacePrefix(address.Prefix)
+	if status != nil {
+		return status
+	}
+
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	addressKey := fmt.Sprintf("%s|%s|%s", addressTable, nativeName, prefix)
+	exists, err := configDB.Exists(ctx, addressKey).Result()
+	if err != nil {
+		return errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to check interface address existence: %v", err))
+	}
+	if exists == 0 {
+		return errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("address %s not found on interface %s", prefix, nativeName))
+	}
+
+	interfaceKey := fmt.Sprintf("%s|%s", addressTable, nativeName)
+	removeInterfaceKey, status := isBareInterfaceKey(ctx, configDB, interfaceKey, addressKey)
+	if status != nil {
+		return status
+	}
+
+	pipe := configDB.TxPipeline()
+	pipe.Del(ctx, addressKey)
+	if removeInterfaceKey {
+		pipe.Del(ctx, interfaceKey)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.NewErrorStatus(errors.REDIS_HSET_FAIL, fmt.Sprintf("failed to remove interface address: %v", err))
+	}
+
+	// Persist changes to config_db.json
+	if status := m.SaveConfig(ctx); status != nil {
+		// Try to rollback if save fails
+		_ = configDB.HSet(ctx, addressKey, "NULL", "NULL").Err()
+		if removeInterfaceKey {
+			_ = configDB.HSet(ctx, interfaceKey, "NULL", "NULL").Err()
+		}
+		return status
+	}
+
+	return nil
+}
+
+// isBareInterfaceKey reports whether the interface key only exists to carry
+// the given address, i.e. it has no further addresses and no attributes such
+// as a VRF binding. Such a key is removed along with the last address.
+func isBareInterfaceKey(ctx context.Context, configDB *redis.Client, interfaceKey, addressKey string) (bool, *agent.Status) {
+	keys, err := configDB.Keys(ctx, interfaceKey+"|*").Result()
+	if err != nil {
+		return false, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to obtain interface address keys: %v", err))
+	}
+	for _, key := range keys {
+		if key != addressKey {
+			return false, nil
+		}
+	}
+
+	fields, err := configDB.HGetAll(ctx, interfaceKey).Result()
+	if err != nil {
+		return false, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get interface %s: %v", interfaceKey, err))
+	}
+	for field := range fields {
+		if field != "NULL" {
+			return false, nil
+		}
+	}
+
+	return len(fields) > 0, nil
+}
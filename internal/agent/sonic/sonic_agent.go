@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"sync"
 	"time"
 
@@ -34,6 +33,11 @@ type SonicAgent struct {
 	redisAddr  string
 	clientPool map[string]*redis.Client
 	poolMutex  sync.RWMutex
+
+	// BGPStateSource provides the runtime state of the BGP sessions.
+	BGPStateSource BGPStateSource
+	// NameMapper converts between native and abstract interface names.
+	NameMapper agent.NameMapper
 }
 
 func getRedisDBIDByName(name string) int {
@@ -97,9 +101,11 @@ func NewSonicRedisAgent(redisAddr string) (*SonicAgent, error) {
 	}
 
 	return &SonicAgent{
-		redisAddr:  redisAddr,
-		clientPool: make(map[string]*redis.Client),
-		poolMutex:  sync.RWMutex{},
+		redisAddr:      redisAddr,
+		clientPool:     make(map[string]*redis.Client),
+		poolMutex:      sync.RWMutex{},
+		BGPStateSource: &VtyshBGPStateSource{},
+		NameMapper:     agent.DefaultNameMapper,
 	}, nil
 }
 
@@ -287,7 +293,7 @@ func (m *SonicAgent) ListInterfaces(ctx context.Context) (*agent.InterfaceList,
 			return nil, agent.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("no MAC address found for interface %s", name))
 		}
 
-		abstractName, err := agent.NativeNameToAbstractName(name)
+		abstractName, err := m.NameMapper.NativeToAbstract(name)
 		if err != nil {
 			return nil, agent.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to convert native name to abstract name: %v", err))
 		}
@@ -308,6 +314,7 @@ func (m *SonicAgent) ListInterfaces(ctx context.Context) (*agent.InterfaceList,
 			OperationStatus: operStatus,
 			AdminStatus:     adminStatus,
 		}
+		setPortAttributes(&iface, applFields)
 		interfaces = append(interfaces, iface)
 	}
 
@@ -346,22 +353,14 @@ func (m *SonicAgent) SaveConfig(ctx context.Context) *agent.Status {
 
 func (m *SonicAgent) SetInterfaceAdminStatus(ctx context.Context, iface *agent.Interface) (*agent.Interface, *agent.Status) {
 	// Validate input
-	var ifaceName string
 	var err error
 
 	if iface == nil || iface.Name == "" {
 		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "interface name cannot be empty")
 	}
-	if !strings.HasPrefix(iface.Name, "Ethernet") && !strings.HasPrefix(iface.Name, "eth") {
-		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "invalid interface name. Must start with 'Ethernet' or 'eth'")
-	}
-	if strings.HasPrefix(iface.Name, "eth") {
-		ifaceName, err = agent.AbstractNameToNativeName(iface.Name)
-		if err != nil {
-			return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to convert abstract name to native name: %v", err))
-		}
-	} else {
-		ifaceName = iface.Name
+	ifaceName, status := m.resolveNativeInterfaceName(iface.Name)
+	if status != nil {
+		return nil, status
 	}
 
 	configDB, err := m.Connect("CONFIG_DB")
@@ -448,7 +447,7 @@ func (m *SonicAgent) SetInterfaceAdminStatus(ctx context.Context, iface *agent.I
 	updatedIface.OperationStatus = operStatus
 	updatedIface.AliasName = alias // alias name should not be changed by this function, but we return it anyway for the caller to have the latest info
 
-	abstractName, _ := agent.NativeNameToAbstractName(ifaceName)
+	abstractName, _ := m.NameMapper.NativeToAbstract(ifaceName)
 	resultInterface := &agent.Interface{
 		TypeMeta: agent.TypeMeta{
 			Kind: agent.InterfaceKind,
@@ -466,22 +465,14 @@ func (m *SonicAgent) SetInterfaceAdminStatus(ctx context.Context, iface *agent.I
 
 func (m *SonicAgent) GetInterface(ctx context.Context, iface *agent.Interface) (*agent.Interface, *agent.Status) {
 	// Validate input
-	var ifaceName string
 	var err error
 
 	if iface == nil || iface.Name == "" {
 		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "interface name cannot be empty")
 	}
-	if !strings.HasPrefix(iface.Name, "Ethernet") && !strings.HasPrefix(iface.Name, "eth") {
-		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "invalid interface name. Must start with 'Ethernet' or 'eth'")
-	}
-	if strings.HasPrefix(iface.Name, "eth") {
-		ifaceName, err = agent.AbstractNameToNativeName(iface.Name)
-		if err != nil {
-			return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to convert abstract name to native name: %v", err))
-		}
-	} else {
-		ifaceName = iface.Name
+	ifaceName, status := m.resolveNativeInterfaceName(iface.Name)
+	if status != nil {
+		return nil, status
 	}
 
 	configDB, err := m.Connect("CONFIG_DB")
@@ -550,7 +541,7 @@ func (m *SonicAgent) GetInterface(ctx context.Context, iface *agent.Interface) (
 		return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to get alias: %v", err))
 	}
 
-	abstractName, err := agent.NativeNameToAbstractName(ifaceName)
+	abstractName, err := m.NameMapper.NativeToAbstract(ifaceName)
 	if err != nil {
 		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to convert native name to abstract name: %v", err))
 	}
@@ -567,27 +558,20 @@ func (m *SonicAgent) GetInterface(ctx context.Context, iface *agent.Interface) (
 		AdminStatus:     adminStatus,
 		Status:          agent.Status{Code: 0, Message: "ok"},
 	}
+	setPortAttributes(resultInterface, applFields)
 
 	return resultInterface, nil
 }
 
 func (m *SonicAgent) GetInterfaceNeighbor(ctx context.Context, iface *agent.Interface) (*agent.InterfaceNeighbor, *agent.Status) {
-	var ifaceName string
 	var err error
 
 	if iface == nil || iface.Name == "" {
 		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "interface name cannot be empty")
 	}
-	if !strings.HasPrefix(iface.Name, "Ethernet") && !strings.HasPrefix(iface.Name, "eth") {
-		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "invalid interface name. Must start with 'Ethernet' or 'eth'")
-	}
-	if strings.HasPrefix(iface.Name, "eth") {
-		ifaceName, err = agent.AbstractNameToNativeName(iface.Name)
-		if err != nil {
-			return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to convert abstract name to native name: %v", err))
-		}
-	} else {
-		ifaceName = iface.Name
+	ifaceName, status := m.resolveNativeInterfaceName(iface.Name)
+	if status != nil {
+		return nil, status
 	}
 
 	applDB, err := m.Connect("APPL_DB")
@@ -624,11 +608,9 @@ func (m *SonicAgent) GetInterfaceNeighbor(ctx context.Context, iface *agent.Inte
 	if handle == "" {
 		// Fallback to lldp_rem_port_id if port_desc is not available
 		handle = lldpFields["lldp_rem_port_id"]
-	} else {
-		handle, err = agent.NativeNameToAbstractName(handle)
-		if err != nil {
-			return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to convert native name to abstract name: %v", err))
-		}
+	} else if abstractName, err := m.NameMapper.NativeToAbstract(handle); err == nil {
+		// The neighbor may use another naming scheme, keep its native name if it is unknown here
+		handle = abstractName
 	}
 
 	// Validate that we have the essential information
@@ -712,22 +694,14 @@ func (m *SonicAgent) ListPorts(ctx context.Context) (*agent.PortList, *agent.Sta
 
 func (m *SonicAgent) SetInterfaceAliasName(ctx context.Context, iface *agent.Interface) (*agent.Interface, *agent.Status) {
 	// Validate input
-	var ifaceName string
 	var err error
 
 	if iface == nil || iface.Name == "" {
 		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "interface name cannot be empty")
 	}
-	if !strings.HasPrefix(iface.Name, "Ethernet") && !strings.HasPrefix(iface.Name, "eth") {
-		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "invalid interface name. Must start with 'Ethernet' or 'eth'")
-	}
-	if strings.HasPrefix(iface.Name, "eth") {
-		ifaceName, err = agent.AbstractNameToNativeName(iface.Name)
-		if err != nil {
-			return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to convert abstract name to native name: %v", err))
-		}
-	} else {
-		ifaceName = iface.Name
+	ifaceName, status := m.resolveNativeInterfaceName(iface.Name)
+	if status != nil {
+		return nil, status
 	}
 
 	configDB, err := m.Connect("CONFIG_DB")
@@ -0,0 +1,320 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+
+	errors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// bgpRemoteASRegexp matches a 4-byte AS number or the FRR keywords for eBGP/iBGP peers.
+var bgpRemoteASRegexp = regexp.MustCompile(`^([0-9]{1,10}|external|internal)$`)
+
+// BGPPeerState is the runtime state of a BGP session.
+type BGPPeerState struct {
+	RemoteAS         string
+	State            string
+	PrefixesReceived uint64
+	Uptime           string
+}
+
+// BGPStateSource provides the runtime state of the BGP sessions keyed by peer,
+// i.e. the neighbor address or the interface of unnumbered neighbors.
+type BGPStateSource interface {
+	BGPPeerStates(ctx context.Context) (map[string]BGPPeerState, error)
+}
+
+// VtyshBGPStateSource reads the BGP session state from FRR.
+type VtyshBGPStateSource struct {
+	// Command is the command to run vtysh, e.g., []string{"docker", "exec", "bgp", "vtysh"}.
+	Command []string
+}
+
+type frrBGPPeer struct {
+	// RemoteAs is a number, or a string for peers accepting any eBGP/iBGP AS.
+	RemoteAs   json.RawMessage `json:"remoteAs"`
+	State      string          `json:"state"`
+	PeerUptime string          `json:"peerUptime"`
+	PfxRcd     uint64          `json:"pfxRcd"`
+}
+
+type frrBGPAddressFamilySummary struct {
+	Peers map[string]frrBGPPeer `json:"peers"`
+}
+
+func (s *VtyshBGPStateSource) BGPPeerStates(ctx context.Context) (map[string]BGPPeerState, error) {
+	command := s.Command
+	if len(command) == 0 {
+		command = []string{"vtysh"}
+	}
+	args := append(append([]string{}, command[1:]...), "-c", "show bgp summary json")
+	output, err := exec.CommandContext(ctx, command[0], args...).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run vtysh: %w", err)
+	}
+	return parseBGPSummary(output)
+}
+
+// parseBGPSummary parses the output of 'show bgp summary json', which reports
+// the peers per address family. The received prefixes are summed up.
+func parseBGPSummary(output []byte) (map[string]BGPPeerState, error) {
+	summary := map[string]frrBGPAddressFamilySummary{}
+	if err := json.Unmarshal(output, &summary); err != nil {
+		return nil, fmt.Errorf("failed to parse BGP summary: %w", err)
+	}
+
+	states := map[string]BGPPeerState{}
+	for _, af := range summary {
+		for peer, p := range af.Peers {
+			state := states[peer]
+			state.RemoteAS = strings.Trim(string(p.RemoteAs), `"`)
+			state.State = p.State
+			state.Uptime = p.PeerUptime
+			state.PrefixesReceived += p.PfxRcd
+			states[peer] = state
+		}
+	}
+	return states, nil
+}
+
+// StateDBBGPStateSource reads the BGP session state maintained by bgpmon in
+// STATE_DB. It does not report received prefixes and uptimes.
+type StateDBBGPStateSource struct {
+	Agent *SonicAgent
+}
+
+func (s *StateDBBGPStateSource) BGPPeerStates(ctx context.Context) (map[string]BGPPeerState, error) {
+	stateDB, err := s.Agent.Connect("STATE_DB")
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to STATE_DB: %w", err)
+	}
+
+	keys, err := stateDB.Keys(ctx, "NEIGH_STATE_TABLE|*").Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain NEIGH_STATE_TABLE keys: %w", err)
+	}
+
+	states := make(map[string]BGPPeerState, len(keys))
+	for _, key := range keys {
+		state, err := stateDB.HGet(ctx, key, "state").Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s: %w", key, err)
+		}
+		states[strings.TrimPrefix(key, "NEIGH_STATE_TABLE|")] = BGPPeerState{State: state}
+	}
+	return states, nil
+}
+
+// bgpNeighborKey returns the CONFIG_DB key of a neighbor in the default VRF.
+func bgpNeighborKey(neighbor string) string {
+	return fmt.Sprintf("BGP_NEIGHBOR|default|%s", neighbor)
+}
+
+func (m *SonicAgent) ListBGPNeighbors(ctx context.Context) (*agent.BGPNeighborList, *agent.Status) {
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	neighbors := map[string]*agent.BGPNeighbor{}
+	neighbor := func(name string) *agent.BGPNeighbor {
+		if _, ok := neighbors[name]; !ok {
+			neighbors[name] = &agent.BGPNeighbor{
+				TypeMeta: agent.TypeMeta{
+					Kind: agent.BGPNeighborKind,
+				},
+				Neighbor: name,
+			}
+		}
+		return neighbors[name]
+	}
+
+	keys, err := configDB.Keys(ctx, "BGP_NEIGHBOR|*").Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to obtain BGP_NEIGHBOR keys: %v", err))
+	}
+	for _, key := range keys {
+		// Both BGP_NEIGHBOR|<neighbor> and BGP_NEIGHBOR|default|<neighbor> refer to the default VRF
+		name := strings.TrimPrefix(key, "BGP_NEIGHBOR|")
+		if vrf, peer, ok := strings.Cut(name, "|"); ok {
+			if vrf != "default" {
+				continue
+			}
+			name = peer
+		}
+
+		fields, err := configDB.HGetAll(ctx, key).Result()
+		if err != nil {
+			return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get %s: %v", key, err))
+		}
+		n := neighbor(name)
+		n.Name = fields["name"]
+		n.RemoteAS = fields["asn"]
+		n.Configured = true
+	}
+
+	states, err := m.BGPStateSource.BGPPeerStates(ctx)
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.SERVER_ERROR, fmt.Sprintf("failed to get BGP session state: %v", err))
+	}
+	for name, state := range states {
+		n := neighbor(name)
+		if n.RemoteAS == "" {
+			n.RemoteAS = state.RemoteAS
+		}
+		n.State = state.State
+		n.PrefixesReceived = state.PrefixesReceived
+		n.Uptime = state.Uptime
+	}
+
+	names := make([]string, 0, len(neighbors))
+	for name := range neighbors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	items := make([]agent.BGPNeighbor, 0, len(names))
+	for _, name := range names {
+		items = append(items, *neighbors[name])
+	}
+
+	return &agent.BGPNeighborList{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.BGPNeighborListKind,
+		},
+		Items:  items,
+		Status: agent.Status{Code: 0, Message: "ok"},
+	}, nil
+}
+
+func (m *SonicAgent) AddBGPNeighbor(ctx context.Context, neighbor *agent.BGPNeighbor) (*agent.BGPNeighbor, *agent.Status) {
+	if neighbor == nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "BGP neighbor cannot be empty")
+	}
+	if !bgpRemoteASRegexp.MatchString(neighbor.RemoteAS) {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("invalid remote AS %q, must be an AS number, external or internal", neighbor.RemoteAS))
+	}
+
+	// Unnumbered neighbors are identified by the interface they are reachable through
+	nativeName, interfaceTable, parentTable, status := m.interfaceAddressTable(neighbor.Neighbor)
+	if status != nil {
+		return nil, status
+	}
+	if parentTable == "" {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("interface %s does not support unnumbered BGP neighbors", nativeName))
+	}
+
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	exists, err := configDB.Exists(ctx, fmt.Sprintf("%s|%s", parentTable, nativeName)).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to check interface existence: %v", err))
+	}
+	if exists == 0 {
+		return nil, errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("interface %s not found", nativeName))
+	}
+
+	neighborKey := bgpNeighborKey(nativeName)
+	exists, err = configDB.Exists(ctx, neighborKey, fmt.Sprintf("BGP_NEIGHBOR|%s", nativeName)).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to check BGP neighbor existence: %v", err))
+	}
+	if exists != 0 {
+		return nil, errors.NewErrorStatus(errors.ALREADY_EXISTS, fmt.Sprintf("BGP neighbor %s already exists", nativeName))
+	}
+
+	// Unnumbered peering runs over the IPv6 link-local address of the interface
+	interfaceKey := fmt.Sprintf("%s|%s", interfaceTable, nativeName)
+	linkLocalOnly, err := configDB.HGet(ctx, interfaceKey, "ipv6_use_link_local_only").Result()
+	if err != nil && err != redis.Nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get interface %s: %v", nativeName, err))
+	}
+
+	pipe := configDB.TxPipeline()
+	if linkLocalOnly != "enable" {
+		pipe.HSet(ctx, interfaceKey, "ipv6_use_link_local_only", "enable")
+	}
+	pipe.HSet(ctx, neighborKey,
+		"asn", neighbor.RemoteAS,
+		"name", neighbor.Name,
+		"admin_status", string(agent.StatusUp),
+	)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HSET_FAIL, fmt.Sprintf("failed to add BGP neighbor: %v", err))
+	}
+
+	// Persist changes to config_db.json
+	if status := m.SaveConfig(ctx); status != nil {
+		// Try to rollback if save fails
+		_ = configDB.Del(ctx, neighborKey).Err()
+		if linkLocalOnly == "" {
+			_ = configDB.HDel(ctx, interfaceKey, "ipv6_use_link_local_only").Err()
+		} else if linkLocalOnly != "enable" {
+			_ = configDB.HSet(ctx, interfaceKey, "ipv6_use_link_local_only", linkLocalOnly).Err()
+		}
+		return nil, status
+	}
+
+	return &agent.BGPNeighbor{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.BGPNeighborKind,
+		},
+		Neighbor:   nativeName,
+		Name:       neighbor.Name,
+		RemoteAS:   neighbor.RemoteAS,
+		Configured: true,
+		Status:     agent.Status{Code: 0, Message: "ok"},
+	}, nil
+}
+
+func (m *SonicAgent) RemoveBGPNeighbor(ctx context.Context, neighbor *agent.BGPNeighbor) *agent.Status {
+	if neighbor == nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, "BGP neighbor cannot be empty")
+	}
+
+	nativeName, _, _, status := m.interfaceAddressTable(neighbor.Neighbor)
+	if status != nil {
+		return status
+	}
+
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	neighborKey := bgpNeighborKey(nativeName)
+	fields, err := configDB.HGetAll(ctx, neighborKey).Result()
+	if err != nil {
+		return errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get BGP neighbor %s: %v", nativeName, err))
+	}
+	if len(fields) == 0 {
+		return errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("BGP neighbor %s not found", nativeName))
+	}
+
+	if err := configDB.Del(ctx, neighborKey).Err(); err != nil {
+		return errors.NewErrorStatus(errors.REDIS_HSET_FAIL, fmt.Sprintf("failed to remove BGP neighbor: %v", err))
+	}
+
+	// Persist changes to config_db.json
+	if status := m.SaveConfig(ctx); status != nil {
+		// Try to rollback if save fails
+		_ = configDB.HSet(ctx, neighborKey, fields).Err()
+		return status
+	}
+
+	return nil
+}
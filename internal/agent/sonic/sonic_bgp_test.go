@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+)
+
+// fakeBGPStateSource returns fixed BGP session states.
+type fakeBGPStateSource struct {
+	states map[string]BGPPeerState
+	err    error
+}
+
+func (s *fakeBGPStateSource) BGPPeerStates(context.Context) (map[string]BGPPeerState, error) {
+	return s.states, s.err
+}
+
+var _ = Describe("BGP", func() {
+	DescribeTable("parseBGPSummary",
+		func(output string, expected map[string]BGPPeerState) {
+			states, err := parseBGPSummary([]byte(output))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(states).To(Equal(expected))
+		},
+		Entry("no address families", `{}`, map[string]BGPPeerState{}),
+		Entry("unnumbered peer",
+			`{"ipv4Unicast":{"peers":{"Ethernet0":{"remoteAs":65001,"state":"Established","peerUptime":"01:02:03","pfxRcd":12}}}}`,
+			map[string]BGPPeerState{
+				"Ethernet0": {RemoteAS: "65001", State: "Established", PrefixesReceived: 12, Uptime: "01:02:03"},
+			}),
+		Entry("prefixes summed up over address families",
+			`{"ipv4Unicast":{"peers":{"Ethernet0":{"remoteAs":65001,"state":"Established","pfxRcd":12}}},`+
+				`"ipv6Unicast":{"peers":{"Ethernet0":{"remoteAs":65001,"state":"Established","pfxRcd":3}}}}`,
+			map[string]BGPPeerState{
+				"Ethernet0": {RemoteAS: "65001", State: "Established", PrefixesReceived: 15},
+			}),
+		Entry("4-byte AS number",
+			`{"ipv4Unicast":{"peers":{"10.0.0.1":{"remoteAs":4200000001,"state":"Active"}}}}`,
+			map[string]BGPPeerState{
+				"10.0.0.1": {RemoteAS: "4200000001", State: "Active"},
+			}),
+		Entry("peer accepting any eBGP AS",
+			`{"ipv4Unicast":{"peers":{"Ethernet8":{"remoteAs":"external","state":"Connect"}}}}`,
+			map[string]BGPPeerState{
+				"Ethernet8": {RemoteAS: "external", State: "Connect"},
+			}),
+		Entry("peer without remote AS",
+			`{"ipv4Unicast":{"peers":{"Ethernet4":{"state":"Idle"}}}}`,
+			map[string]BGPPeerState{
+				"Ethernet4": {State: "Idle"},
+			}),
+	)
+
+	It("should fail to parse invalid output", func() {
+		_, err := parseBGPSummary([]byte(`% Unknown command`))
+		Expect(err).To(MatchError(ContainSubstring("failed to parse BGP summary")))
+	})
+
+	Describe("ListBGPNeighbors", func() {
+		var (
+			redis       *fakeRedis
+			stateSource *fakeBGPStateSource
+			sonicAgent  *SonicAgent
+		)
+
+		BeforeEach(func() {
+			var addr string
+			redis, addr = startFakeRedis()
+
+			var err error
+			sonicAgent, err = NewSonicRedisAgent(addr)
+			Expect(err).NotTo(HaveOccurred())
+			stateSource = &fakeBGPStateSource{}
+			sonicAgent.BGPStateSource = stateSource
+		})
+
+		It("should merge the configured neighbors with the session state", func() {
+			redis.hset("CONFIG_DB", "BGP_NEIGHBOR|default|Ethernet0", map[string]string{"asn": "65001", "name": "spine-1"})
+			redis.hset("CONFIG_DB", "BGP_NEIGHBOR|Ethernet4", map[string]string{"asn": "external"})
+			redis.hset("CONFIG_DB", "BGP_NEIGHBOR|Vrf1|Ethernet8", map[string]string{"asn": "65003"})
+			stateSource.states = map[string]BGPPeerState{
+				"Ethernet0": {RemoteAS: "65001", State: "Established", PrefixesReceived: 7, Uptime: "00:10:00"},
+				"Ethernet4": {RemoteAS: "65002", State: "Active"},
+				"10.0.0.1":  {RemoteAS: "65100", State: "Established"},
+			}
+
+			neighbors, status := sonicAgent.ListBGPNeighbors(context.Background())
+			Expect(status).To(BeNil())
+			Expect(neighbors.Items).To(Equal([]agent.BGPNeighbor{
+				{
+					TypeMeta: agent.TypeMeta{Kind: agent.BGPNeighborKind},
+					Neighbor: "10.0.0.1",
+					RemoteAS: "65100",
+					State:    "Established",
+				},
+				{
+					TypeMeta:         agent.TypeMeta{Kind: agent.BGPNeighborKind},
+					Neighbor:         "Ethernet0",
+					Name:             "spine-1",
+					RemoteAS:         "65001",
+					State:            "Established",
+					PrefixesReceived: 7,
+					Uptime:           "00:10:00",
+					Configured:       true,
+				},
+				{
+					TypeMeta:   agent.TypeMeta{Kind: agent.BGPNeighborKind},
+					Neighbor:   "Ethernet4",
+					RemoteAS:   "external",
+					State:      "Active",
+					Configured: true,
+				},
+			}))
+		})
+
+		It("should report configured neighbors without a session", func() {
+			redis.hset("CONFIG_DB", "BGP_NEIGHBOR|default|Ethernet0", map[string]string{"asn": "65001"})
+
+			neighbors, status := sonicAgent.ListBGPNeighbors(context.Background())
+			Expect(status).To(BeNil())
+			Expect(neighbors.Items).To(ConsistOf(agent.BGPNeighbor{
+				TypeMeta:   agent.TypeMeta{Kind: agent.BGPNeighborKind},
+				Neighbor:   "Ethernet0",
+				RemoteAS:   "65001",
+				Configured: true,
+			}))
+		})
+
+		It("should fail if the session state cannot be read", func() {
+			stateSource.err = fmt.Errorf("vtysh not found")
+
+			_, status := sonicAgent.ListBGPNeighbors(context.Background())
+			Expect(status).NotTo(BeNil())
+			Expect(status.Code).To(Equal(uint32(errors.SERVER_ERROR)))
+			Expect(status.Message).To(ContainSubstring("vtysh not found"))
+		})
+	})
+})
@@ -0,0 +1,347 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	errors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// SonicDeviceDir is the directory holding the platform and HWSKU definitions.
+var SonicDeviceDir = "/usr/share/sonic/device"
+
+// breakoutModeRegexp matches uniform breakout modes like 1x100G[40G] or 4x25G[10G].
+var breakoutModeRegexp = regexp.MustCompile(`^(\d+)x(\d+)G(\[[0-9G,]+\])?$`)
+
+type platformInterface struct {
+	Index         string              `json:"index"`
+	Lanes         string              `json:"lanes"`
+	BreakoutModes map[string][]string `json:"breakout_modes"`
+}
+
+type platformJSON struct {
+	Interfaces map[string]platformInterface `json:"interfaces"`
+}
+
+type hwskuInterface struct {
+	DefaultBreakoutMode string `json:"default_brkout_mode"`
+}
+
+type hwskuJSON struct {
+	Interfaces map[string]hwskuInterface `json:"interfaces"`
+}
+
+// breakoutPort is a port resulting from breaking out a parent port.
+type breakoutPort struct {
+	Name  string
+	Alias string
+	Index string
+	Lanes string
+	Speed string
+}
+
+// loadBreakoutDefinitions reads platform.json and hwsku.json of the running platform.
+func (m *SonicAgent) loadBreakoutDefinitions(ctx context.Context, configDB *redis.Client) (*platformJSON, *hwskuJSON, *agent.Status) {
+	metadata, err := configDB.HGetAll(ctx, "DEVICE_METADATA|localhost").Result()
+	if err != nil {
+		return nil, nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get device metadata: %v", err))
+	}
+	platform, hwsku := metadata["platform"], metadata["hwsku"]
+	if platform == "" || hwsku == "" {
+		return nil, nil, errors.NewErrorStatus(errors.NOT_FOUND, "platform or hwsku missing in device metadata")
+	}
+
+	platformDef := &platformJSON{}
+	if err := readJSONFile(filepath.Join(SonicDeviceDir, platform, "platform.json"), platformDef); err != nil {
+		return nil, nil, errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("failed to read platform.json: %v", err))
+	}
+
+	hwskuDef := &hwskuJSON{}
+	if err := readJSONFile(filepath.Join(SonicDeviceDir, platform, hwsku, "hwsku.json"), hwskuDef); err != nil {
+		return nil, nil, errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("failed to read hwsku.json: %v", err))
+	}
+
+	return platformDef, hwskuDef, nil
+}
+
+func readJSONFile(path string, v any) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(content, v)
+}
+
+// breakoutPorts computes the ports resulting from breaking out the given
+// parent port in the given mode. The lanes of the parent port are split
+// evenly across the resulting ports.
+func breakoutPorts(parent string, def platformInterface, mode string) ([]breakoutPort, error) {
+	aliases, ok := def.BreakoutModes[mode]
+	if !ok {
+		return nil, fmt.Errorf("breakout mode %s is not supported by port %s", mode, parent)
+	}
+
+	match := breakoutModeRegexp.FindStringSubmatch(mode)
+	if match == nil {
+		return nil, fmt.Errorf("breakout mode %s is not supported, only uniform modes like 4x25G are", mode)
+	}
+	count, _ := strconv.Atoi(match[1])
+	speed, _ := strconv.Atoi(match[2])
+
+	lanes := strings.Split(def.Lanes, ",")
+	indices := strings.Split(def.Index, ",")
+	if count == 0 || len(lanes)%count != 0 || len(aliases) != count {
+		return nil, fmt.Errorf("breakout mode %s does not match the %d lanes of port %s", mode, len(lanes), parent)
+	}
+
+	base, err := strconv.Atoi(strings.TrimPrefix(parent, "Ethernet"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse port number of %s: %w", parent, err)
+	}
+
+	lanesPerPort := len(lanes) / count
+	ports := make([]breakoutPort, 0, count)
+	for i := range count {
+		index := ""
+		if i*lanesPerPort < len(indices) {
+			index = indices[i*lanesPerPort]
+		}
+		ports = append(ports, breakoutPort{
+			Name:  fmt.Sprintf("Ethernet%d", base+i*lanesPerPort),
+			Alias: aliases[i],
+			Index: index,
+			Lanes: strings.Join(lanes[i*lanesPerPort:(i+1)*lanesPerPort], ","),
+			Speed: strconv.Itoa(speed * 1000),
+		})
+	}
+	return ports, nil
+}
+
+// currentBreakoutPorts returns the names of the ports in CONFIG_DB occupying
+// the lanes of the given parent port.
+func currentBreakoutPorts(ctx context.Context, configDB *redis.Client, parent string, def platformInterface) ([]string, error) {
+	base, err := strconv.Atoi(strings.TrimPrefix(parent, "Ethernet"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse port number of %s: %w", parent, err)
+	}
+	numLanes := len(strings.Split(def.Lanes, ","))
+
+	var ports []string
+	for i := range numLanes {
+		name := fmt.Sprintf("Ethernet%d", base+i)
+		exists, err := configDB.Exists(ctx, fmt.Sprintf("PORT|%s", name)).Result()
+		if err != nil {
+			return nil, err
+		}
+		if exists != 0 {
+			ports = append(ports, name)
+		}
+	}
+	return ports, nil
+}
+
+func (m *SonicAgent) getPortBreakout(ctx context.Context, configDB *redis.Client, port string, platformDef *platformJSON, hwskuDef *hwskuJSON) (*agent.PortBreakout, *agent.Status) {
+	def, ok := platformDef.Interfaces[port]
+	if !ok {
+		return nil, errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("port %s not found in platform.json", port))
+	}
+
+	mode, err := configDB.HGet(ctx, fmt.Sprintf("BREAKOUT_CFG|%s", port), "brkout_mode").Result()
+	if err != nil && err != redis.Nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get breakout mode of port %s: %v", port, err))
+	}
+	defaultMode := hwskuDef.Interfaces[port].DefaultBreakoutMode
+	if mode == "" {
+		mode = defaultMode
+	}
+
+	supportedModes := make([]string, 0, len(def.BreakoutModes))
+	for supportedMode := range def.BreakoutModes {
+		supportedModes = append(supportedModes, supportedMode)
+	}
+	sort.Strings(supportedModes)
+
+	ports, err := currentBreakoutPorts(ctx, configDB, port, def)
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to get ports of %s: %v", port, err))
+	}
+
+	return &agent.PortBreakout{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortBreakoutKind,
+		},
+		Port:           port,
+		Mode:           mode,
+		DefaultMode:    defaultMode,
+		SupportedModes: supportedModes,
+		Ports:          ports,
+		Status:         agent.Status{Code: 0, Message: "ok"},
+	}, nil
+}
+
+func (m *SonicAgent) ListPortBreakouts(ctx context.Context) (*agent.PortBreakoutList, *agent.Status) {
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	platformDef, hwskuDef, status := m.loadBreakoutDefinitions(ctx, configDB)
+	if status != nil {
+		return nil, status
+	}
+
+	ports := make([]string, 0, len(platformDef.Interfaces))
+	for port := range platformDef.Interfaces {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool {
+		numI, _ := strconv.Atoi(strings.TrimPrefix(ports[i], "Ethernet"))
+		numJ, _ := strconv.Atoi(strings.TrimPrefix(ports[j], "Ethernet"))
+		return numI < numJ
+	})
+
+	breakouts := make([]agent.PortBreakout, 0, len(ports))
+	for _, port := range ports {
+		breakout, status := m.getPortBreakout(ctx, configDB, port, platformDef, hwskuDef)
+		if status != nil {
+			return nil, status
+		}
+		breakouts = append(breakouts, *breakout)
+	}
+
+	return &agent.PortBreakoutList{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortBreakoutListKind,
+		},
+		Items:  breakouts,
+		Status: agent.Status{Code: 0, Message: "ok"},
+	}, nil
+}
+
+func (m *SonicAgent) SetPortBreakout(ctx context.Context, breakout *agent.PortBreakout) (*agent.PortBreakout, *agent.Status) {
+	if breakout == nil || breakout.Port == "" {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "port cannot be empty")
+	}
+	if breakout.Mode == "" {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "breakout mode cannot be empty")
+	}
+
+	port, status := m.resolveNativeInterfaceName(breakout.Port)
+	if status != nil {
+		return nil, status
+	}
+
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	platformDef, hwskuDef, status := m.loadBreakoutDefinitions(ctx, configDB)
+	if status != nil {
+		return nil, status
+	}
+
+	current, status := m.getPortBreakout(ctx, configDB, port, platformDef, hwskuDef)
+	if status != nil {
+		return nil, status
+	}
+	if current.Mode == breakout.Mode {
+		return current, nil
+	}
+
+	newPorts, err := breakoutPorts(port, platformDef.Interfaces[port], breakout.Mode)
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, err.Error())
+	}
+
+	// The ports going away must not carry any configuration depending on them
+	oldPorts := make(map[string]map[string]string, len(current.Ports))
+	for _, name := range current.Ports {
+		for _, pattern := range []string{
+			fmt.Sprintf("VLAN_MEMBER|*|%s", name),
+			fmt.Sprintf("PORTCHANNEL_MEMBER|*|%s", name),
+			fmt.Sprintf("INTERFACE|%s", name),
+			fmt.Sprintf("INTERFACE|%s|*", name),
+		} {
+			keys, err := configDB.Keys(ctx, pattern).Result()
+			if err != nil {
+				return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to obtain keys for %s: %v", pattern, err))
+			}
+			if len(keys) > 0 {
+				return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("port %s is still in use (%s), remove the configuration first", name, keys[0]))
+			}
+		}
+
+		fields, err := configDB.HGetAll(ctx, fmt.Sprintf("PORT|%s", name)).Result()
+		if err != nil {
+			return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get port %s: %v", name, err))
+		}
+		oldPorts[name] = fields
+	}
+
+	// The new ports inherit the admin status and mtu of the parent port
+	parentFields := oldPorts[port]
+	breakoutKey := fmt.Sprintf("BREAKOUT_CFG|%s", port)
+	previousMode, _ := configDB.HGet(ctx, breakoutKey, "brkout_mode").Result()
+
+	pipe := configDB.TxPipeline()
+	for name := range oldPorts {
+		pipe.Del(ctx, fmt.Sprintf("PORT|%s", name))
+	}
+	for _, p := range newPorts {
+		values := []any{
+			"alias", p.Alias,
+			"lanes", p.Lanes,
+			"speed", p.Speed,
+			"admin_status", valueOrDefault(parentFields["admin_status"], string(agent.StatusDown)),
+			"mtu", valueOrDefault(parentFields["mtu"], PortDefaultMTU),
+		}
+		if p.Index != "" {
+			values = append(values, "index", p.Index)
+		}
+		pipe.HSet(ctx, fmt.Sprintf("PORT|%s", p.Name), values...)
+	}
+	pipe.HSet(ctx, breakoutKey, "brkout_mode", breakout.Mode)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HSET_FAIL, fmt.Sprintf("failed to apply breakout mode: %v", err))
+	}
+
+	// Persist changes to config_db.json
+	if status := m.SaveConfig(ctx); status != nil {
+		// Try to rollback if save fails
+		for _, p := range newPorts {
+			_ = configDB.Del(ctx, fmt.Sprintf("PORT|%s", p.Name)).Err()
+		}
+		for name, fields := range oldPorts {
+			_ = configDB.HSet(ctx, fmt.Sprintf("PORT|%s", name), fields).Err()
+		}
+		if previousMode != "" {
+			_ = configDB.HSet(ctx, breakoutKey, "brkout_mode", previousMode).Err()
+		} else {
+			_ = configDB.Del(ctx, breakoutKey).Err()
+		}
+		return nil, status
+	}
+
+	return m.getPortBreakout(ctx, configDB, port, platformDef, hwskuDef)
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
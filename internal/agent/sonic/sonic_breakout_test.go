@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+)
+
+const testPlatformJSON = `{
+  "interfaces": {
+    "Ethernet0": {
+      "index": "1,1,1,1",
+      "lanes": "1,2,3,4",
+      "breakout_modes": {
+        "1x100G[40G]": ["Eth1"],
+        "2x50G": ["Eth1/1", "Eth1/3"],
+        "4x25G[10G]": ["Eth1/1", "Eth1/2", "Eth1/3", "Eth1/4"],
+        "1x50G(2)+2x25G(2)": ["Eth1/1", "Eth1/3", "Eth1/4"]
+      }
+    },
+    "Ethernet4": {
+      "index": "2,2,2,2",
+      "lanes": "5,6,7,8",
+      "breakout_modes": {
+        "1x100G[40G]": ["Eth2"]
+      }
+    }
+  }
+}`
+
+const testHWSKUJSON = `{
+  "interfaces": {
+    "Ethernet0": {"default_brkout_mode": "1x100G[40G]"},
+    "Ethernet4": {"default_brkout_mode": "1x100G[40G]"}
+  }
+}`
+
+var _ = Describe("Port breakout", func() {
+	DescribeTable("breakoutPorts",
+		func(mode string, expected []breakoutPort) {
+			var def platformJSON
+			Expect(json.Unmarshal([]byte(testPlatformJSON), &def)).To(Succeed())
+
+			ports, err := breakoutPorts("Ethernet0", def.Interfaces["Ethernet0"], mode)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ports).To(Equal(expected))
+		},
+		Entry("no breakout", "1x100G[40G]", []breakoutPort{
+			{Name: "Ethernet0", Alias: "Eth1", Index: "1", Lanes: "1,2,3,4", Speed: "100000"},
+		}),
+		Entry("two ports", "2x50G", []breakoutPort{
+			{Name: "Ethernet0", Alias: "Eth1/1", Index: "1", Lanes: "1,2", Speed: "50000"},
+			{Name: "Ethernet2", Alias: "Eth1/3", Index: "1", Lanes: "3,4", Speed: "50000"},
+		}),
+		Entry("four ports", "4x25G[10G]", []breakoutPort{
+			{Name: "Ethernet0", Alias: "Eth1/1", Index: "1", Lanes: "1", Speed: "25000"},
+			{Name: "Ethernet1", Alias: "Eth1/2", Index: "1", Lanes: "2", Speed: "25000"},
+			{Name: "Ethernet2", Alias: "Eth1/3", Index: "1", Lanes: "3", Speed: "25000"},
+			{Name: "Ethernet3", Alias: "Eth1/4", Index: "1", Lanes: "4", Speed: "25000"},
+		}),
+	)
+
+	DescribeTable("breakoutPorts rejecting modes",
+		func(def platformInterface, mode, message string) {
+			_, err := breakoutPorts("Ethernet0", def, mode)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("mode missing in platform.json",
+			platformInterface{Lanes: "1,2,3,4", BreakoutModes: map[string][]string{"1x100G[40G]": {"Eth1"}}},
+			"4x25G[10G]", "breakout mode 4x25G[10G] is not supported by port Ethernet0"),
+		Entry("mixed mode",
+			platformInterface{Lanes: "1,2,3,4", BreakoutModes: map[string][]string{"1x50G(2)+2x25G(2)": {"Eth1/1", "Eth1/3", "Eth1/4"}}},
+			"1x50G(2)+2x25G(2)", "only uniform modes"),
+		Entry("lanes not divisible by the port count",
+			platformInterface{Lanes: "1,2,3", BreakoutModes: map[string][]string{"2x50G": {"Eth1/1", "Eth1/2"}}},
+			"2x50G", "does not match the 3 lanes"),
+		Entry("alias count not matching the port count",
+			platformInterface{Lanes: "1,2,3,4", BreakoutModes: map[string][]string{"2x50G": {"Eth1/1"}}},
+			"2x50G", "does not match the 4 lanes"),
+	)
+
+	Context("with a platform", func() {
+		var (
+			redis      *fakeRedis
+			sonicAgent *SonicAgent
+		)
+
+		BeforeEach(func() {
+			var addr string
+			redis, addr = startFakeRedis()
+			redis.hset("CONFIG_DB", "DEVICE_METADATA|localhost", map[string]string{"platform": "x86_64-test", "hwsku": "Test-32X"})
+			redis.hset("CONFIG_DB", "PORT|Ethernet0", map[string]string{"alias": "Eth1/1", "lanes": "1,2", "speed": "50000"})
+			redis.hset("CONFIG_DB", "PORT|Ethernet2", map[string]string{"alias": "Eth1/3", "lanes": "3,4", "speed": "50000"})
+			redis.hset("CONFIG_DB", "BREAKOUT_CFG|Ethernet0", map[string]string{"brkout_mode": "2x50G"})
+			redis.hset("CONFIG_DB", "PORT|Ethernet4", map[string]string{"alias": "Eth2", "lanes": "5,6,7,8", "speed": "100000"})
+
+			deviceDir := GinkgoT().TempDir()
+			hwskuDir := filepath.Join(deviceDir, "x86_64-test", "Test-32X")
+			Expect(os.MkdirAll(hwskuDir, 0o755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(deviceDir, "x86_64-test", "platform.json"), []byte(testPlatformJSON), 0o644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(hwskuDir, "hwsku.json"), []byte(testHWSKUJSON), 0o644)).To(Succeed())
+			previousDeviceDir := SonicDeviceDir
+			SonicDeviceDir = deviceDir
+			DeferCleanup(func() {
+				SonicDeviceDir = previousDeviceDir
+			})
+
+			var err error
+			sonicAgent, err = NewSonicRedisAgent(addr)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should list the breakout of each port of platform.json", func() {
+			list, status := sonicAgent.ListPortBreakouts(context.Background())
+			Expect(status).To(BeNil())
+			Expect(list.Items).To(HaveLen(2))
+
+			Expect(list.Items[0].Port).To(Equal("Ethernet0"))
+			Expect(list.Items[0].Mode).To(Equal("2x50G"))
+			Expect(list.Items[0].DefaultMode).To(Equal("1x100G[40G]"))
+			Expect(list.Items[0].SupportedModes).To(Equal([]string{"1x100G[40G]", "1x50G(2)+2x25G(2)", "2x50G", "4x25G[10G]"}))
+			Expect(list.Items[0].Ports).To(Equal([]string{"Ethernet0", "Ethernet2"}))
+
+			By("falling back to the default mode of the HWSKU")
+			Expect(list.Items[1].Port).To(Equal("Ethernet4"))
+			Expect(list.Items[1].Mode).To(Equal("1x100G[40G]"))
+			Expect(list.Items[1].Ports).To(Equal([]string{"Ethernet4"}))
+		})
+
+		It("should not change a port already in the requested mode", func() {
+			breakout, status := sonicAgent.SetPortBreakout(context.Background(), &agent.PortBreakout{Port: "Ethernet0", Mode: "2x50G"})
+			Expect(status).To(BeNil())
+			Expect(breakout.Mode).To(Equal("2x50G"))
+			Expect(redis.writeCount()).To(BeZero())
+		})
+
+		DescribeTable("should reject breakouts without changing the ports",
+			func(port, mode string, code int, message string) {
+				_, status := sonicAgent.SetPortBreakout(context.Background(), &agent.PortBreakout{Port: port, Mode: mode})
+				Expect(status).NotTo(BeNil())
+				Expect(status.Code).To(BeEquivalentTo(code))
+				Expect(status.Message).To(ContainSubstring(message))
+				Expect(redis.writeCount()).To(BeZero())
+			},
+			Entry("mode not supported by the port", "Ethernet4", "4x25G[10G]", errors.BAD_REQUEST, "breakout mode 4x25G[10G] is not supported by port Ethernet4"),
+			Entry("mixed mode", "Ethernet0", "1x50G(2)+2x25G(2)", errors.BAD_REQUEST, "only uniform modes"),
+			Entry("port missing in platform.json", "Ethernet8", "1x100G[40G]", errors.NOT_FOUND, "port Ethernet8 not found in platform.json"),
+		)
+
+		It("should reject breaking out a port that is still in use", func() {
+			redis.hset("CONFIG_DB", "VLAN_MEMBER|Vlan10|Ethernet2", map[string]string{"tagging_mode": "untagged"})
+
+			_, status := sonicAgent.SetPortBreakout(context.Background(), &agent.PortBreakout{Port: "Ethernet0", Mode: "1x100G[40G]"})
+			Expect(status).NotTo(BeNil())
+			Expect(status.Message).To(ContainSubstring("port Ethernet2 is still in use (VLAN_MEMBER|Vlan10|Ethernet2)"))
+			Expect(redis.get("CONFIG_DB", "PORT|Ethernet2")).To(HaveKeyWithValue("lanes", "3,4"))
+		})
+
+		It("should report a missing platform.json", func() {
+			Expect(os.Remove(filepath.Join(SonicDeviceDir, "x86_64-test", "platform.json"))).To(Succeed())
+
+			_, status := sonicAgent.ListPortBreakouts(context.Background())
+			Expect(status).NotTo(BeNil())
+			Expect(status.Code).To(BeEquivalentTo(errors.NOT_FOUND))
+			Expect(status.Message).To(ContainSubstring("failed to read platform.json"))
+		})
+	})
+})
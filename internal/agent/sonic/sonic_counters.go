@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	errors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// counterFields maps the SAI port statistics to the counters they are summed into.
+var counterFields = map[string]func(c *agent.InterfaceCounters) *uint64{
+	"SAI_PORT_STAT_IF_IN_OCTETS":          func(c *agent.InterfaceCounters) *uint64 { return &c.RxOctets },
+	"SAI_PORT_STAT_IF_IN_UCAST_PKTS":      func(c *agent.InterfaceCounters) *uint64 { return &c.RxPackets },
+	"SAI_PORT_STAT_IF_IN_NON_UCAST_PKTS":  func(c *agent.InterfaceCounters) *uint64 { return &c.RxPackets },
+	"SAI_PORT_STAT_IF_IN_ERRORS":          func(c *agent.InterfaceCounters) *uint64 { return &c.RxErrors },
+	"SAI_PORT_STAT_IF_IN_DISCARDS":        func(c *agent.InterfaceCounters) *uint64 { return &c.RxDiscards },
+	"SAI_PORT_STAT_IF_OUT_OCTETS":         func(c *agent.InterfaceCounters) *uint64 { return &c.TxOctets },
+	"SAI_PORT_STAT_IF_OUT_UCAST_PKTS":     func(c *agent.InterfaceCounters) *uint64 { return &c.TxPackets },
+	"SAI_PORT_STAT_IF_OUT_NON_UCAST_PKTS": func(c *agent.InterfaceCounters) *uint64 { return &c.TxPackets },
+	"SAI_PORT_STAT_IF_OUT_ERRORS":         func(c *agent.InterfaceCounters) *uint64 { return &c.TxErrors },
+	"SAI_PORT_STAT_IF_OUT_DISCARDS":       func(c *agent.InterfaceCounters) *uint64 { return &c.TxDiscards },
+}
+
+// getInterfaceCounters reads the counters of the port with the given OID from COUNTERS_DB.
+func getInterfaceCounters(ctx context.Context, countersDB *redis.Client, name, oid string) (*agent.InterfaceCounters, *agent.Status) {
+	fields, err := countersDB.HGetAll(ctx, fmt.Sprintf("COUNTERS:%s", oid)).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get counters of interface %s: %v", name, err))
+	}
+
+	counters := &agent.InterfaceCounters{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceCountersKind,
+		},
+		Interface: name,
+		Status:    agent.Status{Code: 0, Message: "ok"},
+	}
+	for field, counter := range counterFields {
+		value, err := strconv.ParseUint(fields[field], 10, 64)
+		if err != nil {
+			continue // Skip counters not supported by the platform
+		}
+		*counter(counters) += value
+	}
+	return counters, nil
+}
+
+func (m *SonicAgent) GetInterfaceCounters(ctx context.Context, iface *agent.Interface) (*agent.InterfaceCounters, *agent.Status) {
+	if iface == nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "interface cannot be empty")
+	}
+
+	nativeName, status := m.resolveNativeInterfaceName(iface.Name)
+	if status != nil {
+		return nil, status
+	}
+
+	countersDB, err := m.Connect("COUNTERS_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to COUNTERS_DB: %v", err))
+	}
+
+	oid, err := countersDB.HGet(ctx, "COUNTERS_PORT_NAME_MAP", nativeName).Result()
+	if err == redis.Nil {
+		return nil, errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("no counters found for interface %s", nativeName))
+	}
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to resolve counters of interface %s: %v", nativeName, err))
+	}
+
+	return getInterfaceCounters(ctx, countersDB, nativeName, oid)
+}
+
+func (m *SonicAgent) ListInterfaceCounters(ctx context.Context) (*agent.InterfaceCountersList, *agent.Status) {
+	countersDB, err := m.Connect("COUNTERS_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to COUNTERS_DB: %v", err))
+	}
+
+	nameMap, err := countersDB.HGetAll(ctx, "COUNTERS_PORT_NAME_MAP").Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get COUNTERS_PORT_NAME_MAP: %v", err))
+	}
+
+	names := make([]string, 0, len(nameMap))
+	for name := range nameMap {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		numI, _ := strconv.Atoi(strings.TrimPrefix(names[i], "Ethernet"))
+		numJ, _ := strconv.Atoi(strings.TrimPrefix(names[j], "Ethernet"))
+		return numI < numJ
+	})
+
+	items := make([]agent.InterfaceCounters, 0, len(names))
+	for _, name := range names {
+		counters, status := getInterfaceCounters(ctx, countersDB, name, nameMap[name])
+		if status != nil {
+			return nil, status
+		}
+		items = append(items, *counters)
+	}
+
+	return &agent.InterfaceCountersList{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceCountersListKind,
+		},
+		Items:  items,
+		Status: agent.Status{Code: 0, Message: "ok"},
+	}, nil
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+)
+
+var _ = Describe("Interface counters", func() {
+	var sonicAgent *SonicAgent
+
+	BeforeEach(func() {
+		redis, addr := startFakeRedis()
+		redis.hset("COUNTERS_DB", "COUNTERS_PORT_NAME_MAP", map[string]string{
+			"Ethernet0":  "oid:0x1000000000002",
+			"Ethernet12": "oid:0x1000000000004",
+			"Ethernet4":  "oid:0x1000000000003",
+		})
+		redis.hset("COUNTERS_DB", "COUNTERS:oid:0x1000000000002", map[string]string{
+			"SAI_PORT_STAT_IF_IN_OCTETS":          "1000",
+			"SAI_PORT_STAT_IF_IN_UCAST_PKTS":      "10",
+			"SAI_PORT_STAT_IF_IN_NON_UCAST_PKTS":  "5",
+			"SAI_PORT_STAT_IF_IN_ERRORS":          "1",
+			"SAI_PORT_STAT_IF_IN_DISCARDS":        "2",
+			"SAI_PORT_STAT_IF_OUT_OCTETS":         "2000",
+			"SAI_PORT_STAT_IF_OUT_UCAST_PKTS":     "20",
+			"SAI_PORT_STAT_IF_OUT_NON_UCAST_PKTS": "7",
+			"SAI_PORT_STAT_IF_OUT_ERRORS":         "3",
+			"SAI_PORT_STAT_IF_OUT_DISCARDS":       "4",
+			"SAI_PORT_STAT_ETHER_STATS_JABBERS":   "9",
+		})
+		// a platform supporting only some counters, one of them unreadable
+		redis.hset("COUNTERS_DB", "COUNTERS:oid:0x1000000000003", map[string]string{
+			"SAI_PORT_STAT_IF_IN_OCTETS":  "18446744073709551615",
+			"SAI_PORT_STAT_IF_OUT_OCTETS": "n/a",
+		})
+
+		var err error
+		sonicAgent, err = NewSonicRedisAgent(addr)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should sum the SAI port statistics into the counters", func() {
+		counters, status := sonicAgent.GetInterfaceCounters(context.Background(), &agent.Interface{Name: "Ethernet0"})
+		Expect(status).To(BeNil())
+		Expect(counters.Interface).To(Equal("Ethernet0"))
+		Expect(counters.RxOctets).To(BeEquivalentTo(1000))
+		Expect(counters.RxPackets).To(BeEquivalentTo(15))
+		Expect(counters.RxErrors).To(BeEquivalentTo(1))
+		Expect(counters.RxDiscards).To(BeEquivalentTo(2))
+		Expect(counters.TxOctets).To(BeEquivalentTo(2000))
+		Expect(counters.TxPackets).To(BeEquivalentTo(27))
+		Expect(counters.TxErrors).To(BeEquivalentTo(3))
+		Expect(counters.TxDiscards).To(BeEquivalentTo(4))
+	})
+
+	It("should resolve abstract names and skip counters the platform does not provide", func() {
+		counters, status := sonicAgent.GetInterfaceCounters(context.Background(), &agent.Interface{Name: "eth1-0"})
+		Expect(status).To(BeNil())
+		Expect(counters.Interface).To(Equal("Ethernet4"))
+		Expect(counters.RxOctets).To(Equal(uint64(18446744073709551615)))
+		Expect(counters.TxOctets).To(BeZero())
+		Expect(counters.RxPackets).To(BeZero())
+	})
+
+	It("should report interfaces without counters as not found", func() {
+		_, status := sonicAgent.GetInterfaceCounters(context.Background(), &agent.Interface{Name: "Ethernet8"})
+		Expect(status).NotTo(BeNil())
+		Expect(status.Code).To(BeEquivalentTo(errors.NOT_FOUND))
+	})
+
+	It("should list the counters of all ports in port order", func() {
+		list, status := sonicAgent.ListInterfaceCounters(context.Background())
+		Expect(status).To(BeNil())
+		Expect(list.Items).To(HaveLen(3))
+		Expect(list.Items[0].Interface).To(Equal("Ethernet0"))
+		Expect(list.Items[1].Interface).To(Equal("Ethernet4"))
+		Expect(list.Items[2].Interface).To(Equal("Ethernet12"))
+		Expect(list.Items[0].TxPackets).To(BeEquivalentTo(27))
+
+		By("reporting ports without statistics with zero counters")
+		Expect(list.Items[2].RxOctets).To(BeZero())
+	})
+})
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	errors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+)
+
+// LoadNameMapper sets the NameMapper of the agent for the platform and HWSKU of the switch. An explicit
+// table for the HWSKU takes precedence over the lane data of port_config.ini and platform.json, which
+// is only used if derive is set. If neither is available, agent.DefaultNameMapper is kept, also on
+// errors. It returns a description of the chosen source.
+func (m *SonicAgent) LoadNameMapper(ctx context.Context, tables agent.NameTables, derive bool) (string, error) {
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to CONFIG_DB: %w", err)
+	}
+	metadata, err := configDB.HGetAll(ctx, "DEVICE_METADATA|localhost").Result()
+	if err != nil {
+		return "", fmt.Errorf("failed to get device metadata: %w", err)
+	}
+	platform, hwsku := metadata["platform"], metadata["hwsku"]
+
+	if table, ok := tables[hwsku]; ok {
+		mapper, err := agent.NewTableNameMapper(table)
+		if err != nil {
+			return "", fmt.Errorf("invalid name table of %s: %w", hwsku, err)
+		}
+		m.NameMapper = mapper
+		return fmt.Sprintf("name table of %s", hwsku), nil
+	}
+
+	if !derive || platform == "" || hwsku == "" {
+		return "the default 4-lane scheme", nil
+	}
+
+	var ports []agent.PortLanes
+	var sources []string
+	portConfigPath := filepath.Join(SonicDeviceDir, platform, hwsku, "port_config.ini")
+	if f, err := os.Open(portConfigPath); err == nil {
+		defer func() {
+			_ = f.Close()
+		}()
+		portConfig, err := agent.ParsePortConfig(f)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse %s: %w", portConfigPath, err)
+		}
+		ports = append(ports, portConfig...)
+		sources = append(sources, portConfigPath)
+	}
+
+	platformDef, hwskuDef, status := m.loadBreakoutDefinitions(ctx, configDB)
+	if status == nil {
+		ports = append(ports, breakoutPortLanes(platformDef, hwskuDef)...)
+		sources = append(sources, "platform.json")
+	}
+
+	if len(ports) == 0 {
+		return "the default 4-lane scheme", nil
+	}
+	mapper, err := agent.NewLaneNameMapper(uniquePortLanes(ports))
+	if err != nil {
+		return "", fmt.Errorf("failed to derive interface names from %s: %w", strings.Join(sources, ", "), err)
+	}
+	m.NameMapper = mapper
+	return strings.Join(sources, ", "), nil
+}
+
+// breakoutPortLanes returns the lane data of the ports of every supported breakout mode, so that
+// the names stay resolvable after a breakout.
+func breakoutPortLanes(platformDef *platformJSON, hwskuDef *hwskuJSON) []agent.PortLanes {
+	var ports []agent.PortLanes
+	for parent, def := range platformDef.Interfaces {
+		modes := []string{hwskuDef.Interfaces[parent].DefaultBreakoutMode}
+		for mode := range def.BreakoutModes {
+			modes = append(modes, mode)
+		}
+		for _, mode := range modes {
+			children, err := breakoutPorts(parent, def, mode)
+			if err != nil {
+				continue
+			}
+			for _, child := range children {
+				p, err := portLanes(child)
+				if err != nil {
+					continue
+				}
+				ports = append(ports, p)
+			}
+		}
+	}
+	return ports
+}
+
+func portLanes(p breakoutPort) (agent.PortLanes, error) {
+	index, err := strconv.Atoi(p.Index)
+	if err != nil {
+		return agent.PortLanes{}, err
+	}
+	port := agent.PortLanes{Name: p.Name, Alias: p.Alias, Index: index}
+	for _, lane := range strings.Split(p.Lanes, ",") {
+		n, err := strconv.Atoi(lane)
+		if err != nil {
+			return agent.PortLanes{}, err
+		}
+		port.Lanes = append(port.Lanes, n)
+	}
+	return port, nil
+}
+
+// uniquePortLanes keeps the first definition of every port.
+func uniquePortLanes(ports []agent.PortLanes) []agent.PortLanes {
+	seen := make(map[string]bool, len(ports))
+	unique := make([]agent.PortLanes, 0, len(ports))
+	for _, p := range ports {
+		if seen[p.Name] {
+			continue
+		}
+		seen[p.Name] = true
+		unique = append(unique, p)
+	}
+	return unique
+}
+
+// resolveNativeInterfaceName accepts either a native (Ethernet0) or an abstract
+// (eth0-0) interface name and returns the native name used in the SONiC databases.
+func (m *SonicAgent) resolveNativeInterfaceName(name string) (string, *agent.Status) {
+	if name == "" {
+		return "", errors.NewErrorStatus(errors.BAD_REQUEST, "interface name cannot be empty")
+	}
+	if nativeName, err := m.NameMapper.AbstractToNative(name); err == nil {
+		return nativeName, nil
+	}
+	if _, err := m.NameMapper.NativeToAbstract(name); err != nil {
+		return "", errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("unknown interface name %s: %v", name, err))
+	}
+	return name, nil
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+)
+
+var _ = Describe("LoadNameMapper", func() {
+	var sonicAgent *SonicAgent
+
+	BeforeEach(func() {
+		redis, addr := startFakeRedis()
+		redis.hset("CONFIG_DB", "DEVICE_METADATA|localhost", map[string]string{"platform": "x86_64-test", "hwsku": "Test-32X"})
+
+		deviceDir := GinkgoT().TempDir()
+		hwskuDir := filepath.Join(deviceDir, "x86_64-test", "Test-32X")
+		Expect(os.MkdirAll(hwskuDir, 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(hwskuDir, "port_config.ini"), []byte(
+			"# name lanes alias index\n"+
+				"Ethernet0 1,2,3,4 Eth1 1\n"+
+				"Ethernet4 5,6,7,8 Eth2 2\n"), 0o644)).To(Succeed())
+		previousDeviceDir := SonicDeviceDir
+		SonicDeviceDir = deviceDir
+		DeferCleanup(func() {
+			SonicDeviceDir = previousDeviceDir
+		})
+
+		var err error
+		sonicAgent, err = NewSonicRedisAgent(addr)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should keep the default scheme unless deriving the names is enabled", func() {
+		source, err := sonicAgent.LoadNameMapper(context.Background(), nil, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(source).To(Equal("the default 4-lane scheme"))
+		Expect(sonicAgent.NameMapper.NativeToAbstract("Ethernet4")).To(Equal("eth1-0"))
+	})
+
+	It("should derive the names from the lane data of the platform", func() {
+		source, err := sonicAgent.LoadNameMapper(context.Background(), nil, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(source).To(ContainSubstring("port_config.ini"))
+		Expect(sonicAgent.NameMapper.NativeToAbstract("Ethernet4")).To(Equal("eth2-0"))
+	})
+
+	It("should prefer the name table of the HWSKU", func() {
+		tables := agent.NameTables{"Test-32X": {"Ethernet4": "eth7-0"}}
+		source, err := sonicAgent.LoadNameMapper(context.Background(), tables, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(source).To(Equal("name table of Test-32X"))
+		Expect(sonicAgent.NameMapper.NativeToAbstract("Ethernet4")).To(Equal("eth7-0"))
+	})
+})
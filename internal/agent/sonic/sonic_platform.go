@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	errors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// platformBool parses the booleans written by pmon, which uses both "true" and "True".
+func platformBool(value string) bool {
+	return strings.EqualFold(value, "true")
+}
+
+// getPlatformTable returns the entries of the given STATE_DB table maintained
+// by pmon, keyed and sorted by their name.
+func getPlatformTable(ctx context.Context, stateDB *redis.Client, table string) ([]string, map[string]map[string]string, error) {
+	keys, err := stateDB.Keys(ctx, table+"|*").Result()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make([]string, 0, len(keys))
+	entries := make(map[string]map[string]string, len(keys))
+	for _, key := range keys {
+		fields, err := stateDB.HGetAll(ctx, key).Result()
+		if err != nil {
+			return nil, nil, err
+		}
+		name := strings.TrimPrefix(key, table+"|")
+		names = append(names, name)
+		entries[name] = fields
+	}
+	sort.Strings(names)
+	return names, entries, nil
+}
+
+func (m *SonicAgent) GetPlatformHealth(ctx context.Context) (*agent.PlatformHealth, *agent.Status) {
+	stateDB, err := m.Connect("STATE_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to STATE_DB: %v", err))
+	}
+
+	health := &agent.PlatformHealth{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PlatformHealthKind,
+		},
+		PSUs:         make([]agent.PSU, 0),
+		Fans:         make([]agent.Fan, 0),
+		Temperatures: make([]agent.TemperatureSensor, 0),
+		Status:       agent.Status{Code: 0, Message: "ok"},
+	}
+
+	names, entries, err := getPlatformTable(ctx, stateDB, "PSU_INFO")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get PSU_INFO: %v", err))
+	}
+	for _, name := range names {
+		fields := entries[name]
+		health.PSUs = append(health.PSUs, agent.PSU{
+			Name:     name,
+			Presence: platformBool(fields["presence"]),
+			OK:       platformBool(fields["status"]),
+			Model:    fields["model"],
+			Serial:   fields["serial"],
+			Power:    fields["power"],
+		})
+	}
+
+	names, entries, err = getPlatformTable(ctx, stateDB, "FAN_INFO")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get FAN_INFO: %v", err))
+	}
+	for _, name := range names {
+		fields := entries[name]
+		health.Fans = append(health.Fans, agent.Fan{
+			Name:     name,
+			Presence: platformBool(fields["presence"]),
+			// A fan running outside of its target speed range is not considered healthy
+			OK:        platformBool(fields["status"]) && !platformBool(fields["is_under_speed"]) && !platformBool(fields["is_over_speed"]),
+			Speed:     fields["speed"],
+			Direction: fields["direction"],
+		})
+	}
+
+	names, entries, err = getPlatformTable(ctx, stateDB, "TEMPERATURE_INFO")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get TEMPERATURE_INFO: %v", err))
+	}
+	for _, name := range names {
+		fields := entries[name]
+		health.Temperatures = append(health.Temperatures, agent.TemperatureSensor{
+			Name:                  name,
+			Temperature:           fields["temperature"],
+			HighThreshold:         fields["high_threshold"],
+			CriticalHighThreshold: fields["critical_high_threshold"],
+			Warning:               platformBool(fields["warning_status"]),
+		})
+	}
+
+	return health, nil
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	errors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+)
+
+const (
+	PortMTUMin = 68
+	PortMTUMax = 9216
+
+	// PortDefaultMTU is the MTU SONiC assigns to ports without an explicit one.
+	PortDefaultMTU = "9100"
+
+	AutonegOn  = "on"
+	AutonegOff = "off"
+)
+
+// FECModes are the forward error correction modes supported by CONFIG_DB PORT.
+var FECModes = []string{"none", "rs", "fc", "auto"}
+
+// setPortAttributes fills in the port attributes of the interface from the
+// given PORT fields. APPL_DB PORT_TABLE holds the values actually applied,
+// CONFIG_DB PORT the configured ones.
+func setPortAttributes(iface *agent.Interface, fields map[string]string) {
+	if mtu, err := strconv.ParseUint(fields["mtu"], 10, 32); err == nil {
+		iface.MTU = uint32(mtu)
+	}
+	if speed, err := strconv.ParseUint(fields["speed"], 10, 32); err == nil {
+		iface.Speed = uint32(speed)
+	}
+	iface.FEC = fields["fec"]
+
+	// older SONiC releases report auto-negotiation as 1/0
+	switch fields["autoneg"] {
+	case AutonegOn, "1":
+		iface.Autoneg = AutonegOn
+	case AutonegOff, "0":
+		iface.Autoneg = AutonegOff
+	}
+}
+
+func (m *SonicAgent) SetInterfacePortAttributes(ctx context.Context, iface *agent.Interface) (*agent.Interface, *agent.Status) {
+	if iface == nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "interface cannot be empty")
+	}
+
+	ifaceName, status := m.resolveNativeInterfaceName(iface.Name)
+	if status != nil {
+		return nil, status
+	}
+
+	// only the attributes set on the request are applied, the others are kept as they are
+	var values []any
+	if iface.MTU != 0 {
+		if iface.MTU < PortMTUMin || iface.MTU > PortMTUMax {
+			return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("invalid mtu %d, it has to be between %d and %d", iface.MTU, PortMTUMin, PortMTUMax))
+		}
+		values = append(values, "mtu", strconv.FormatUint(uint64(iface.MTU), 10))
+	}
+	if iface.Speed != 0 {
+		values = append(values, "speed", strconv.FormatUint(uint64(iface.Speed), 10))
+	}
+	if iface.FEC != "" {
+		if !slices.Contains(FECModes, iface.FEC) {
+			return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("invalid fec mode %q, it has to be one of %v", iface.FEC, FECModes))
+		}
+		values = append(values, "fec", iface.FEC)
+	}
+	if iface.Autoneg != "" {
+		if iface.Autoneg != AutonegOn && iface.Autoneg != AutonegOff {
+			return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("invalid autoneg %q, it has to be either %s or %s", iface.Autoneg, AutonegOn, AutonegOff))
+		}
+		values = append(values, "autoneg", iface.Autoneg)
+	}
+	if len(values) == 0 {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "no port attributes to set")
+	}
+
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	// store the current port fields for rollback
+	portKey := fmt.Sprintf("PORT|%s", ifaceName)
+	fields, err := configDB.HGetAll(ctx, portKey).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get port %s: %v", ifaceName, err))
+	}
+	if len(fields) == 0 {
+		return nil, errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("interface %s not found", ifaceName))
+	}
+
+	if iface.Speed != 0 {
+		stateDB, err := m.Connect("STATE_DB")
+		if err != nil {
+			return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to STATE_DB: %v", err))
+		}
+
+		// not every platform publishes its supported speeds, only validate if it does
+		supportedSpeeds, err := stateDB.HGet(ctx, fmt.Sprintf("PORT_TABLE|%s", ifaceName), "supported_speeds").Result()
+		if err == nil && supportedSpeeds != "" && !slices.Contains(strings.Split(supportedSpeeds, ","), strconv.FormatUint(uint64(iface.Speed), 10)) {
+			return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("speed %d is not supported by interface %s, supported speeds: %s", iface.Speed, ifaceName, supportedSpeeds))
+		}
+	}
+
+	// only write the attributes that differ from CONFIG_DB, so that a port
+	// whose operational values lag behind or never match is not rewritten
+	configured := &agent.Interface{}
+	setPortAttributes(configured, fields)
+	var changes []any
+	for i := 0; i < len(values); i += 2 {
+		field, value := values[i].(string), values[i+1].(string)
+		current := fields[field]
+		if field == "autoneg" {
+			current = configured.Autoneg
+		}
+		if current != value {
+			changes = append(changes, field, value)
+		}
+	}
+
+	if len(changes) > 0 {
+		if err := configDB.HSet(ctx, portKey, changes...).Err(); err != nil {
+			return nil, errors.NewErrorStatus(errors.REDIS_HSET_FAIL, fmt.Sprintf("failed to set port attributes: %v", err))
+		}
+
+		// Persist changes to config_db.json
+		if status := m.SaveConfig(ctx); status != nil {
+			// Try to rollback if save fails
+			for i := 0; i < len(changes); i += 2 {
+				field := changes[i].(string)
+				if value, ok := fields[field]; ok {
+					_ = configDB.HSet(ctx, portKey, field, value).Err()
+				} else {
+					_ = configDB.HDel(ctx, portKey, field).Err()
+				}
+			}
+			return nil, status
+		}
+
+		for i := 0; i < len(changes); i += 2 {
+			fields[changes[i].(string)] = changes[i+1].(string)
+		}
+	}
+
+	abstractName, err := m.NameMapper.NativeToAbstract(ifaceName)
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to convert native name to abstract name: %v", err))
+	}
+
+	// report the configured attributes, the applied ones show up in APPL_DB
+	// only once the port has been reconfigured
+	result := &agent.Interface{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceKind,
+		},
+		Name:       abstractName,
+		NativeName: ifaceName,
+		AliasName:  fields["alias"],
+		Status:     agent.Status{Code: 0, Message: "ok"},
+	}
+	setPortAttributes(result, fields)
+	return result, nil
+}
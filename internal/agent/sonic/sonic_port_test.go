@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+)
+
+var _ = Describe("SetInterfacePortAttributes", func() {
+	var (
+		redis      *fakeRedis
+		sonicAgent *SonicAgent
+	)
+
+	BeforeEach(func() {
+		var addr string
+		redis, addr = startFakeRedis()
+		redis.hset("CONFIG_DB", "PORT|Ethernet0", map[string]string{
+			"alias":   "Eth1",
+			"mtu":     "9100",
+			"speed":   "100000",
+			"fec":     "rs",
+			"autoneg": "off",
+		})
+		redis.hset("STATE_DB", "PORT_TABLE|Ethernet0", map[string]string{"supported_speeds": "40000,100000"})
+		// the applied values lag behind the configuration
+		redis.hset("APPL_DB", "PORT_TABLE:Ethernet0", map[string]string{"mtu": "1500", "speed": "40000", "fec": "none"})
+
+		var err error
+		sonicAgent, err = NewSonicRedisAgent(addr)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should not write or save the configuration when it already matches", func() {
+		iface, status := sonicAgent.SetInterfacePortAttributes(context.Background(), &agent.Interface{
+			Name:    "Ethernet0",
+			MTU:     9100,
+			Speed:   100000,
+			FEC:     "rs",
+			Autoneg: AutonegOff,
+		})
+		// saving the configuration is not possible here, so this only succeeds without a save
+		Expect(status).To(BeNil())
+		Expect(redis.writeCount()).To(BeZero())
+
+		By("reporting the configured rather than the applied values")
+		Expect(iface.Name).To(Equal("eth0-0"))
+		Expect(iface.NativeName).To(Equal("Ethernet0"))
+		Expect(iface.AliasName).To(Equal("Eth1"))
+		Expect(iface.MTU).To(BeEquivalentTo(9100))
+		Expect(iface.Speed).To(BeEquivalentTo(100000))
+		Expect(iface.FEC).To(Equal("rs"))
+		Expect(iface.Autoneg).To(Equal(AutonegOff))
+	})
+
+	It("should treat auto-negotiation stored as 1/0 like on/off", func() {
+		redis.hset("CONFIG_DB", "PORT|Ethernet0", map[string]string{"autoneg": "1"})
+
+		_, status := sonicAgent.SetInterfacePortAttributes(context.Background(), &agent.Interface{
+			Name:    "eth0-0",
+			Autoneg: AutonegOn,
+		})
+		Expect(status).To(BeNil())
+		Expect(redis.writeCount()).To(BeZero())
+	})
+
+	It("should write only the changed attributes and roll them back when saving fails", func() {
+		_, status := sonicAgent.SetInterfacePortAttributes(context.Background(), &agent.Interface{
+			Name:  "Ethernet0",
+			MTU:   9100,
+			Speed: 40000,
+		})
+		Expect(status).NotTo(BeNil())
+		Expect(status.Message).To(ContainSubstring("failed to"))
+
+		// one write for the speed and one to restore it
+		Expect(redis.writeCount()).To(Equal(2))
+		Expect(redis.get("CONFIG_DB", "PORT|Ethernet0")).To(HaveKeyWithValue("speed", "100000"))
+	})
+
+	It("should remove attributes that were not configured before when rolling back", func() {
+		_, status := sonicAgent.SetInterfacePortAttributes(context.Background(), &agent.Interface{
+			Name: "Ethernet0",
+			MTU:  9000,
+		})
+		Expect(status).NotTo(BeNil())
+		Expect(redis.get("CONFIG_DB", "PORT|Ethernet0")).To(HaveKeyWithValue("mtu", "9100"))
+
+		redis.hset("CONFIG_DB", "PORT|Ethernet4", map[string]string{"alias": "Eth2"})
+		_, status = sonicAgent.SetInterfacePortAttributes(context.Background(), &agent.Interface{
+			Name: "Ethernet4",
+			FEC:  "fc",
+		})
+		Expect(status).NotTo(BeNil())
+		Expect(redis.get("CONFIG_DB", "PORT|Ethernet4")).NotTo(HaveKey("fec"))
+	})
+
+	DescribeTable("should reject invalid attributes without writing them",
+		func(iface *agent.Interface, code int, message string) {
+			iface.Name = "Ethernet0"
+			_, status := sonicAgent.SetInterfacePortAttributes(context.Background(), iface)
+			Expect(status).NotTo(BeNil())
+			Expect(status.Code).To(BeEquivalentTo(code))
+			Expect(status.Message).To(ContainSubstring(message))
+			Expect(redis.writeCount()).To(BeZero())
+		},
+		Entry("no attributes", &agent.Interface{}, errors.BAD_REQUEST, "no port attributes to set"),
+		Entry("mtu too small", &agent.Interface{MTU: 67}, errors.BAD_REQUEST, "invalid mtu 67"),
+		Entry("mtu too large", &agent.Interface{MTU: 9217}, errors.BAD_REQUEST, "invalid mtu 9217"),
+		Entry("unknown fec mode", &agent.Interface{FEC: "baser"}, errors.BAD_REQUEST, `invalid fec mode "baser"`),
+		Entry("unknown autoneg", &agent.Interface{Autoneg: "yes"}, errors.BAD_REQUEST, `invalid autoneg "yes"`),
+		Entry("unsupported speed", &agent.Interface{Speed: 25000}, errors.BAD_REQUEST, "speed 25000 is not supported by interface Ethernet0"),
+	)
+
+	It("should report interfaces missing from CONFIG_DB as not found", func() {
+		_, status := sonicAgent.SetInterfacePortAttributes(context.Background(), &agent.Interface{
+			Name: "Ethernet8",
+			MTU:  9100,
+		})
+		Expect(status).NotTo(BeNil())
+		Expect(status.Code).To(BeEquivalentTo(errors.NOT_FOUND))
+	})
+})
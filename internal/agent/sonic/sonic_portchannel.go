@@ -0,0 +1,386 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	errors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	PortChannelIDMax = 9999
+
+	// PortChannelDefaultMTU matches the MTU `config portchannel add` uses.
+	PortChannelDefaultMTU = "9100"
+)
+
+// portChannelName validates the given PortChannel name (e.g. PortChannel1).
+func portChannelName(name string) (string, *agent.Status) {
+	if name == "" {
+		return "", errors.NewErrorStatus(errors.BAD_REQUEST, "port channel name cannot be empty")
+	}
+
+	var id int
+	if _, err := fmt.Sscanf(name, "PortChannel%d", &id); err != nil || fmt.Sprintf("PortChannel%d", id) != name {
+		return "", errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("invalid port channel name %q, it has to be PortChannel<0-%d>", name, PortChannelIDMax))
+	}
+	if id < 0 || id > PortChannelIDMax {
+		return "", errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("invalid port channel name %q, it has to be PortChannel<0-%d>", name, PortChannelIDMax))
+	}
+
+	return name, nil
+}
+
+func (m *SonicAgent) getPortChannel(ctx context.Context, configDB, stateDB, applDB *redis.Client, name string) (*agent.PortChannel, *agent.Status) {
+	portChannelKey := fmt.Sprintf("PORTCHANNEL|%s", name)
+	fields, err := configDB.HGetAll(ctx, portChannelKey).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get port channel %s: %v", name, err))
+	}
+	if len(fields) == 0 {
+		return nil, errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("port channel %s not found", name))
+	}
+
+	memberKeys, err := configDB.Keys(ctx, fmt.Sprintf("PORTCHANNEL_MEMBER|%s|*", name)).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to obtain port channel member keys: %v", err))
+	}
+	sort.Strings(memberKeys)
+
+	members := make([]agent.PortChannelMember, 0, len(memberKeys))
+	for _, key := range memberKeys {
+		ifaceName := strings.TrimPrefix(key, fmt.Sprintf("PORTCHANNEL_MEMBER|%s|", name))
+
+		// teamd reports whether LACP selected the member in STATE_DB
+		selected, err := stateDB.HGet(ctx, fmt.Sprintf("LAG_MEMBER_TABLE|%s|%s", name, ifaceName), "runner.aggregator.selected").Result()
+		if err != nil {
+			selected = ""
+		}
+
+		members = append(members, agent.PortChannelMember{
+			TypeMeta: agent.TypeMeta{
+				Kind: agent.PortChannelMemberKind,
+			},
+			PortChannelName: name,
+			Interface:       ifaceName,
+			Selected:        selected == "true",
+		})
+	}
+
+	adminStatus := agent.StatusDown
+	if fields["admin_status"] == "up" {
+		adminStatus = agent.StatusUp
+	}
+
+	// The LAG only shows up in STATE_DB once teamd created the team device
+	operStatus := agent.StatusDown
+	exists, err := stateDB.Exists(ctx, fmt.Sprintf("LAG_TABLE|%s", name)).Result()
+	if err == nil && exists != 0 {
+		applFields, err := applDB.HGetAll(ctx, fmt.Sprintf("LAG_TABLE:%s", name)).Result()
+		if err == nil && applFields["oper_status"] == "up" {
+			operStatus = agent.StatusUp
+		}
+	}
+
+	return &agent.PortChannel{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortChannelKind,
+		},
+		Name:            name,
+		Members:         members,
+		AdminStatus:     adminStatus,
+		OperationStatus: operStatus,
+		Status:          agent.Status{Code: 0, Message: "ok"},
+	}, nil
+}
+
+func (m *SonicAgent) connectPortChannelDBs() (configDB, stateDB, applDB *redis.Client, status *agent.Status) {
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return nil, nil, nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	stateDB, err = m.Connect("STATE_DB")
+	if err != nil {
+		return nil, nil, nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to STATE_DB: %v", err))
+	}
+
+	applDB, err = m.Connect("APPL_DB")
+	if err != nil {
+		return nil, nil, nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to APPL_DB: %v", err))
+	}
+
+	return configDB, stateDB, applDB, nil
+}
+
+func (m *SonicAgent) CreatePortChannel(ctx context.Context, portChannel *agent.PortChannel) (*agent.PortChannel, *agent.Status) {
+	if portChannel == nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "port channel cannot be empty")
+	}
+
+	name, status := portChannelName(portChannel.Name)
+	if status != nil {
+		return nil, status
+	}
+
+	adminStatus := portChannel.AdminStatus
+	if adminStatus == "" {
+		adminStatus = agent.StatusUp
+	}
+	if _, err := agent.ValidateDeviceStatusStr(string(adminStatus)); err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, err.Error())
+	}
+
+	configDB, stateDB, applDB, status := m.connectPortChannelDBs()
+	if status != nil {
+		return nil, status
+	}
+
+	portChannelKey := fmt.Sprintf("PORTCHANNEL|%s", name)
+	exists, err := configDB.Exists(ctx, portChannelKey).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to check port channel existence: %v", err))
+	}
+	if exists != 0 {
+		return nil, errors.NewErrorStatus(errors.ALREADY_EXISTS, fmt.Sprintf("port channel %s already exists", name))
+	}
+
+	err = configDB.HSet(ctx, portChannelKey,
+		"admin_status", string(adminStatus),
+		"mtu", PortChannelDefaultMTU,
+		"lacp_key", "auto",
+	).Err()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HSET_FAIL, fmt.Sprintf("failed to create port channel: %v", err))
+	}
+
+	// Persist changes to config_db.json
+	if status := m.SaveConfig(ctx); status != nil {
+		// Try to rollback if save fails
+		_ = configDB.Del(ctx, portChannelKey).Err()
+		return nil, status
+	}
+
+	return m.getPortChannel(ctx, configDB, stateDB, applDB, name)
+}
+
+func (m *SonicAgent) DeletePortChannel(ctx context.Context, portChannel *agent.PortChannel) *agent.Status {
+	if portChannel == nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, "port channel cannot be empty")
+	}
+
+	name, status := portChannelName(portChannel.Name)
+	if status != nil {
+		return status
+	}
+
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	// store the current port channel fields for rollback
+	portChannelKey := fmt.Sprintf("PORTCHANNEL|%s", name)
+	fields, err := configDB.HGetAll(ctx, portChannelKey).Result()
+	if err != nil {
+		return errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get port channel %s: %v", name, err))
+	}
+	if len(fields) == 0 {
+		return errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("port channel %s not found", name))
+	}
+
+	memberKeys, err := configDB.Keys(ctx, fmt.Sprintf("PORTCHANNEL_MEMBER|%s|*", name)).Result()
+	if err != nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to obtain port channel member keys: %v", err))
+	}
+	if len(memberKeys) > 0 {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("port channel %s still has %d member(s), remove them first", name, len(memberKeys)))
+	}
+
+	if err := configDB.Del(ctx, portChannelKey).Err(); err != nil {
+		return errors.NewErrorStatus(errors.REDIS_HSET_FAIL, fmt.Sprintf("failed to delete port channel: %v", err))
+	}
+
+	// Persist changes to config_db.json
+	if status := m.SaveConfig(ctx); status != nil {
+		// Try to rollback if save fails
+		_ = configDB.HSet(ctx, portChannelKey, fields).Err()
+		return status
+	}
+
+	return nil
+}
+
+func (m *SonicAgent) GetPortChannel(ctx context.Context, portChannel *agent.PortChannel) (*agent.PortChannel, *agent.Status) {
+	if portChannel == nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "port channel cannot be empty")
+	}
+
+	name, status := portChannelName(portChannel.Name)
+	if status != nil {
+		return nil, status
+	}
+
+	configDB, stateDB, applDB, status := m.connectPortChannelDBs()
+	if status != nil {
+		return nil, status
+	}
+
+	return m.getPortChannel(ctx, configDB, stateDB, applDB, name)
+}
+
+func (m *SonicAgent) ListPortChannels(ctx context.Context) (*agent.PortChannelList, *agent.Status) {
+	configDB, stateDB, applDB, status := m.connectPortChannelDBs()
+	if status != nil {
+		return nil, status
+	}
+
+	keys, err := configDB.Keys(ctx, "PORTCHANNEL|*").Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to obtain port channel keys: %v", err))
+	}
+	sort.Strings(keys)
+
+	portChannels := make([]agent.PortChannel, 0, len(keys))
+	for _, key := range keys {
+		portChannel, status := m.getPortChannel(ctx, configDB, stateDB, applDB, strings.TrimPrefix(key, "PORTCHANNEL|"))
+		if status != nil {
+			return nil, status
+		}
+		portChannels = append(portChannels, *portChannel)
+	}
+
+	return &agent.PortChannelList{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortChannelListKind,
+		},
+		Items:  portChannels,
+		Status: agent.Status{Code: 0, Message: "ok"},
+	}, nil
+}
+
+func (m *SonicAgent) AddPortChannelMember(ctx context.Context, member *agent.PortChannelMember) (*agent.PortChannelMember, *agent.Status) {
+	if member == nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "port channel member cannot be empty")
+	}
+
+	name, status := portChannelName(member.PortChannelName)
+	if status != nil {
+		return nil, status
+	}
+
+	ifaceName, status := m.resolveNativeInterfaceName(member.Interface)
+	if status != nil {
+		return nil, status
+	}
+
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	exists, err := configDB.Exists(ctx, fmt.Sprintf("PORTCHANNEL|%s", name)).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to check port channel existence: %v", err))
+	}
+	if exists == 0 {
+		return nil, errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("port channel %s not found", name))
+	}
+
+	exists, err = configDB.Exists(ctx, fmt.Sprintf("PORT|%s", ifaceName)).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to check interface existence: %v", err))
+	}
+	if exists == 0 {
+		return nil, errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("interface %s not found", ifaceName))
+	}
+
+	// An interface can only be a member of a single port channel and must not
+	// carry any L2 or L3 configuration of its own
+	for pattern, reason := range map[string]string{
+		fmt.Sprintf("PORTCHANNEL_MEMBER|*|%s", ifaceName): "is already a member of a port channel",
+		fmt.Sprintf("VLAN_MEMBER|*|%s", ifaceName):        "is a vlan member",
+		fmt.Sprintf("INTERFACE|%s|*", ifaceName):          "has ip addresses configured",
+	} {
+		keys, err := configDB.Keys(ctx, pattern).Result()
+		if err != nil {
+			return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to obtain keys for %s: %v", pattern, err))
+		}
+		if len(keys) > 0 {
+			return nil, errors.NewErrorStatus(errors.ALREADY_EXISTS, fmt.Sprintf("interface %s %s (%s)", ifaceName, reason, keys[0]))
+		}
+	}
+
+	memberKey := fmt.Sprintf("PORTCHANNEL_MEMBER|%s|%s", name, ifaceName)
+	if err := configDB.HSet(ctx, memberKey, "NULL", "NULL").Err(); err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HSET_FAIL, fmt.Sprintf("failed to add port channel member: %v", err))
+	}
+
+	// Persist changes to config_db.json
+	if status := m.SaveConfig(ctx); status != nil {
+		// Try to rollback if save fails
+		_ = configDB.Del(ctx, memberKey).Err()
+		return nil, status
+	}
+
+	return &agent.PortChannelMember{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortChannelMemberKind,
+		},
+		PortChannelName: name,
+		Interface:       ifaceName,
+		Status:          agent.Status{Code: 0, Message: "ok"},
+	}, nil
+}
+
+func (m *SonicAgent) RemovePortChannelMember(ctx context.Context, member *agent.PortChannelMember) *agent.Status {
+	if member == nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, "port channel member cannot be empty")
+	}
+
+	name, status := portChannelName(member.PortChannelName)
+	if status != nil {
+		return status
+	}
+
+	ifaceName, status := m.resolveNativeInterfaceName(member.Interface)
+	if status != nil {
+		return status
+	}
+
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	// store the current member fields for rollback
+	memberKey := fmt.Sprintf("PORTCHANNEL_MEMBER|%s|%s", name, ifaceName)
+	fields, err := configDB.HGetAll(ctx, memberKey).Result()
+	if err != nil {
+		return errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get port channel member: %v", err))
+	}
+	if len(fields) == 0 {
+		return errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("interface %s is not a member of port channel %s", ifaceName, name))
+	}
+
+	if err := configDB.Del(ctx, memberKey).Err(); err != nil {
+		return errors.NewErrorStatus(errors.REDIS_HSET_FAIL, fmt.Sprintf("failed to remove port channel member: %v", err))
+	}
+
+	// Persist changes to config_db.json
+	if status := m.SaveConfig(ctx); status != nil {
+		// Try to rollback if save fails
+		_ = configDB.HSet(ctx, memberKey, fields).Err()
+		return status
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+	"fmt"
+
+	errors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+)
+
+// TransceiverMaxLanes is the maximum number of lanes reported by xcvrd (e.g., for OSFP modules).
+const TransceiverMaxLanes = 8
+
+// transceiverReading normalizes a DOM reading, xcvrd reports N/A for unsupported sensors.
+func transceiverReading(value string) string {
+	if value == "N/A" {
+		return ""
+	}
+	return value
+}
+
+func (m *SonicAgent) GetTransceiver(ctx context.Context, iface *agent.Interface) (*agent.Transceiver, *agent.Status) {
+	if iface == nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "interface cannot be empty")
+	}
+
+	nativeName, status := m.resolveNativeInterfaceName(iface.Name)
+	if status != nil {
+		return nil, status
+	}
+
+	stateDB, err := m.Connect("STATE_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to STATE_DB: %v", err))
+	}
+
+	info, err := stateDB.HGetAll(ctx, fmt.Sprintf("TRANSCEIVER_INFO|%s", nativeName)).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get transceiver info of %s: %v", nativeName, err))
+	}
+	if len(info) == 0 {
+		return nil, errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("no transceiver present in %s", nativeName))
+	}
+
+	dom, err := stateDB.HGetAll(ctx, fmt.Sprintf("TRANSCEIVER_DOM_SENSOR|%s", nativeName)).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get transceiver DOM readings of %s: %v", nativeName, err))
+	}
+
+	lanes := make([]agent.TransceiverLane, 0)
+	for lane := 1; lane <= TransceiverMaxLanes; lane++ {
+		rxPower, rxOk := dom[fmt.Sprintf("rx%dpower", lane)]
+		txPower, txOk := dom[fmt.Sprintf("tx%dpower", lane)]
+		if !rxOk && !txOk {
+			continue
+		}
+		lanes = append(lanes, agent.TransceiverLane{
+			Lane:    uint32(lane),
+			RxPower: transceiverReading(rxPower),
+			TxPower: transceiverReading(txPower),
+		})
+	}
+
+	return &agent.Transceiver{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.TransceiverKind,
+		},
+		Interface:    nativeName,
+		Vendor:       info["manufacturer"],
+		PartNumber:   info["model"],
+		SerialNumber: info["serial"],
+		Type:         info["type"],
+		Temperature:  transceiverReading(dom["temperature"]),
+		Voltage:      transceiverReading(dom["voltage"]),
+		Lanes:        lanes,
+		Status:       agent.Status{Code: 0, Message: "ok"},
+	}, nil
+}
@@ -0,0 +1,362 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	errors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	VlanIDMin = 1
+	VlanIDMax = 4094
+
+	VlanTaggingModeTagged   = "tagged"
+	VlanTaggingModeUntagged = "untagged"
+)
+
+// vlanName returns the CONFIG_DB name (e.g. Vlan1001) of the given VLAN. The
+// VLAN ID takes precedence over the name if both are set.
+func vlanName(vlan *agent.Vlan) (string, *agent.Status) {
+	if vlan == nil {
+		return "", errors.NewErrorStatus(errors.BAD_REQUEST, "vlan cannot be empty")
+	}
+
+	vlanID := vlan.VlanID
+	if vlanID == 0 {
+		if _, err := fmt.Sscanf(vlan.Name, "Vlan%d", &vlanID); err != nil {
+			return "", errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to parse vlan name %q: %v", vlan.Name, err))
+		}
+	}
+
+	if vlanID < VlanIDMin || vlanID > VlanIDMax {
+		return "", errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("invalid vlan id %d, it has to be between %d and %d", vlanID, VlanIDMin, VlanIDMax))
+	}
+
+	return fmt.Sprintf("Vlan%d", vlanID), nil
+}
+
+func (m *SonicAgent) getVlan(ctx context.Context, configDB, stateDB *redis.Client, name string) (*agent.Vlan, *agent.Status) {
+	vlanKey := fmt.Sprintf("VLAN|%s", name)
+	fields, err := configDB.HGetAll(ctx, vlanKey).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get vlan %s: %v", name, err))
+	}
+	if len(fields) == 0 {
+		return nil, errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("vlan %s not found", name))
+	}
+
+	vlanID, err := strconv.ParseUint(fields["vlanid"], 10, 32)
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to parse vlanid of vlan %s: %v", name, err))
+	}
+
+	memberKeys, err := configDB.Keys(ctx, fmt.Sprintf("VLAN_MEMBER|%s|*", name)).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to obtain vlan member keys: %v", err))
+	}
+	sort.Strings(memberKeys)
+
+	members := make([]agent.VlanMember, 0, len(memberKeys))
+	for _, key := range memberKeys {
+		ifaceName := strings.TrimPrefix(key, fmt.Sprintf("VLAN_MEMBER|%s|", name))
+
+		taggingMode, err := configDB.HGet(ctx, key, "tagging_mode").Result()
+		if err != nil && err != redis.Nil {
+			return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get tagging mode: %v", err))
+		}
+
+		members = append(members, agent.VlanMember{
+			TypeMeta: agent.TypeMeta{
+				Kind: agent.VlanMemberKind,
+			},
+			VlanName:    name,
+			Interface:   ifaceName,
+			TaggingMode: taggingMode,
+		})
+	}
+
+	// The VLAN state is populated by vlanmgrd once the VLAN has been created in the kernel
+	state, err := stateDB.HGet(ctx, fmt.Sprintf("VLAN_TABLE|%s", name), "state").Result()
+	if err != nil {
+		state = ""
+	}
+
+	return &agent.Vlan{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.VlanKind,
+		},
+		Name:    name,
+		VlanID:  uint32(vlanID),
+		Members: members,
+		State:   state,
+		Status:  agent.Status{Code: 0, Message: "ok"},
+	}, nil
+}
+
+func (m *SonicAgent) CreateVlan(ctx context.Context, vlan *agent.Vlan) (*agent.Vlan, *agent.Status) {
+	name, status := vlanName(vlan)
+	if status != nil {
+		return nil, status
+	}
+
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	stateDB, err := m.Connect("STATE_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to STATE_DB: %v", err))
+	}
+
+	vlanKey := fmt.Sprintf("VLAN|%s", name)
+	exists, err := configDB.Exists(ctx, vlanKey).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to check vlan existence: %v", err))
+	}
+	if exists != 0 {
+		return nil, errors.NewErrorStatus(errors.ALREADY_EXISTS, fmt.Sprintf("vlan %s already exists", name))
+	}
+
+	vlanID := strings.TrimPrefix(name, "Vlan")
+	if err := configDB.HSet(ctx, vlanKey, "vlanid", vlanID).Err(); err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HSET_FAIL, fmt.Sprintf("failed to create vlan: %v", err))
+	}
+
+	// Persist changes to config_db.json
+	if status := m.SaveConfig(ctx); status != nil {
+		// Try to rollback if save fails
+		_ = configDB.Del(ctx, vlanKey).Err()
+		return nil, status
+	}
+
+	return m.getVlan(ctx, configDB, stateDB, name)
+}
+
+func (m *SonicAgent) DeleteVlan(ctx context.Context, vlan *agent.Vlan) *agent.Status {
+	name, status := vlanName(vlan)
+	if status != nil {
+		return status
+	}
+
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	// store the current vlan fields for rollback
+	vlanKey := fmt.Sprintf("VLAN|%s", name)
+	fields, err := configDB.HGetAll(ctx, vlanKey).Result()
+	if err != nil {
+		return errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get vlan %s: %v", name, err))
+	}
+	if len(fields) == 0 {
+		return errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("vlan %s not found", name))
+	}
+
+	// SONiC refuses to remove a VLAN which still has members, so do we
+	memberKeys, err := configDB.Keys(ctx, fmt.Sprintf("VLAN_MEMBER|%s|*", name)).Result()
+	if err != nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to obtain vlan member keys: %v", err))
+	}
+	if len(memberKeys) > 0 {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("vlan %s still has %d member(s), remove them first", name, len(memberKeys)))
+	}
+
+	if err := configDB.Del(ctx, vlanKey).Err(); err != nil {
+		return errors.NewErrorStatus(errors.REDIS_HSET_FAIL, fmt.Sprintf("failed to delete vlan: %v", err))
+	}
+
+	// Persist changes to config_db.json
+	if status := m.SaveConfig(ctx); status != nil {
+		// Try to rollback if save fails
+		_ = configDB.HSet(ctx, vlanKey, fields).Err()
+		return status
+	}
+
+	return nil
+}
+
+func (m *SonicAgent) ListVlans(ctx context.Context) (*agent.VlanList, *agent.Status) {
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	stateDB, err := m.Connect("STATE_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to STATE_DB: %v", err))
+	}
+
+	keys, err := configDB.Keys(ctx, "VLAN|*").Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to obtain vlan keys: %v", err))
+	}
+
+	vlans := make([]agent.Vlan, 0, len(keys))
+	for _, key := range keys {
+		vlan, status := m.getVlan(ctx, configDB, stateDB, strings.TrimPrefix(key, "VLAN|"))
+		if status != nil {
+			return nil, status
+		}
+		vlans = append(vlans, *vlan)
+	}
+
+	sort.Slice(vlans, func(i, j int) bool {
+		return vlans[i].VlanID < vlans[j].VlanID
+	})
+
+	return &agent.VlanList{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.VlanListKind,
+		},
+		Items:  vlans,
+		Status: agent.Status{Code: 0, Message: "ok"},
+	}, nil
+}
+
+func (m *SonicAgent) AddVlanMember(ctx context.Context, member *agent.VlanMember) (*agent.VlanMember, *agent.Status) {
+	if member == nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, "vlan member cannot be empty")
+	}
+
+	name, status := vlanName(&agent.Vlan{Name: member.VlanName})
+	if status != nil {
+		return nil, status
+	}
+
+	ifaceName, status := m.resolveNativeInterfaceName(member.Interface)
+	if status != nil {
+		return nil, status
+	}
+
+	taggingMode := member.TaggingMode
+	if taggingMode == "" {
+		taggingMode = VlanTaggingModeUntagged
+	}
+	if taggingMode != VlanTaggingModeTagged && taggingMode != VlanTaggingModeUntagged {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("invalid tagging mode: %s, it has to be '%s' or '%s'", taggingMode, VlanTaggingModeTagged, VlanTaggingModeUntagged))
+	}
+
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	exists, err := configDB.Exists(ctx, fmt.Sprintf("VLAN|%s", name)).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to check vlan existence: %v", err))
+	}
+	if exists == 0 {
+		return nil, errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("vlan %s not found", name))
+	}
+
+	exists, err = configDB.Exists(ctx, fmt.Sprintf("PORT|%s", ifaceName)).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to check interface existence: %v", err))
+	}
+	if exists == 0 {
+		return nil, errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("interface %s not found", ifaceName))
+	}
+
+	memberKey := fmt.Sprintf("VLAN_MEMBER|%s|%s", name, ifaceName)
+	exists, err = configDB.Exists(ctx, memberKey).Result()
+	if err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_KEY_CHECK_FAIL, fmt.Sprintf("failed to check vlan member existence: %v", err))
+	}
+	if exists != 0 {
+		return nil, errors.NewErrorStatus(errors.ALREADY_EXISTS, fmt.Sprintf("interface %s is already a member of vlan %s", ifaceName, name))
+	}
+
+	// An interface can only be an untagged member of a single VLAN
+	if taggingMode == VlanTaggingModeUntagged {
+		keys, err := configDB.Keys(ctx, fmt.Sprintf("VLAN_MEMBER|*|%s", ifaceName)).Result()
+		if err != nil {
+			return nil, errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to obtain vlan member keys: %v", err))
+		}
+		for _, key := range keys {
+			mode, err := configDB.HGet(ctx, key, "tagging_mode").Result()
+			if err != nil && err != redis.Nil {
+				return nil, errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get tagging mode: %v", err))
+			}
+			if mode == VlanTaggingModeUntagged {
+				return nil, errors.NewErrorStatus(errors.ALREADY_EXISTS, fmt.Sprintf("interface %s is already an untagged member of another vlan (%s)", ifaceName, key))
+			}
+		}
+	}
+
+	if err := configDB.HSet(ctx, memberKey, "tagging_mode", taggingMode).Err(); err != nil {
+		return nil, errors.NewErrorStatus(errors.REDIS_HSET_FAIL, fmt.Sprintf("failed to add vlan member: %v", err))
+	}
+
+	// Persist changes to config_db.json
+	if status := m.SaveConfig(ctx); status != nil {
+		// Try to rollback if save fails
+		_ = configDB.Del(ctx, memberKey).Err()
+		return nil, status
+	}
+
+	return &agent.VlanMember{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.VlanMemberKind,
+		},
+		VlanName:    name,
+		Interface:   ifaceName,
+		TaggingMode: taggingMode,
+		Status:      agent.Status{Code: 0, Message: "ok"},
+	}, nil
+}
+
+func (m *SonicAgent) RemoveVlanMember(ctx context.Context, member *agent.VlanMember) *agent.Status {
+	if member == nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, "vlan member cannot be empty")
+	}
+
+	name, status := vlanName(&agent.Vlan{Name: member.VlanName})
+	if status != nil {
+		return status
+	}
+
+	ifaceName, status := m.resolveNativeInterfaceName(member.Interface)
+	if status != nil {
+		return status
+	}
+
+	configDB, err := m.Connect("CONFIG_DB")
+	if err != nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to CONFIG_DB: %v", err))
+	}
+
+	// store the current member fields for rollback
+	memberKey := fmt.Sprintf("VLAN_MEMBER|%s|%s", name, ifaceName)
+	fields, err := configDB.HGetAll(ctx, memberKey).Result()
+	if err != nil {
+		return errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get vlan member: %v", err))
+	}
+	if len(fields) == 0 {
+		return errors.NewErrorStatus(errors.NOT_FOUND, fmt.Sprintf("interface %s is not a member of vlan %s", ifaceName, name))
+	}
+
+	if err := configDB.Del(ctx, memberKey).Err(); err != nil {
+		return errors.NewErrorStatus(errors.REDIS_HSET_FAIL, fmt.Sprintf("failed to remove vlan member: %v", err))
+	}
+
+	// Persist changes to config_db.json
+	if status := m.SaveConfig(ctx); status != nil {
+		// Try to rollback if save fails
+		_ = configDB.HSet(ctx, memberKey, fields).Err()
+		return status
+	}
+
+	return nil
+}
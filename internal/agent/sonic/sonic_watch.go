@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package sonic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	errors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// getInterfaceEvent reads the current admin and operational state of the given port.
+func (m *SonicAgent) getInterfaceEvent(ctx context.Context, applDB, stateDB *redis.Client, name string) (*agent.InterfaceEvent, error) {
+	applFields, err := applDB.HGetAll(ctx, fmt.Sprintf("PORT_TABLE:%s", name)).Result()
+	if err != nil {
+		return nil, err
+	}
+	stateFields, err := stateDB.HGetAll(ctx, fmt.Sprintf("PORT_TABLE|%s", name)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	// Same sources as ListInterfaces
+	operStatus := agent.StatusDown
+	if applFields["oper_status"] == "up" {
+		operStatus = agent.StatusUp
+	}
+	adminStatus := agent.StatusDown
+	if stateFields["admin_status"] == "up" {
+		adminStatus = agent.StatusUp
+	}
+
+	abstractName, _ := m.NameMapper.NativeToAbstract(name)
+	return &agent.InterfaceEvent{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceEventKind,
+		},
+		Name:            abstractName,
+		NativeName:      name,
+		AdminStatus:     adminStatus,
+		OperationStatus: operStatus,
+	}, nil
+}
+
+// WatchInterfaces sends the state of all ports followed by every change of
+// their admin or operational state until the context is done. Changes are
+// picked up through keyspace notifications, which SONiC enables by default.
+func (m *SonicAgent) WatchInterfaces(ctx context.Context, send func(*agent.InterfaceEvent) error) *agent.Status {
+	applDB, err := m.Connect("APPL_DB")
+	if err != nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to APPL_DB: %v", err))
+	}
+
+	stateDB, err := m.Connect("STATE_DB")
+	if err != nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to connect to STATE_DB: %v", err))
+	}
+
+	applPrefix := fmt.Sprintf("__keyspace@%d__:PORT_TABLE:", getRedisDBIDByName("APPL_DB"))
+	statePrefix := fmt.Sprintf("__keyspace@%d__:PORT_TABLE|", getRedisDBIDByName("STATE_DB"))
+
+	// Subscribe before taking the snapshot to not miss any change in between
+	applSub := applDB.PSubscribe(ctx, applPrefix+"Ethernet*")
+	defer func() {
+		_ = applSub.Close()
+	}()
+	if _, err := applSub.Receive(ctx); err != nil {
+		return errors.NewErrorStatus(errors.SERVER_ERROR, fmt.Sprintf("failed to subscribe to APPL_DB: %v", err))
+	}
+
+	stateSub := stateDB.PSubscribe(ctx, statePrefix+"Ethernet*")
+	defer func() {
+		_ = stateSub.Close()
+	}()
+	if _, err := stateSub.Receive(ctx); err != nil {
+		return errors.NewErrorStatus(errors.SERVER_ERROR, fmt.Sprintf("failed to subscribe to STATE_DB: %v", err))
+	}
+
+	last := map[string]agent.InterfaceEvent{}
+	notify := func(name string) *agent.Status {
+		event, err := m.getInterfaceEvent(ctx, applDB, stateDB, name)
+		if err != nil {
+			return errors.NewErrorStatus(errors.REDIS_HGET_FAIL, fmt.Sprintf("failed to get state of interface %s: %v", name, err))
+		}
+		if previous, ok := last[name]; ok && previous.AdminStatus == event.AdminStatus && previous.OperationStatus == event.OperationStatus {
+			return nil
+		}
+		last[name] = *event
+
+		if err := send(event); err != nil {
+			return errors.NewErrorStatus(errors.CLIENT_ERROR, fmt.Sprintf("failed to send interface event: %v", err))
+		}
+		return nil
+	}
+
+	keys, err := applDB.Keys(ctx, "PORT_TABLE:Ethernet*").Result()
+	if err != nil {
+		return errors.NewErrorStatus(errors.BAD_REQUEST, fmt.Sprintf("failed to obtain PORT_TABLE keys: %v", err))
+	}
+	for _, key := range keys {
+		if status := notify(strings.TrimPrefix(key, "PORT_TABLE:")); status != nil {
+			return status
+		}
+	}
+
+	applCh := applSub.Channel()
+	stateCh := stateSub.Channel()
+	for {
+		var name string
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-applCh:
+			if !ok {
+				return errors.NewErrorStatus(errors.SERVER_ERROR, "APPL_DB subscription closed")
+			}
+			name = strings.TrimPrefix(msg.Channel, applPrefix)
+		case msg, ok := <-stateCh:
+			if !ok {
+				return errors.NewErrorStatus(errors.SERVER_ERROR, "STATE_DB subscription closed")
+			}
+			name = strings.TrimPrefix(msg.Channel, statePrefix)
+		}
+
+		if status := notify(name); status != nil {
+			return status
+		}
+	}
+}
@@ -97,6 +97,49 @@ func (d *SwitchDevice) GetStatus() Status {
 	return d.Status
 }
 
+type PSU struct {
+	Name     string `json:"name"`
+	Presence bool   `json:"presence"`
+	OK       bool   `json:"ok"`
+	Model    string `json:"model"`
+	Serial   string `json:"serial"`
+	Power    string `json:"power"` // Output power in watts
+}
+
+type Fan struct {
+	Name      string `json:"name"`
+	Presence  bool   `json:"presence"`
+	OK        bool   `json:"ok"`
+	Speed     string `json:"speed"` // Speed in percent of the maximum speed
+	Direction string `json:"direction"`
+}
+
+type TemperatureSensor struct {
+	Name                  string `json:"name"`
+	Temperature           string `json:"temperature"` // Temperature in degrees Celsius
+	HighThreshold         string `json:"high_threshold"`
+	CriticalHighThreshold string `json:"critical_high_threshold"`
+	Warning               bool   `json:"warning"`
+}
+
+type PlatformHealth struct {
+	TypeMeta `json:",inline"`
+
+	PSUs         []PSU               `json:"psus"`
+	Fans         []Fan               `json:"fans"`
+	Temperatures []TemperatureSensor `json:"temperatures"`
+
+	Status Status `json:"status"`
+}
+
+func (h *PlatformHealth) GetName() string {
+	return "platform-health"
+}
+
+func (h *PlatformHealth) GetStatus() Status {
+	return h.Status
+}
+
 type Interface struct {
 	TypeMeta `json:",inline"`
 
@@ -108,6 +151,11 @@ type Interface struct {
 	OperationStatus DeviceStatus `json:"operation_status"`
 	AdminStatus     DeviceStatus `json:"admin_status"`
 
+	MTU     uint32 `json:"mtu"`
+	Speed   uint32 `json:"speed"`   // The port speed in Mbps, e.g., 100000
+	FEC     string `json:"fec"`     // The forward error correction mode, e.g., rs, fc, none
+	Autoneg string `json:"autoneg"` // Whether auto-negotiation is enabled, either on or off
+
 	Status Status `json:"status"`
 }
 
@@ -160,6 +208,130 @@ func (n *InterfaceNeighbor) GetStatus() Status {
 	return n.Status
 }
 
+type InterfaceCounters struct {
+	TypeMeta  `json:",inline"`
+	Interface string `json:"interface"` // Native name of the interface, e.g., Ethernet0
+
+	RxOctets   uint64 `json:"rx_octets"`
+	RxPackets  uint64 `json:"rx_packets"`
+	RxErrors   uint64 `json:"rx_errors"`
+	RxDiscards uint64 `json:"rx_discards"`
+	TxOctets   uint64 `json:"tx_octets"`
+	TxPackets  uint64 `json:"tx_packets"`
+	TxErrors   uint64 `json:"tx_errors"`
+	TxDiscards uint64 `json:"tx_discards"`
+
+	Status Status `json:"status"`
+}
+
+func (c *InterfaceCounters) GetName() string {
+	return c.Interface
+}
+
+func (c *InterfaceCounters) GetStatus() Status {
+	return c.Status
+}
+
+type InterfaceCountersList struct {
+	TypeMeta `json:",inline"`
+	Items    []InterfaceCounters `json:"items"`
+	Status   Status              `json:"status"`
+}
+
+func (l *InterfaceCountersList) GetItems() []Object {
+	items := make([]Object, len(l.Items))
+	for i, item := range l.Items {
+		items[i] = &item
+	}
+	return items
+}
+
+func (l *InterfaceCountersList) GetStatus() Status {
+	return l.Status
+}
+
+type TransceiverLane struct {
+	Lane    uint32 `json:"lane"`
+	RxPower string `json:"rx_power"` // RX power in dBm
+	TxPower string `json:"tx_power"` // TX power in dBm
+}
+
+type Transceiver struct {
+	TypeMeta  `json:",inline"`
+	Interface string `json:"interface"` // Native name of the interface, e.g., Ethernet0
+
+	Vendor       string            `json:"vendor"`
+	PartNumber   string            `json:"part_number"`
+	SerialNumber string            `json:"serial_number"`
+	Type         string            `json:"type"`
+	Temperature  string            `json:"temperature"` // Module temperature in degrees Celsius
+	Voltage      string            `json:"voltage"`     // Supply voltage in volts
+	Lanes        []TransceiverLane `json:"lanes"`
+
+	Status Status `json:"status"`
+}
+
+func (t *Transceiver) GetName() string {
+	return t.Interface
+}
+
+func (t *Transceiver) GetStatus() Status {
+	return t.Status
+}
+
+type BGPNeighbor struct {
+	TypeMeta `json:",inline"`
+	Neighbor string `json:"neighbor"` // The neighbor address or the interface of an unnumbered neighbor
+
+	Name             string `json:"name"`
+	RemoteAS         string `json:"remote_as"` // The AS number, or external/internal
+	State            string `json:"state"`     // The BGP session state, e.g., Established
+	PrefixesReceived uint64 `json:"prefixes_received"`
+	Uptime           string `json:"uptime"`
+	Configured       bool   `json:"configured"` // Whether the neighbor is configured in CONFIG_DB
+
+	Status Status `json:"status"`
+}
+
+func (n *BGPNeighbor) GetName() string {
+	return n.Neighbor
+}
+
+func (n *BGPNeighbor) GetStatus() Status {
+	return n.Status
+}
+
+type BGPNeighborList struct {
+	TypeMeta `json:",inline"`
+	Items    []BGPNeighbor `json:"items"`
+	Status   Status        `json:"status"`
+}
+
+func (l *BGPNeighborList) GetItems() []Object {
+	items := make([]Object, len(l.Items))
+	for i, item := range l.Items {
+		items[i] = &item
+	}
+	return items
+}
+
+func (l *BGPNeighborList) GetStatus() Status {
+	return l.Status
+}
+
+type InterfaceEvent struct {
+	TypeMeta `json:",inline"`
+
+	Name            string       `json:"name"`        // Abstract name of the interface, e.g., eth0-0
+	NativeName      string       `json:"native_name"` // Native name of the interface, e.g., Ethernet0
+	AdminStatus     DeviceStatus `json:"admin_status"`
+	OperationStatus DeviceStatus `json:"oper_status"`
+}
+
+func (e *InterfaceEvent) GetName() string {
+	return e.NativeName
+}
+
 type Port struct {
 	TypeMeta `json:",inline"`
 	Name     string `json:"name"`
@@ -194,11 +366,213 @@ func (l *PortList) GetStatus() Status {
 	return l.Status
 }
 
+type VlanMember struct {
+	TypeMeta `json:",inline"`
+
+	VlanName    string `json:"vlan_name"`
+	Interface   string `json:"interface"`
+	TaggingMode string `json:"tagging_mode"` // "tagged" or "untagged"
+
+	Status Status `json:"status"`
+}
+
+func (m *VlanMember) GetName() string {
+	return fmt.Sprintf("%s|%s", m.VlanName, m.Interface)
+}
+
+func (m *VlanMember) GetStatus() Status {
+	return m.Status
+}
+
+type Vlan struct {
+	TypeMeta `json:",inline"`
+
+	Name    string       `json:"name"` // The name of the VLAN in CONFIG_DB, e.g., Vlan1001
+	VlanID  uint32       `json:"vlan_id"`
+	Members []VlanMember `json:"members"`
+	State   string       `json:"state"` // The state reported by STATE_DB, e.g., ok
+
+	Status Status `json:"status"`
+}
+
+func (v *Vlan) GetName() string {
+	return v.Name
+}
+
+func (v *Vlan) GetStatus() Status {
+	return v.Status
+}
+
+type VlanList struct {
+	TypeMeta `json:",inline"`
+	Items    []Vlan `json:"items"`
+	Status   Status `json:"status"`
+}
+
+func (l *VlanList) GetItems() []Object {
+	items := make([]Object, len(l.Items))
+	for i, item := range l.Items {
+		items[i] = &item
+	}
+	return items
+}
+
+func (l *VlanList) GetStatus() Status {
+	return l.Status
+}
+
+type PortChannelMember struct {
+	TypeMeta `json:",inline"`
+
+	PortChannelName string `json:"port_channel_name"`
+	Interface       string `json:"interface"`
+	Selected        bool   `json:"selected"` // Whether LACP selected the member to be part of the aggregator
+
+	Status Status `json:"status"`
+}
+
+func (m *PortChannelMember) GetName() string {
+	return fmt.Sprintf("%s|%s", m.PortChannelName, m.Interface)
+}
+
+func (m *PortChannelMember) GetStatus() Status {
+	return m.Status
+}
+
+type PortChannel struct {
+	TypeMeta `json:",inline"`
+
+	Name            string              `json:"name"` // The name of the PortChannel in CONFIG_DB, e.g., PortChannel1
+	Members         []PortChannelMember `json:"members"`
+	AdminStatus     DeviceStatus        `json:"admin_status"`
+	OperationStatus DeviceStatus        `json:"operation_status"`
+
+	Status Status `json:"status"`
+}
+
+func (p *PortChannel) GetName() string {
+	return p.Name
+}
+
+func (p *PortChannel) GetStatus() Status {
+	return p.Status
+}
+
+type PortChannelList struct {
+	TypeMeta `json:",inline"`
+	Items    []PortChannel `json:"items"`
+	Status   Status        `json:"status"`
+}
+
+func (l *PortChannelList) GetItems() []Object {
+	items := make([]Object, len(l.Items))
+	for i, item := range l.Items {
+		items[i] = &item
+	}
+	return items
+}
+
+func (l *PortChannelList) GetStatus() Status {
+	return l.Status
+}
+
+type InterfaceAddress struct {
+	TypeMeta `json:",inline"`
+
+	Interface  string `json:"interface"`
+	Prefix     string `json:"prefix"`     // The address in CIDR notation, e.g., 10.0.0.1/31 or fc00::1/126
+	Configured bool   `json:"configured"` // Whether the address is present in CONFIG_DB
+	Active     bool   `json:"active"`     // Whether the address is present in APPL_DB
+
+	Status Status `json:"status"`
+}
+
+func (a *InterfaceAddress) GetName() string {
+	return fmt.Sprintf("%s|%s", a.Interface, a.Prefix)
+}
+
+func (a *InterfaceAddress) GetStatus() Status {
+	return a.Status
+}
+
+type InterfaceAddressList struct {
+	TypeMeta `json:",inline"`
+	Items    []InterfaceAddress `json:"items"`
+	Status   Status             `json:"status"`
+}
+
+func (l *InterfaceAddressList) GetItems() []Object {
+	items := make([]Object, len(l.Items))
+	for i, item := range l.Items {
+		items[i] = &item
+	}
+	return items
+}
+
+func (l *InterfaceAddressList) GetStatus() Status {
+	return l.Status
+}
+
+type PortBreakout struct {
+	TypeMeta `json:",inline"`
+
+	Port           string   `json:"port"`            // The parent port the breakout applies to, e.g., Ethernet0
+	Mode           string   `json:"mode"`            // The breakout mode, e.g., 4x25G[10G]
+	DefaultMode    string   `json:"default_mode"`    // The default breakout mode of the HWSKU
+	SupportedModes []string `json:"supported_modes"` // The breakout modes supported by the platform
+	Ports          []string `json:"ports"`           // The ports resulting from the breakout
+
+	Status Status `json:"status"`
+}
+
+func (b *PortBreakout) GetName() string {
+	return b.Port
+}
+
+func (b *PortBreakout) GetStatus() Status {
+	return b.Status
+}
+
+type PortBreakoutList struct {
+	TypeMeta `json:",inline"`
+	Items    []PortBreakout `json:"items"`
+	Status   Status         `json:"status"`
+}
+
+func (l *PortBreakoutList) GetItems() []Object {
+	items := make([]Object, len(l.Items))
+	for i, item := range l.Items {
+		items[i] = &item
+	}
+	return items
+}
+
+func (l *PortBreakoutList) GetStatus() Status {
+	return l.Status
+}
+
 var (
-	DeviceKind            = reflect.TypeOf(SwitchDevice{}).Name()
-	InterfaceKind         = reflect.TypeOf(Interface{}).Name()
-	InterfaceListKind     = reflect.TypeOf(InterfaceList{}).Name()
-	PortKind              = reflect.TypeOf(Port{}).Name()
-	PortListKind          = reflect.TypeOf(PortList{}).Name()
-	InterfaceNeighborKind = reflect.TypeOf(InterfaceNeighbor{}).Name()
+	DeviceKind                = reflect.TypeOf(SwitchDevice{}).Name()
+	InterfaceKind             = reflect.TypeOf(Interface{}).Name()
+	InterfaceListKind         = reflect.TypeOf(InterfaceList{}).Name()
+	PortKind                  = reflect.TypeOf(Port{}).Name()
+	PortListKind              = reflect.TypeOf(PortList{}).Name()
+	InterfaceNeighborKind     = reflect.TypeOf(InterfaceNeighbor{}).Name()
+	VlanKind                  = reflect.TypeOf(Vlan{}).Name()
+	VlanListKind              = reflect.TypeOf(VlanList{}).Name()
+	VlanMemberKind            = reflect.TypeOf(VlanMember{}).Name()
+	PortChannelKind           = reflect.TypeOf(PortChannel{}).Name()
+	PortChannelListKind       = reflect.TypeOf(PortChannelList{}).Name()
+	PortChannelMemberKind     = reflect.TypeOf(PortChannelMember{}).Name()
+	InterfaceAddressKind      = reflect.TypeOf(InterfaceAddress{}).Name()
+	InterfaceAddressListKind  = reflect.TypeOf(InterfaceAddressList{}).Name()
+	PortBreakoutKind          = reflect.TypeOf(PortBreakout{}).Name()
+	PortBreakoutListKind      = reflect.TypeOf(PortBreakoutList{}).Name()
+	InterfaceCountersKind     = reflect.TypeOf(InterfaceCounters{}).Name()
+	InterfaceCountersListKind = reflect.TypeOf(InterfaceCountersList{}).Name()
+	TransceiverKind           = reflect.TypeOf(Transceiver{}).Name()
+	PlatformHealthKind        = reflect.TypeOf(PlatformHealth{}).Name()
+	BGPNeighborKind           = reflect.TypeOf(BGPNeighbor{}).Name()
+	BGPNeighborListKind       = reflect.TypeOf(BGPNeighborList{}).Name()
+	InterfaceEventKind        = reflect.TypeOf(InterfaceEvent{}).Name()
 )
@@ -5,11 +5,11 @@ package types
 
 import (
 	"fmt"
-	"strings"
 
 	pb "github.com/ironcore-dev/sonic-operator/internal/agent/proto"
 
 	api "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	"k8s.io/utils/ptr"
 )
 
 func ProtoStatusToStatus(pbStatus *pb.Status) Status {
@@ -76,28 +76,43 @@ func APIOperationStateToAgentDeviceStatus(opState api.OperationState) (DeviceSta
 	}
 }
 
-func NativeNameToAbstractName(nativeName string) (string, error) {
-
-	if strings.HasPrefix(nativeName, "Ethernet") {
-		var port, vport int
-		number := strings.TrimPrefix(nativeName, "Ethernet")
-		if _, err := fmt.Sscanf(number, "%d", &port); err != nil {
-			return "", fmt.Errorf("failed to parse interface number %q: %v", number, err)
-		}
-		vport = port % 4
-		port = port / 4
-		return fmt.Sprintf("eth%d-%d", port, vport), nil
+func APIAutonegToAgentAutoneg(autoneg *bool) string {
+	if autoneg == nil {
+		return ""
+	}
+	if *autoneg {
+		return "on"
 	}
-	return "", fmt.Errorf("unknown native interface name: %s", nativeName)
+	return "off"
+}
 
+func AgentAutonegToAPIAutoneg(autoneg string) *bool {
+	switch autoneg {
+	case "on":
+		return ptr.To(true)
+	case "off":
+		return ptr.To(false)
+	default:
+		return nil
+	}
 }
-func AbstractNameToNativeName(abstractName string) (string, error) {
-	if strings.HasPrefix(abstractName, "eth") {
-		var port, vport int
-		if _, err := fmt.Sscanf(abstractName, "eth%d-%d", &port, &vport); err != nil {
-			return "", fmt.Errorf("failed to parse abstract interface name %q: %v", abstractName, err)
-		}
-		return fmt.Sprintf("Ethernet%d", 4*port+vport), nil
+
+func AgentTransceiverToAPITransceiver(transceiver *Transceiver) *api.TransceiverStatus {
+	lanes := make([]api.TransceiverLaneStatus, 0, len(transceiver.Lanes))
+	for _, lane := range transceiver.Lanes {
+		lanes = append(lanes, api.TransceiverLaneStatus{
+			Lane:    int32(lane.Lane),
+			RxPower: lane.RxPower,
+			TxPower: lane.TxPower,
+		})
+	}
+	return &api.TransceiverStatus{
+		Vendor:       transceiver.Vendor,
+		PartNumber:   transceiver.PartNumber,
+		SerialNumber: transceiver.SerialNumber,
+		Type:         transceiver.Type,
+		Temperature:  transceiver.Temperature,
+		Voltage:      transceiver.Voltage,
+		Lanes:        lanes,
 	}
-	return "", fmt.Errorf("unknown abstract interface name: %s", abstractName)
 }
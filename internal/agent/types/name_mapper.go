@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// NameMapper converts between the native interface names of a platform (e.g., "Ethernet0" or
+// "Ethernet1/1") and the abstract interface names used by the operator (e.g., "eth0-0").
+type NameMapper interface {
+	// NativeToAbstract returns the abstract name of the given native interface name.
+	NativeToAbstract(nativeName string) (string, error)
+	// AbstractToNative returns the native name of the given abstract interface name.
+	AbstractToNative(abstractName string) (string, error)
+}
+
+// DefaultNameMapper is used if no mapping is known for the platform. It assumes ports with 4 lanes
+// named "Ethernet<first lane>".
+var DefaultNameMapper NameMapper = FixedLaneNameMapper{LanesPerPort: 4}
+
+// FormatAbstractName returns the abstract name of the given lane of a port, e.g., "eth1-0".
+func FormatAbstractName(port, lane int) string {
+	return fmt.Sprintf("eth%d-%d", port, lane)
+}
+
+// ParseAbstractName returns the port and the lane of an abstract name like "eth1-0".
+func ParseAbstractName(abstractName string) (port, lane int, err error) {
+	if _, err := fmt.Sscanf(abstractName, "eth%d-%d", &port, &lane); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse abstract interface name %q: %v", abstractName, err)
+	}
+	if port < 0 || lane < 0 || FormatAbstractName(port, lane) != abstractName {
+		return 0, 0, fmt.Errorf("invalid abstract interface name %q, expected eth<port>-<lane>", abstractName)
+	}
+	return port, lane, nil
+}
+
+// FixedLaneNameMapper maps "Ethernet<N>" to "eth<N / LanesPerPort>-<N % LanesPerPort>".
+type FixedLaneNameMapper struct {
+	LanesPerPort int
+}
+
+func (m FixedLaneNameMapper) NativeToAbstract(nativeName string) (string, error) {
+	number, ok := strings.CutPrefix(nativeName, "Ethernet")
+	if !ok {
+		return "", fmt.Errorf("unknown native interface name: %s", nativeName)
+	}
+	n, err := strconv.Atoi(number)
+	if err != nil || n < 0 {
+		return "", fmt.Errorf("failed to parse interface number %q of %s", number, nativeName)
+	}
+	return FormatAbstractName(n/m.LanesPerPort, n%m.LanesPerPort), nil
+}
+
+func (m FixedLaneNameMapper) AbstractToNative(abstractName string) (string, error) {
+	port, lane, err := ParseAbstractName(abstractName)
+	if err != nil {
+		return "", err
+	}
+	if lane >= m.LanesPerPort {
+		return "", fmt.Errorf("lane %d of %s exceeds the %d lanes per port", lane, abstractName, m.LanesPerPort)
+	}
+	return fmt.Sprintf("Ethernet%d", port*m.LanesPerPort+lane), nil
+}
+
+// TableNameMapper maps names using an explicit table.
+type TableNameMapper struct {
+	toAbstract map[string]string
+	toNative   map[string]string
+}
+
+// NewTableNameMapper returns a mapper for the given table of native to abstract names.
+func NewTableNameMapper(table map[string]string) (*TableNameMapper, error) {
+	m := &TableNameMapper{
+		toAbstract: make(map[string]string, len(table)),
+		toNative:   make(map[string]string, len(table)),
+	}
+	for nativeName, abstractName := range table {
+		if _, _, err := ParseAbstractName(abstractName); err != nil {
+			return nil, fmt.Errorf("invalid mapping of %s: %w", nativeName, err)
+		}
+		if other, ok := m.toNative[abstractName]; ok {
+			return nil, fmt.Errorf("%s and %s are both mapped to %s", other, nativeName, abstractName)
+		}
+		m.toAbstract[nativeName] = abstractName
+		m.toNative[abstractName] = nativeName
+	}
+	return m, nil
+}
+
+func (m *TableNameMapper) NativeToAbstract(nativeName string) (string, error) {
+	if abstractName, ok := m.toAbstract[nativeName]; ok {
+		return abstractName, nil
+	}
+	return "", fmt.Errorf("unknown native interface name: %s", nativeName)
+}
+
+func (m *TableNameMapper) AbstractToNative(abstractName string) (string, error) {
+	if nativeName, ok := m.toNative[abstractName]; ok {
+		return nativeName, nil
+	}
+	return "", fmt.Errorf("unknown abstract interface name: %s", abstractName)
+}
+
+// PortLanes is the lane data of a port as defined by port_config.ini or platform.json.
+type PortLanes struct {
+	Name  string
+	Lanes []int
+	Alias string
+	Index int
+}
+
+// NewLaneNameMapper derives the mapping from the lane data of the given ports. The port of the abstract
+// name is the front panel index of the port, the lane is the offset of the first lane of the port within
+// the lanes of all ports sharing that index. This keeps the names stable across breakout modes, e.g., the
+// ports of a 2x200G breakout of the 8-lane port with index 1 are named eth1-0 and eth1-4.
+func NewLaneNameMapper(ports []PortLanes) (*TableNameMapper, error) {
+	laneSets := map[int]map[int]bool{}
+	for _, p := range ports {
+		if len(p.Lanes) == 0 {
+			return nil, fmt.Errorf("port %s has no lanes", p.Name)
+		}
+		if laneSets[p.Index] == nil {
+			laneSets[p.Index] = map[int]bool{}
+		}
+		for _, lane := range p.Lanes {
+			laneSets[p.Index][lane] = true
+		}
+	}
+	lanesByIndex := make(map[int][]int, len(laneSets))
+	for index, set := range laneSets {
+		lanes := make([]int, 0, len(set))
+		for lane := range set {
+			lanes = append(lanes, lane)
+		}
+		sort.Ints(lanes)
+		lanesByIndex[index] = lanes
+	}
+
+	table := make(map[string]string, len(ports))
+	for _, p := range ports {
+		lanes := lanesByIndex[p.Index]
+		offset := 0
+		for i, lane := range lanes {
+			if lane == p.Lanes[0] {
+				offset = i
+				break
+			}
+		}
+		table[p.Name] = FormatAbstractName(p.Index, offset)
+	}
+	return NewTableNameMapper(table)
+}
+
+// ParsePortConfig parses the lane data of a port_config.ini file. The columns are taken from the
+// header line (e.g., "# name lanes alias index speed"), name, lanes, alias and index are assumed
+// if there is none.
+func ParsePortConfig(r io.Reader) ([]PortLanes, error) {
+	columns := map[string]int{"name": 0, "lanes": 1, "alias": 2, "index": 3}
+
+	var ports []PortLanes
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if header, ok := strings.CutPrefix(line, "#"); ok {
+			if fields := strings.Fields(header); len(fields) > 0 && fields[0] == "name" {
+				columns = map[string]int{}
+				for i, field := range fields {
+					columns[field] = i
+				}
+			}
+			continue
+		}
+
+		fields := strings.Fields(line)
+		column := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(fields) {
+				return fields[i]
+			}
+			return ""
+		}
+
+		port := PortLanes{Name: column("name"), Alias: column("alias")}
+		for _, lane := range strings.Split(column("lanes"), ",") {
+			n, err := strconv.Atoi(lane)
+			if err != nil {
+				return nil, fmt.Errorf("invalid lanes of port %s: %q", port.Name, column("lanes"))
+			}
+			port.Lanes = append(port.Lanes, n)
+		}
+		index, err := strconv.Atoi(column("index"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid index of port %s: %q", port.Name, column("index"))
+		}
+		port.Index = index
+		ports = append(ports, port)
+	}
+	return ports, scanner.Err()
+}
+
+// NameTables holds explicit tables of native to abstract names by HWSKU.
+type NameTables map[string]map[string]string
+
+// LoadNameTables reads a YAML or JSON file mapping HWSKUs to tables of native to abstract names, e.g.,
+//
+//	Accton-AS7726-32X:
+//	  Ethernet0: eth1-0
+//	  Ethernet4: eth2-0
+func LoadNameTables(path string) (NameTables, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	tables := NameTables{}
+	if err := yaml.Unmarshal(content, &tables); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	for hwsku, table := range tables {
+		if _, err := NewTableNameMapper(table); err != nil {
+			return nil, fmt.Errorf("invalid table of %s: %w", hwsku, err)
+		}
+	}
+	return tables, nil
+}
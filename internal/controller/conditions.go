@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	agenterrors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+)
+
+// setCondition sets the condition and records an event if its status changed.
+// Transitions to True are recorded as Normal events, all others as Warning events.
+func setCondition(recorder events.EventRecorder, obj client.Object, conditions *[]metav1.Condition, condition metav1.Condition) {
+	if condition.ObservedGeneration == 0 {
+		condition.ObservedGeneration = obj.GetGeneration()
+	}
+
+	// FindStatusCondition points into the slice updated by SetStatusCondition, so keep a copy of the previous status.
+	var previous metav1.ConditionStatus
+	if existing := meta.FindStatusCondition(*conditions, condition.Type); existing != nil {
+		previous = existing.Status
+	}
+	meta.SetStatusCondition(conditions, condition)
+
+	if recorder == nil || previous == condition.Status {
+		return
+	}
+	if previous == "" && condition.Status == metav1.ConditionTrue && condition.Type != networkingv1alpha1.ConditionReady {
+		// Do not flood new objects with events for every condition becoming True.
+		return
+	}
+
+	eventType := corev1.EventTypeWarning
+	if condition.Status == metav1.ConditionTrue {
+		eventType = corev1.EventTypeNormal
+	}
+	recorder.Eventf(obj, nil, eventType, condition.Reason, condition.Type, "%s is %s: %s", condition.Type, condition.Status, condition.Message)
+}
+
+// errorReason returns the condition reason for a reconciliation error.
+func errorReason(err error) string {
+	if isAgentUnreachable(err) {
+		return networkingv1alpha1.ReasonAgentUnreachable
+	}
+	if statusErr, ok := agenterrors.AsStatusError(err); ok {
+		return agenterrors.Reason(statusErr.Code)
+	}
+	return networkingv1alpha1.ReasonReconcileFailed
+}
+
+// setReachableConditions sets the AgentReachable condition and the Reachable condition kept for
+// existing consumers. Events are only recorded for AgentReachable to not duplicate them.
+func setReachableConditions(recorder events.EventRecorder, obj client.Object, conditions *[]metav1.Condition, status metav1.ConditionStatus, reason, message string) {
+	setCondition(recorder, obj, conditions, metav1.Condition{
+		Type:    networkingv1alpha1.ConditionAgentReachable,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               networkingv1alpha1.SwitchConditionReachable,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: obj.GetGeneration(),
+	})
+}
+
+// setReadyConditions sets the Ready, AgentReachable and Reachable conditions from the result of a reconciliation.
+// AgentReachable and Reachable are only changed if the error tells whether the agent responded.
+func setReadyConditions(recorder events.EventRecorder, obj client.Object, conditions *[]metav1.Condition, err error) {
+	if err == nil {
+		setReachableConditions(recorder, obj, conditions, metav1.ConditionTrue,
+			networkingv1alpha1.ReasonAgentReachable, "The switch agent is reachable")
+		setCondition(recorder, obj, conditions, metav1.Condition{
+			Type:    networkingv1alpha1.ConditionReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  networkingv1alpha1.ReasonReconciled,
+			Message: "The resource was reconciled successfully",
+		})
+		return
+	}
+
+	if isAgentUnreachable(err) {
+		setReachableConditions(recorder, obj, conditions, metav1.ConditionFalse,
+			networkingv1alpha1.ReasonAgentUnreachable, err.Error())
+	} else if _, ok := agenterrors.AsStatusError(err); ok {
+		setReachableConditions(recorder, obj, conditions, metav1.ConditionTrue,
+			networkingv1alpha1.ReasonAgentReachable, "The switch agent is reachable")
+	}
+
+	setCondition(recorder, obj, conditions, metav1.Condition{
+		Type:    networkingv1alpha1.ConditionReady,
+		Status:  metav1.ConditionFalse,
+		Reason:  errorReason(err),
+		Message: err.Error(),
+	})
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+)
+
+var _ = Describe("setCondition", func() {
+	var (
+		s        *networkingv1alpha1.Switch
+		recorder *events.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		s = &networkingv1alpha1.Switch{ObjectMeta: metav1.ObjectMeta{Name: "conditions-test", Generation: 1}}
+		recorder = events.NewFakeRecorder(10)
+	})
+
+	set := func(conditionType string, status metav1.ConditionStatus) {
+		setCondition(recorder, s, &s.Status.Conditions, metav1.Condition{
+			Type:    conditionType,
+			Status:  status,
+			Reason:  "Test",
+			Message: "test",
+		})
+	}
+
+	It("should not record new conditions becoming True except Ready", func() {
+		set(networkingv1alpha1.SwitchConditionDiscovered, metav1.ConditionTrue)
+		Expect(recorder.Events).To(BeEmpty())
+
+		set(networkingv1alpha1.ConditionReady, metav1.ConditionTrue)
+		Expect(recorder.Events).To(Receive(Equal("Normal Test Ready is True: test")))
+	})
+
+	It("should record transitions of existing conditions", func() {
+		set(networkingv1alpha1.SwitchConditionDiscovered, metav1.ConditionTrue)
+		set(networkingv1alpha1.SwitchConditionDiscovered, metav1.ConditionFalse)
+		Expect(recorder.Events).To(Receive(Equal("Warning Test Discovered is False: test")))
+
+		set(networkingv1alpha1.SwitchConditionDiscovered, metav1.ConditionTrue)
+		Expect(recorder.Events).To(Receive(Equal("Normal Test Discovered is True: test")))
+	})
+
+	It("should not record conditions keeping their status", func() {
+		set(networkingv1alpha1.SwitchConditionDiscovered, metav1.ConditionFalse)
+		Expect(recorder.Events).To(Receive())
+
+		set(networkingv1alpha1.SwitchConditionDiscovered, metav1.ConditionFalse)
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should set Reachable together with AgentReachable", func() {
+		setReadyConditions(recorder, s, &s.Status.Conditions, nil)
+		Expect(meta.IsStatusConditionTrue(s.Status.Conditions, networkingv1alpha1.ConditionAgentReachable)).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(s.Status.Conditions, networkingv1alpha1.SwitchConditionReachable)).To(BeTrue())
+
+		setReadyConditions(recorder, s, &s.Status.Conditions, status.Error(codes.Unavailable, "connection refused"))
+		reachable := meta.FindStatusCondition(s.Status.Conditions, networkingv1alpha1.SwitchConditionReachable)
+		Expect(reachable).NotTo(BeNil())
+		Expect(reachable.Status).To(Equal(metav1.ConditionFalse))
+		Expect(reachable.Reason).To(Equal(networkingv1alpha1.ReasonAgentUnreachable))
+		Expect(meta.IsStatusConditionFalse(s.Status.Conditions, networkingv1alpha1.ConditionAgentReachable)).To(BeTrue())
+	})
+})
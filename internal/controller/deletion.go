@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultDeletionTimeout is the default time after which the finalizer of a resource is removed even if
+// its cleanup on the switch failed, e.g., because the agent cannot be reached.
+const DefaultDeletionTimeout = 10 * time.Minute
+
+// deletionTimedOut reports whether the object has been deleting for longer than the timeout.
+// A timeout of zero or less never times out.
+func deletionTimedOut(obj client.Object, timeout time.Duration) bool {
+	deletionTimestamp := obj.GetDeletionTimestamp()
+	if timeout <= 0 || deletionTimestamp == nil {
+		return false
+	}
+	return time.Since(deletionTimestamp.Time) > timeout
+}
+
+// recordCleanupSkipped records an event that the cleanup of the object was given up after the deletion timeout.
+func recordCleanupSkipped(recorder events.EventRecorder, obj client.Object, err error) {
+	if recorder == nil {
+		return
+	}
+	recorder.Eventf(obj, nil, corev1.EventTypeWarning, "CleanupSkipped", "Delete", "Cleanup did not finish within the deletion timeout: %v", err)
+}
@@ -0,0 +1,391 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"net"
+	"slices"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	"github.com/ironcore-dev/sonic-operator/internal/agent/agent_server"
+	agenterrors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	switchAgent "github.com/ironcore-dev/sonic-operator/internal/agent/interface"
+	pb "github.com/ironcore-dev/sonic-operator/internal/agent/proto"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+	switchUtil "github.com/ironcore-dev/sonic-operator/internal/switch_util"
+)
+
+// fakeAgent is an in-memory switch agent for the controller tests. Calls of
+// methods it does not implement panic through the nil embedded SwitchAgent.
+type fakeAgent struct {
+	switchAgent.SwitchAgent
+
+	mu           sync.Mutex
+	interfaces   map[string]*agent.Interface
+	portChannels map[string]*agent.PortChannel
+	bgpNeighbors map[string]*agent.BGPNeighbor
+	breakouts    map[string]*agent.PortBreakout
+	// breakoutPorts are the ports resulting from each breakout mode.
+	breakoutPorts map[string][]string
+
+	// adminStatusUpdates counts the calls of SetInterfaceAdminStatus.
+	adminStatusUpdates int
+	// portAttributeRequests records the calls of SetInterfacePortAttributes.
+	// The attributes are not applied to the interfaces, as the operational
+	// values of a port follow its configuration only with a delay.
+	portAttributeRequests []agent.Interface
+	// transceiverStatus, if set, is returned by GetTransceiver.
+	transceiverStatus *agent.Status
+	// failStatus, if set, is returned by GetPortChannel and ListBGPNeighbors.
+	failStatus *agent.Status
+	// configSaves counts the calls of SaveConfig.
+	configSaves int
+	// saveConfigStatus, if set, is returned by SaveConfig.
+	saveConfigStatus *agent.Status
+}
+
+func newFakeAgent() *fakeAgent {
+	return &fakeAgent{
+		interfaces:    map[string]*agent.Interface{},
+		portChannels:  map[string]*agent.PortChannel{},
+		bgpNeighbors:  map[string]*agent.BGPNeighbor{},
+		breakouts:     map[string]*agent.PortBreakout{},
+		breakoutPorts: map[string][]string{},
+	}
+}
+
+// startFakeAgent serves the agent on a local port and returns the management
+// host and port to put into the Switch spec.
+func startFakeAgent(switchName string, a switchAgent.SwitchAgent) (string, string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+
+	server := grpc.NewServer()
+	pb.RegisterSwitchAgentServiceServer(server, agent_server.NewProxyServer(a))
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	DeferCleanup(func() {
+		switchUtil.DefaultConnectionManager.Evict(switchName)
+		server.Stop()
+	})
+
+	host, port, err := net.SplitHostPort(lis.Addr().String())
+	Expect(err).NotTo(HaveOccurred())
+	return host, port
+}
+
+// findInterface returns the interface with the given name, native name or alias.
+func (f *fakeAgent) findInterface(name string) *agent.Interface {
+	for _, iface := range f.interfaces {
+		if iface.Name == name || iface.NativeName == name || iface.AliasName == name {
+			return iface
+		}
+	}
+	return nil
+}
+
+func (f *fakeAgent) GetInterface(_ context.Context, iface *agent.Interface) (*agent.Interface, *agent.Status) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	found := f.findInterface(iface.Name)
+	if found == nil {
+		return nil, agenterrors.NewErrorStatus(agenterrors.NOT_FOUND, "interface not found")
+	}
+	result := *found
+	return &result, nil
+}
+
+func (f *fakeAgent) SetInterfaceAdminStatus(_ context.Context, iface *agent.Interface) (*agent.Interface, *agent.Status) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.adminStatusUpdates++
+	found := f.findInterface(iface.Name)
+	if found == nil {
+		return nil, agenterrors.NewErrorStatus(agenterrors.NOT_FOUND, "interface not found")
+	}
+	found.AdminStatus = iface.AdminStatus
+	found.OperationStatus = iface.AdminStatus
+	result := *found
+	return &result, nil
+}
+
+func (f *fakeAgent) SetInterfaceAliasName(_ context.Context, iface *agent.Interface) (*agent.Interface, *agent.Status) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	found := f.findInterface(iface.Name)
+	if found == nil {
+		return nil, agenterrors.NewErrorStatus(agenterrors.NOT_FOUND, "interface not found")
+	}
+	found.AliasName = iface.AliasName
+	result := *found
+	return &result, nil
+}
+
+func (f *fakeAgent) SetInterfacePortAttributes(_ context.Context, iface *agent.Interface) (*agent.Interface, *agent.Status) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.portAttributeRequests = append(f.portAttributeRequests, *iface)
+	found := f.findInterface(iface.Name)
+	if found == nil {
+		return nil, agenterrors.NewErrorStatus(agenterrors.NOT_FOUND, "interface not found")
+	}
+	result := *found
+	result.MTU = iface.MTU
+	result.Speed = iface.Speed
+	result.FEC = iface.FEC
+	result.Autoneg = iface.Autoneg
+	return &result, nil
+}
+
+func (f *fakeAgent) SaveConfig(_ context.Context) *agent.Status {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.configSaves++
+	return f.saveConfigStatus
+}
+
+func (f *fakeAgent) ListInterfaceAddresses(_ context.Context, _ *agent.Interface) (*agent.InterfaceAddressList, *agent.Status) {
+	return &agent.InterfaceAddressList{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceAddressListKind,
+		},
+	}, nil
+}
+
+func (f *fakeAgent) GetInterfaceNeighbor(_ context.Context, _ *agent.Interface) (*agent.InterfaceNeighbor, *agent.Status) {
+	return nil, agenterrors.NewErrorStatus(agenterrors.NOT_FOUND, "no LLDP neighbor")
+}
+
+func (f *fakeAgent) GetTransceiver(_ context.Context, iface *agent.Interface) (*agent.Transceiver, *agent.Status) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.transceiverStatus != nil {
+		return nil, f.transceiverStatus
+	}
+	return &agent.Transceiver{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.TransceiverKind,
+		},
+		Interface: iface.Name,
+		Vendor:    "ACME",
+	}, nil
+}
+
+func (f *fakeAgent) GetPortChannel(_ context.Context, portChannel *agent.PortChannel) (*agent.PortChannel, *agent.Status) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.failStatus != nil {
+		return nil, f.failStatus
+	}
+	pc, ok := f.portChannels[portChannel.Name]
+	if !ok {
+		return nil, agenterrors.NewErrorStatus(agenterrors.NOT_FOUND, "port channel not found")
+	}
+	result := *pc
+	result.Members = append([]agent.PortChannelMember(nil), pc.Members...)
+	return &result, nil
+}
+
+func (f *fakeAgent) CreatePortChannel(_ context.Context, portChannel *agent.PortChannel) (*agent.PortChannel, *agent.Status) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.portChannels[portChannel.Name]; ok {
+		return nil, agenterrors.NewErrorStatus(agenterrors.ALREADY_EXISTS, "port channel already exists")
+	}
+	pc := &agent.PortChannel{
+		TypeMeta:        portChannel.TypeMeta,
+		Name:            portChannel.Name,
+		AdminStatus:     portChannel.AdminStatus,
+		OperationStatus: agent.StatusUp,
+	}
+	f.portChannels[pc.Name] = pc
+	result := *pc
+	return &result, nil
+}
+
+func (f *fakeAgent) DeletePortChannel(_ context.Context, portChannel *agent.PortChannel) *agent.Status {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.portChannels[portChannel.Name]; !ok {
+		return agenterrors.NewErrorStatus(agenterrors.NOT_FOUND, "port channel not found")
+	}
+	delete(f.portChannels, portChannel.Name)
+	return nil
+}
+
+// AddPortChannelMember adds the member as selected by LACP.
+func (f *fakeAgent) AddPortChannelMember(_ context.Context, member *agent.PortChannelMember) (*agent.PortChannelMember, *agent.Status) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	pc, ok := f.portChannels[member.PortChannelName]
+	if !ok {
+		return nil, agenterrors.NewErrorStatus(agenterrors.NOT_FOUND, "port channel not found")
+	}
+	added := agent.PortChannelMember{
+		TypeMeta:        member.TypeMeta,
+		PortChannelName: member.PortChannelName,
+		Interface:       member.Interface,
+		Selected:        true,
+	}
+	pc.Members = append(pc.Members, added)
+	return &added, nil
+}
+
+func (f *fakeAgent) RemovePortChannelMember(_ context.Context, member *agent.PortChannelMember) *agent.Status {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	pc, ok := f.portChannels[member.PortChannelName]
+	if !ok {
+		return agenterrors.NewErrorStatus(agenterrors.NOT_FOUND, "port channel not found")
+	}
+	for i, m := range pc.Members {
+		if m.Interface == member.Interface {
+			pc.Members = append(pc.Members[:i], pc.Members[i+1:]...)
+			return nil
+		}
+	}
+	return agenterrors.NewErrorStatus(agenterrors.NOT_FOUND, "port channel member not found")
+}
+
+// memberNames returns the member interfaces of the port channel on the fake switch.
+func (f *fakeAgent) memberNames(name string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	pc, ok := f.portChannels[name]
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(pc.Members))
+	for _, m := range pc.Members {
+		names = append(names, m.Interface)
+	}
+	return names
+}
+
+func (f *fakeAgent) hasPortChannel(name string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, ok := f.portChannels[name]
+	return ok
+}
+
+func (f *fakeAgent) ListBGPNeighbors(_ context.Context) (*agent.BGPNeighborList, *agent.Status) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.failStatus != nil {
+		return nil, f.failStatus
+	}
+	list := &agent.BGPNeighborList{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.BGPNeighborListKind,
+		},
+	}
+	for _, n := range f.bgpNeighbors {
+		list.Items = append(list.Items, *n)
+	}
+	return list, nil
+}
+
+// AddBGPNeighbor adds the neighbor with an established session.
+func (f *fakeAgent) AddBGPNeighbor(_ context.Context, neighbor *agent.BGPNeighbor) (*agent.BGPNeighbor, *agent.Status) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.bgpNeighbors[neighbor.Neighbor]; ok {
+		return nil, agenterrors.NewErrorStatus(agenterrors.ALREADY_EXISTS, "BGP neighbor already exists")
+	}
+	added := &agent.BGPNeighbor{
+		TypeMeta:         neighbor.TypeMeta,
+		Neighbor:         neighbor.Neighbor,
+		Name:             neighbor.Name,
+		RemoteAS:         neighbor.RemoteAS,
+		State:            "Established",
+		PrefixesReceived: 3,
+		Uptime:           "00:00:01",
+		Configured:       true,
+	}
+	f.bgpNeighbors[neighbor.Neighbor] = added
+	result := *added
+	return &result, nil
+}
+
+func (f *fakeAgent) RemoveBGPNeighbor(_ context.Context, neighbor *agent.BGPNeighbor) *agent.Status {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.bgpNeighbors[neighbor.Neighbor]; !ok {
+		return agenterrors.NewErrorStatus(agenterrors.NOT_FOUND, "BGP neighbor not found")
+	}
+	delete(f.bgpNeighbors, neighbor.Neighbor)
+	return nil
+}
+
+// bgpNeighbor returns a copy of the BGP neighbor on the fake switch, or nil if there is none.
+func (f *fakeAgent) bgpNeighbor(name string) *agent.BGPNeighbor {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n, ok := f.bgpNeighbors[name]
+	if !ok {
+		return nil
+	}
+	result := *n
+	return &result
+}
+
+// ListPortBreakouts reports a platform without breakout definitions if no breakouts are set.
+func (f *fakeAgent) ListPortBreakouts(_ context.Context) (*agent.PortBreakoutList, *agent.Status) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.breakouts) == 0 {
+		return nil, agenterrors.NewErrorStatus(agenterrors.NOT_FOUND, "failed to read platform.json")
+	}
+	list := &agent.PortBreakoutList{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortBreakoutListKind,
+		},
+	}
+	for _, b := range f.breakouts {
+		list.Items = append(list.Items, *b)
+	}
+	return list, nil
+}
+
+func (f *fakeAgent) SetPortBreakout(_ context.Context, breakout *agent.PortBreakout) (*agent.PortBreakout, *agent.Status) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	b, ok := f.breakouts[breakout.Port]
+	if !ok {
+		return nil, agenterrors.NewErrorStatus(agenterrors.NOT_FOUND, "port not found in platform.json")
+	}
+	if !slices.Contains(b.SupportedModes, breakout.Mode) {
+		return nil, agenterrors.NewErrorStatus(agenterrors.BAD_REQUEST, "breakout mode is not supported")
+	}
+	b.Mode = breakout.Mode
+	b.Ports = f.breakoutPorts[breakout.Mode]
+	result := *b
+	return &result, nil
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+	switchUtil "github.com/ironcore-dev/sonic-operator/internal/switch_util"
+)
+
+// DefaultInterfaceWatchRetryInterval is the time to wait before re-establishing a failed interface watch.
+const DefaultInterfaceWatchRetryInterval = 10 * time.Second
+
+// InterfaceWatcher streams the interface state changes of each Switch from its
+// agent and emits a generic event per changed interface. The events carry a
+// SwitchInterface stub with the switch reference and the native name set.
+type InterfaceWatcher struct {
+	client.Reader
+
+	// RetryInterval is the time to wait before re-establishing a failed watch.
+	RetryInterval time.Duration
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	events  chan event.GenericEvent
+	mu      sync.Mutex
+	watches map[string]*interfaceWatch
+}
+
+type interfaceWatch struct {
+	management networkingv1alpha1.Management
+	cancel     context.CancelFunc
+}
+
+func NewInterfaceWatcher(reader client.Reader) *InterfaceWatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &InterfaceWatcher{
+		Reader:        reader,
+		RetryInterval: DefaultInterfaceWatchRetryInterval,
+		ctx:           ctx,
+		cancel:        cancel,
+		events:        make(chan event.GenericEvent),
+		watches:       map[string]*interfaceWatch{},
+	}
+}
+
+// Events returns the channel the interface events are emitted on.
+func (w *InterfaceWatcher) Events() <-chan event.GenericEvent {
+	return w.events
+}
+
+// Start implements manager.Runnable and stops all watches once the manager stops.
+func (w *InterfaceWatcher) Start(ctx context.Context) error {
+	<-ctx.Done()
+	w.cancel()
+	return nil
+}
+
+// Watch ensures the interfaces of the given Switch are watched. A running
+// watch is restarted if the management endpoint of the Switch changed.
+func (w *InterfaceWatcher) Watch(s *networkingv1alpha1.Switch) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if watch, ok := w.watches[s.Name]; ok {
+		if watch.management == s.Spec.Management {
+			return
+		}
+		watch.cancel()
+	}
+
+	ctx, cancel := context.WithCancel(w.ctx)
+	w.watches[s.Name] = &interfaceWatch{
+		management: s.Spec.Management,
+		cancel:     cancel,
+	}
+	go w.run(ctx, s.DeepCopy())
+}
+
+// Unwatch stops watching the interfaces of the Switch with the given name.
+func (w *InterfaceWatcher) Unwatch(name string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if watch, ok := w.watches[name]; ok {
+		watch.cancel()
+		delete(w.watches, name)
+	}
+}
+
+func (w *InterfaceWatcher) run(ctx context.Context, s *networkingv1alpha1.Switch) {
+	log := logf.FromContext(ctx).WithValues("Switch", s.Name)
+
+	for {
+		if err := w.watch(ctx, s); err != nil {
+			log.Error(err, "Failed to watch interfaces, retrying", "RetryInterval", w.RetryInterval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.RetryInterval):
+		}
+	}
+}
+
+func (w *InterfaceWatcher) watch(ctx context.Context, s *networkingv1alpha1.Switch) error {
+	switchAgentClient, err := switchUtil.NewAgentClientForSwitch(ctx, w.Reader, s)
+	if err != nil {
+		return err
+	}
+
+	events := make(chan agent.InterfaceEvent)
+	done := make(chan error, 1)
+	go func() {
+		done <- switchAgentClient.WatchInterfaces(ctx, events)
+	}()
+
+	for {
+		select {
+		case err := <-done:
+			return err
+		case e := <-events:
+			select {
+			case w.events <- event.GenericEvent{Object: &networkingv1alpha1.SwitchInterface{
+				Spec: networkingv1alpha1.SwitchInterfaceSpec{
+					SwitchRef:  &corev1.LocalObjectReference{Name: s.Name},
+					NativeName: e.NativeName,
+				},
+			}}:
+			case <-ctx.Done():
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+)
+
+const (
+	// DefaultSwitchResyncInterval is the default interval in which Switches are reconciled again.
+	DefaultSwitchResyncInterval = 5 * time.Minute
+	// DefaultSwitchInterfaceResyncInterval is the default interval in which SwitchInterfaces are reconciled again.
+	DefaultSwitchInterfaceResyncInterval = time.Minute
+	// DefaultSwitchBGPPeerResyncInterval is the default interval in which SwitchBGPPeers are reconciled again.
+	DefaultSwitchBGPPeerResyncInterval = time.Minute
+	// DefaultSwitchPortChannelResyncInterval is the default interval in which SwitchPortChannels are reconciled again.
+	DefaultSwitchPortChannelResyncInterval = time.Minute
+
+	unreachableBackoffBase = 5 * time.Second
+	unreachableBackoffMax  = 5 * time.Minute
+	jitterFactor           = 0.2
+)
+
+// isAgentUnreachable reports whether the error was caused by a switch agent that cannot be reached.
+func isAgentUnreachable(err error) bool {
+	s, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	return s.Code() == codes.Unavailable || s.Code() == codes.DeadlineExceeded
+}
+
+// resyncPolicy computes when an object polling a switch agent is reconciled again.
+// Successful reconciliations are resynced periodically, failures caused by an
+// unreachable agent are retried with a jittered exponential backoff.
+type resyncPolicy struct {
+	mu       sync.Mutex
+	failures map[string]int
+}
+
+// result returns the result and error of the reconciliation of the object with the given key.
+// Successful reconciliations are requeued after the interval, unless an earlier requeue was requested.
+func (p *resyncPolicy) result(key string, interval time.Duration, result ctrl.Result, err error) (ctrl.Result, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.failures == nil {
+		p.failures = map[string]int{}
+	}
+
+	if isAgentUnreachable(err) {
+		failures := p.failures[key]
+		p.failures[key] = failures + 1
+
+		backoff := time.Duration(float64(unreachableBackoffBase) * math.Pow(2, float64(failures)))
+		if backoff <= 0 || backoff > unreachableBackoffMax {
+			backoff = unreachableBackoffMax
+		}
+		return ctrl.Result{RequeueAfter: wait.Jitter(backoff, jitterFactor)}, nil
+	}
+
+	if err != nil {
+		return result, err
+	}
+
+	delete(p.failures, key)
+	if interval > 0 && (result.RequeueAfter == 0 || interval < result.RequeueAfter) {
+		result.RequeueAfter = wait.Jitter(interval, jitterFactor)
+	}
+	return result, nil
+}
+
+// forget drops the failure history of the object with the given key.
+func (p *resyncPolicy) forget(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.failures, key)
+}
+
+// pollInterval returns the poll interval of the Switch, or the given default if it is not set.
+func pollInterval(s *networkingv1alpha1.Switch, defaultInterval time.Duration) time.Duration {
+	if s != nil && s.Spec.PollInterval != nil && s.Spec.PollInterval.Duration > 0 {
+		return s.Spec.PollInterval.Duration
+	}
+	return defaultInterval
+}
@@ -4,22 +4,35 @@
 package controller
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/ironcore-dev/controller-utils/clientutils"
 
+	agentCli "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
+	agenterrors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
 	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
 
 	switchUtil "github.com/ironcore-dev/sonic-operator/internal/switch_util"
 
+	"google.golang.org/grpc/connectivity"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
 	v1alpha1ac "github.com/ironcore-dev/sonic-operator/api/v1alpha1/applyconfiguration/api/v1alpha1"
@@ -30,16 +43,39 @@ var (
 	fieldOwner = client.FieldOwner("switch-controller")
 )
 
+// DefaultOrphanGracePeriod is the default duration a SwitchInterface may be missing on the switch before it is deleted.
+const DefaultOrphanGracePeriod = 10 * time.Minute
+
 // SwitchReconciler reconciles a Switch object
 type SwitchReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// InterfaceWatcher, if set, is told to watch the interfaces of each reconciled Switch.
+	InterfaceWatcher *InterfaceWatcher
+
+	// Recorder, if set, records events on condition transitions.
+	Recorder events.EventRecorder
+
+	// RequirePortsMatched refuses to mark a Switch Ready while its ports do not match spec.ports.
+	RequirePortsMatched bool
+
+	// DeletionTimeout is the time after which the finalizer of a deleted Switch is removed even if its
+	// SwitchInterfaces could not be cleaned up. Zero waits forever.
+	DeletionTimeout time.Duration
+
+	// ResyncInterval is the interval in which Switches are reconciled again, unless overridden by spec.pollInterval.
+	ResyncInterval time.Duration
+
+	resync resyncPolicy
 }
 
 // +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switches,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switches/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switches/finalizers,verbs=update
-// +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=interfaces,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchinterfaces,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchcredentials,verbs=get;list;watch
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -63,7 +99,25 @@ func (r *SwitchReconciler) reconileExists(ctx context.Context, log logr.Logger,
 func (r *SwitchReconciler) delete(ctx context.Context, log logr.Logger, s *networkingv1alpha1.Switch) (ctrl.Result, error) {
 	log.Info("Deleting Switch")
 
-	// TODO: do cleanup
+	// Delete the SwitchInterfaces first, so that their deletion policy is applied while the Switch still exists
+	remaining, err := r.deleteInterfaces(ctx, s)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if remaining > 0 {
+		if !deletionTimedOut(s, r.DeletionTimeout) {
+			log.Info("Waiting for SwitchInterfaces to be deleted", "Remaining", remaining)
+			return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		}
+		log.Info("SwitchInterfaces were not deleted within the deletion timeout, removing finalizer", "Remaining", remaining)
+		recordCleanupSkipped(r.Recorder, s, fmt.Errorf("%d SwitchInterfaces remaining", remaining))
+	}
+
+	if r.InterfaceWatcher != nil {
+		r.InterfaceWatcher.Unwatch(s.Name)
+	}
+	switchUtil.DefaultConnectionManager.Evict(s.Name)
+	r.resync.forget(s.Name)
 
 	if _, err := clientutils.PatchEnsureNoFinalizer(ctx, r.Client, s, networkingv1alpha1.SwitchFinalizer); err != nil {
 		return ctrl.Result{}, err
@@ -73,7 +127,7 @@ func (r *SwitchReconciler) delete(ctx context.Context, log logr.Logger, s *netwo
 	return ctrl.Result{}, nil
 }
 
-func (r *SwitchReconciler) reconcile(ctx context.Context, log logr.Logger, s *networkingv1alpha1.Switch) (ctrl.Result, error) {
+func (r *SwitchReconciler) reconcile(ctx context.Context, log logr.Logger, s *networkingv1alpha1.Switch) (result ctrl.Result, err error) {
 	log.Info("Reconciling Switch")
 
 	if modified, err := clientutils.PatchEnsureFinalizer(ctx, r.Client, s, networkingv1alpha1.SwitchFinalizer); err != nil || modified {
@@ -92,21 +146,58 @@ func (r *SwitchReconciler) reconcile(ctx context.Context, log logr.Logger, s *ne
 		return ctrl.Result{}, nil
 	}
 
-	switchAgentClient, err := switchUtil.NewAgentClientForSwitch(ctx, s)
+	defer func() {
+		setReadyConditions(r.Recorder, s, &s.Status.Conditions, err)
+		result, err = r.resync.result(s.Name, pollInterval(s, r.ResyncInterval), result, err)
+	}()
+
+	switchAgentClient, err := switchUtil.NewAgentClientForSwitch(ctx, r.Client, s)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
 	switchDevice, err := switchAgentClient.GetDeviceInfo(ctx)
+	setConnectedCondition(r.Recorder, s)
 	if err != nil {
 		s.Status.State = networkingv1alpha1.SwitchStateFailed
+		setCondition(r.Recorder, s, &s.Status.Conditions, metav1.Condition{
+			Type:    networkingv1alpha1.SwitchConditionDiscovered,
+			Status:  metav1.ConditionFalse,
+			Reason:  errorReason(err),
+			Message: err.Error(),
+		})
 		return ctrl.Result{}, err
 	}
+	setCondition(r.Recorder, s, &s.Status.Conditions, metav1.Condition{
+		Type:    networkingv1alpha1.SwitchConditionDiscovered,
+		Status:  metav1.ConditionTrue,
+		Reason:  networkingv1alpha1.SwitchConditionDiscovered,
+		Message: fmt.Sprintf("Discovered %s running SONiC %s", switchDevice.Hwsku, switchDevice.SonicOSVersion),
+	})
 
 	s.Status.MACAddress = switchDevice.LocalMacAddress
 	s.Status.FirmwareVersion = switchDevice.SonicOSVersion
 	s.Status.SKU = switchDevice.Hwsku
 
+	if r.InterfaceWatcher != nil {
+		r.InterfaceWatcher.Watch(s)
+	}
+
+	// The platform health is informational and not provided by every agent or platform,
+	// so failing to read it must not fail the Switch.
+	if health, err := switchAgentClient.GetPlatformHealth(ctx); err != nil {
+		log.Error(err, "Failed to get platform health")
+		setPlatformHealthUnknown(r.Recorder, s, err)
+	} else {
+		setPlatformHealthConditions(r.Recorder, s, health)
+	}
+
+	breakouts, err := r.reconcileBreakouts(ctx, log, s, switchAgentClient)
+	if err != nil {
+		s.Status.State = networkingv1alpha1.SwitchStateFailed
+		return ctrl.Result{}, err
+	}
+
 	interfaceList, err := switchAgentClient.ListInterfaces(ctx)
 	if err != nil {
 		s.Status.State = networkingv1alpha1.SwitchStateFailed
@@ -119,26 +210,395 @@ func (r *SwitchReconciler) reconcile(ctx context.Context, log logr.Logger, s *ne
 		}
 	}
 
+	orphanRequeue, err := r.collectStaleInterfaces(ctx, log, s, interfaceList)
+	if err != nil {
+		s.Status.State = networkingv1alpha1.SwitchStateFailed
+		return ctrl.Result{}, err
+	}
+
 	portList, err := switchAgentClient.ListPorts(ctx)
 	if err != nil {
 		s.Status.State = networkingv1alpha1.SwitchStateFailed
 		return ctrl.Result{}, err
 	}
 
-	if len(portList.Items) > 0 {
-		s.Status.Ports = make([]networkingv1alpha1.PortStatus, 0, len(portList.Items))
+	if err := r.reconcilePorts(ctx, s, portList, breakouts); err != nil {
+		s.Status.State = networkingv1alpha1.SwitchStateFailed
+		return ctrl.Result{}, err
+	}
+
+	s.Status.State = networkingv1alpha1.SwitchStateReady
+
+	log.Info("Reconciled Switch")
+	return ctrl.Result{RequeueAfter: orphanRequeue}, nil
+}
+
+// setConnectedCondition sets the Connected condition of the Switch from the state
+// of its pooled agent connection.
+func setConnectedCondition(recorder events.EventRecorder, s *networkingv1alpha1.Switch) {
+	state, ok := switchUtil.DefaultConnectionManager.State(s.Name)
+	if !ok {
+		return
+	}
+
+	condition := metav1.Condition{
+		Type:    networkingv1alpha1.SwitchConditionConnected,
+		Status:  metav1.ConditionFalse,
+		Reason:  "Idle",
+		Message: fmt.Sprintf("The connection to the agent is %s", state),
 	}
+	switch state {
+	case connectivity.Ready:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Ready"
+	case connectivity.Connecting:
+		condition.Reason = "Connecting"
+	case connectivity.TransientFailure:
+		condition.Reason = "TransientFailure"
+	case connectivity.Shutdown:
+		condition.Reason = "Shutdown"
+	}
+	setCondition(recorder, s, &s.Status.Conditions, condition)
+}
 
+// setPlatformHealthConditions sets the PowerRedundant, FansHealthy and ThermalOK
+// conditions of the Switch from the reported platform health.
+func setPlatformHealthConditions(recorder events.EventRecorder, s *networkingv1alpha1.Switch, health *agent.PlatformHealth) {
+	setHealthCondition := func(conditionType string, reported bool, unhealthy []string, healthyMessage string) {
+		condition := metav1.Condition{
+			Type:    conditionType,
+			Status:  metav1.ConditionTrue,
+			Reason:  networkingv1alpha1.SwitchReasonHealthy,
+			Message: healthyMessage,
+		}
+		switch {
+		case !reported:
+			condition.Status = metav1.ConditionUnknown
+			condition.Reason = networkingv1alpha1.SwitchReasonNotReported
+			condition.Message = "The platform does not report these components"
+		case len(unhealthy) > 0:
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = networkingv1alpha1.SwitchReasonUnhealthy
+			condition.Message = fmt.Sprintf("Unhealthy: %s", strings.Join(unhealthy, ", "))
+		}
+		setCondition(recorder, s, &s.Status.Conditions, condition)
+	}
+
+	var unhealthyPSUs []string
+	healthyPSUs := 0
+	for _, psu := range health.PSUs {
+		if psu.Presence && psu.OK {
+			healthyPSUs++
+		} else {
+			unhealthyPSUs = append(unhealthyPSUs, psu.Name)
+		}
+	}
+	if healthyPSUs < 2 && len(unhealthyPSUs) == 0 {
+		// A single healthy PSU provides no redundancy either
+		unhealthyPSUs = append(unhealthyPSUs, fmt.Sprintf("only %d healthy PSU", healthyPSUs))
+	}
+	setHealthCondition(networkingv1alpha1.SwitchConditionPowerRedundant, len(health.PSUs) > 0, unhealthyPSUs,
+		fmt.Sprintf("%d PSUs present and healthy", healthyPSUs))
+
+	var unhealthyFans []string
+	for _, fan := range health.Fans {
+		if !fan.Presence || !fan.OK {
+			unhealthyFans = append(unhealthyFans, fan.Name)
+		}
+	}
+	setHealthCondition(networkingv1alpha1.SwitchConditionFansHealthy, len(health.Fans) > 0, unhealthyFans,
+		fmt.Sprintf("%d fans present and healthy", len(health.Fans)))
+
+	var hotSensors []string
+	for _, sensor := range health.Temperatures {
+		if sensor.Warning {
+			hotSensors = append(hotSensors, fmt.Sprintf("%s (%s C)", sensor.Name, sensor.Temperature))
+		}
+	}
+	setHealthCondition(networkingv1alpha1.SwitchConditionThermalOK, len(health.Temperatures) > 0, hotSensors,
+		fmt.Sprintf("%d temperature sensors below their high threshold", len(health.Temperatures)))
+}
+
+// setPlatformHealthUnknown sets the platform health conditions of the Switch to Unknown
+// if the platform health could not be read.
+func setPlatformHealthUnknown(recorder events.EventRecorder, s *networkingv1alpha1.Switch, err error) {
+	for _, conditionType := range []string{
+		networkingv1alpha1.SwitchConditionPowerRedundant,
+		networkingv1alpha1.SwitchConditionFansHealthy,
+		networkingv1alpha1.SwitchConditionThermalOK,
+	} {
+		setCondition(recorder, s, &s.Status.Conditions, metav1.Condition{
+			Type:    conditionType,
+			Status:  metav1.ConditionUnknown,
+			Reason:  errorReason(err),
+			Message: fmt.Sprintf("Failed to get the platform health: %v", err),
+		})
+	}
+}
+
+// deleteInterfaces deletes the SwitchInterfaces owned by the Switch and returns how many of them still exist.
+func (r *SwitchReconciler) deleteInterfaces(ctx context.Context, s *networkingv1alpha1.Switch) (int, error) {
+	switchInterfaces := &networkingv1alpha1.SwitchInterfaceList{}
+	if err := r.List(ctx, switchInterfaces); err != nil {
+		return 0, err
+	}
+
+	remaining := 0
+	for _, i := range switchInterfaces.Items {
+		if !metav1.IsControlledBy(&i, s) {
+			continue
+		}
+		remaining++
+		if !i.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := r.Delete(ctx, &i); client.IgnoreNotFound(err) != nil {
+			return 0, err
+		}
+	}
+	return remaining, nil
+}
+
+// collectStaleInterfaces marks the SwitchInterfaces owned by the Switch whose interface no longer exists
+// on the switch as orphaned, and deletes them once they have been orphaned for the grace period.
+// Interfaces that reappear are unmarked. It returns when the next orphan is due for deletion.
+func (r *SwitchReconciler) collectStaleInterfaces(ctx context.Context, log logr.Logger, s *networkingv1alpha1.Switch, interfaceList *agent.InterfaceList) (time.Duration, error) {
+	gracePeriod := DefaultOrphanGracePeriod
+	if value, ok := s.Annotations[networkingv1alpha1.SwitchOrphanGracePeriodAnnotation]; ok {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s annotation: %w", networkingv1alpha1.SwitchOrphanGracePeriodAnnotation, err)
+		}
+		gracePeriod = d
+	}
+
+	existing := sets.New[string]()
+	for _, iface := range interfaceList.Items {
+		existing.Insert(iface.NativeName)
+	}
+
+	switchInterfaces := &networkingv1alpha1.SwitchInterfaceList{}
+	if err := r.List(ctx, switchInterfaces); err != nil {
+		return 0, err
+	}
+
+	var requeueAfter time.Duration
+	now := time.Now()
+	for _, i := range switchInterfaces.Items {
+		if !metav1.IsControlledBy(&i, s) || !i.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		orphanedSince, orphaned := i.Annotations[networkingv1alpha1.SwitchInterfaceOrphanedSinceAnnotation]
+		if existing.Has(i.Spec.NativeName) {
+			if orphaned {
+				log.Info("Interface exists again, unmarking SwitchInterface as orphaned", "SwitchInterface", i.Name)
+				base := i.DeepCopy()
+				delete(i.Annotations, networkingv1alpha1.SwitchInterfaceOrphanedSinceAnnotation)
+				if err := r.Patch(ctx, &i, client.MergeFrom(base)); err != nil {
+					return 0, err
+				}
+			}
+			continue
+		}
+
+		if !orphaned {
+			log.Info("Interface no longer exists, marking SwitchInterface as orphaned", "SwitchInterface", i.Name, "NativeName", i.Spec.NativeName)
+			base := i.DeepCopy()
+			metav1.SetMetaDataAnnotation(&i.ObjectMeta, networkingv1alpha1.SwitchInterfaceOrphanedSinceAnnotation, now.UTC().Format(time.RFC3339))
+			if err := r.Patch(ctx, &i, client.MergeFrom(base)); err != nil {
+				return 0, err
+			}
+			orphanedSince = i.Annotations[networkingv1alpha1.SwitchInterfaceOrphanedSinceAnnotation]
+		}
+
+		since, err := time.Parse(time.RFC3339, orphanedSince)
+		if err != nil {
+			since = now
+		}
+		if remaining := since.Add(gracePeriod).Sub(now); remaining > 0 {
+			if requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+			continue
+		}
+
+		log.Info("Deleting orphaned SwitchInterface", "SwitchInterface", i.Name, "OrphanedSince", orphanedSince)
+		if err := r.Delete(ctx, &i); client.IgnoreNotFound(err) != nil {
+			return 0, err
+		}
+	}
+
+	return requeueAfter, nil
+}
+
+// reconcilePorts reports the ports of the switch with their breakout mode and the
+// SwitchInterfaces belonging to them, and compares them with s.spec.Ports.
+func (r *SwitchReconciler) reconcilePorts(ctx context.Context, s *networkingv1alpha1.Switch, portList *agent.PortList, breakouts map[string]agent.PortBreakout) error {
+	switchInterfaces := &networkingv1alpha1.SwitchInterfaceList{}
+	if err := r.List(ctx, switchInterfaces); err != nil {
+		return err
+	}
+	interfacesByNativeName := map[string][]corev1.LocalObjectReference{}
+	for _, i := range switchInterfaces.Items {
+		if metav1.IsControlledBy(&i, s) {
+			interfacesByNativeName[i.Spec.NativeName] = append(interfacesByNativeName[i.Spec.NativeName], corev1.LocalObjectReference{Name: i.Name})
+		}
+	}
+
+	observed := sets.New[string]()
+	s.Status.Ports = make([]networkingv1alpha1.PortStatus, 0, len(portList.Items))
 	for _, p := range portList.Items {
-		s.Status.Ports = append(s.Status.Ports, networkingv1alpha1.PortStatus{Name: p.Name})
+		observed.Insert(p.Name)
+
+		// A port broken out into several interfaces owns all of them
+		nativeNames := []string{p.Name}
+		if breakout, ok := breakouts[p.Name]; ok && len(breakout.Ports) > 0 {
+			nativeNames = slices.SortedFunc(slices.Values(breakout.Ports), comparePortNames)
+		}
+		// The refs are ordered by the port of the interface, then by name
+		var interfaceRefs []corev1.LocalObjectReference
+		for _, nativeName := range nativeNames {
+			refs := interfacesByNativeName[nativeName]
+			slices.SortFunc(refs, func(a, b corev1.LocalObjectReference) int {
+				return strings.Compare(a.Name, b.Name)
+			})
+			interfaceRefs = append(interfaceRefs, refs...)
+		}
+
+		s.Status.Ports = append(s.Status.Ports, networkingv1alpha1.PortStatus{
+			Name:          p.Name,
+			BreakoutMode:  breakouts[p.Name].Mode,
+			InterfaceRefs: interfaceRefs,
+		})
+	}
+	slices.SortFunc(s.Status.Ports, func(a, b networkingv1alpha1.PortStatus) int {
+		return comparePortNames(a.Name, b.Name)
+	})
+
+	if len(s.Spec.Ports) == 0 {
+		setCondition(r.Recorder, s, &s.Status.Conditions, metav1.Condition{
+			Type:    networkingv1alpha1.SwitchConditionPortsMatched,
+			Status:  metav1.ConditionUnknown,
+			Reason:  networkingv1alpha1.SwitchReasonPortsNotDeclared,
+			Message: "No ports are declared in the spec",
+		})
+		return nil
 	}
 
-	s.Status.State = networkingv1alpha1.SwitchStateReady
+	declared := sets.New[string]()
+	for _, port := range s.Spec.Ports {
+		declared.Insert(port.Name)
+	}
+	missing := sets.List(declared.Difference(observed))
+	undeclared := sets.List(observed.Difference(declared))
+	slices.SortFunc(missing, comparePortNames)
+	slices.SortFunc(undeclared, comparePortNames)
+
+	if len(missing) == 0 && len(undeclared) == 0 {
+		setCondition(r.Recorder, s, &s.Status.Conditions, metav1.Condition{
+			Type:    networkingv1alpha1.SwitchConditionPortsMatched,
+			Status:  metav1.ConditionTrue,
+			Reason:  networkingv1alpha1.SwitchReasonPortsMatched,
+			Message: fmt.Sprintf("All %d declared ports exist", declared.Len()),
+		})
+		return nil
+	}
 
-	// TODO: ensure s.spec is applied
+	var drift []string
+	if len(missing) > 0 {
+		drift = append(drift, fmt.Sprintf("missing ports: %s", strings.Join(missing, ", ")))
+	}
+	if len(undeclared) > 0 {
+		drift = append(drift, fmt.Sprintf("undeclared ports: %s", strings.Join(undeclared, ", ")))
+	}
+	message := strings.Join(drift, "; ")
+	setCondition(r.Recorder, s, &s.Status.Conditions, metav1.Condition{
+		Type:    networkingv1alpha1.SwitchConditionPortsMatched,
+		Status:  metav1.ConditionFalse,
+		Reason:  networkingv1alpha1.SwitchReasonPortsDrifted,
+		Message: message,
+	})
+
+	if r.RequirePortsMatched {
+		return fmt.Errorf("ports do not match the spec: %s", message)
+	}
+	return nil
+}
 
-	log.Info("Reconciled Switch")
-	return ctrl.Result{}, nil
+// comparePortNames orders port names with a common prefix by their number, e.g. Ethernet4 before Ethernet12.
+func comparePortNames(a, b string) int {
+	return cmp.Or(cmp.Compare(len(a), len(b)), strings.Compare(a, b))
+}
+
+// reconcileBreakouts applies the breakout modes requested in the Switch spec and
+// deletes the SwitchInterfaces of ports which vanished due to a breakout change.
+// It returns the breakout mode of each port after reconciliation.
+func (r *SwitchReconciler) reconcileBreakouts(ctx context.Context, log logr.Logger, s *networkingv1alpha1.Switch, switchAgentClient agentCli.SwitchAgentClient) (map[string]agent.PortBreakout, error) {
+	modes := map[string]string{}
+	for _, port := range s.Spec.Ports {
+		if port.BreakoutMode != "" {
+			modes[port.Name] = port.BreakoutMode
+		}
+	}
+
+	breakoutList, err := switchAgentClient.ListPortBreakouts(ctx)
+	if err != nil {
+		// Platforms without breakout definitions are fine as long as no breakout is requested
+		if len(modes) == 0 && breakoutList != nil && breakoutList.Status.Code == agenterrors.NOT_FOUND {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list port breakouts: %w", err)
+	}
+
+	breakouts := make(map[string]agent.PortBreakout, len(breakoutList.Items))
+	removedPorts := sets.New[string]()
+	for _, breakout := range breakoutList.Items {
+		breakouts[breakout.Port] = breakout
+
+		mode, ok := modes[breakout.Port]
+		if !ok || mode == breakout.Mode {
+			continue
+		}
+
+		log.Info("Applying port breakout", "Port", breakout.Port, "From", breakout.Mode, "To", mode)
+		applied, err := switchAgentClient.SetPortBreakout(ctx, &agent.PortBreakout{
+			Port: breakout.Port,
+			Mode: mode,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to set breakout mode of port %s: %w", breakout.Port, err)
+		}
+		breakouts[breakout.Port] = *applied
+		removedPorts.Insert(breakout.Ports...)
+		removedPorts.Delete(applied.Ports...)
+	}
+
+	for port := range modes {
+		if _, ok := breakouts[port]; !ok {
+			return nil, fmt.Errorf("port %s does not support breakout", port)
+		}
+	}
+
+	if removedPorts.Len() == 0 {
+		return breakouts, nil
+	}
+
+	switchInterfaces := &networkingv1alpha1.SwitchInterfaceList{}
+	if err := r.List(ctx, switchInterfaces); err != nil {
+		return nil, err
+	}
+	for _, i := range switchInterfaces.Items {
+		if !metav1.IsControlledBy(&i, s) || !removedPorts.Has(i.Spec.NativeName) {
+			continue
+		}
+		log.Info("Deleting SwitchInterface of removed port", "SwitchInterface", i.Name, "NativeName", i.Spec.NativeName)
+		if err := r.Delete(ctx, &i); client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+	}
+
+	return breakouts, nil
 }
 
 func (r *SwitchReconciler) EnsureInterface(ctx context.Context, log logr.Logger, s *networkingv1alpha1.Switch, iface agent.Interface) error {
@@ -176,11 +636,41 @@ func (r *SwitchReconciler) EnsureInterface(ctx context.Context, log logr.Logger,
 	return nil
 }
 
+// enqueueBySwitchCredentials enqueues all Switches referencing the given SwitchCredentials.
+// Their interface watches are stopped so that they are re-established with the new credentials.
+func (r *SwitchReconciler) enqueueBySwitchCredentials(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	switches := &networkingv1alpha1.SwitchList{}
+	if err := r.List(ctx, switches); err != nil {
+		log.Error(err, "Failed to list Switches")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, s := range switches.Items {
+		if s.Spec.Management.Credentials.Name != obj.GetName() {
+			continue
+		}
+		if r.InterfaceWatcher != nil {
+			r.InterfaceWatcher.Unwatch(s.Name)
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&s)})
+	}
+	return requests
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *SwitchReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&networkingv1alpha1.Switch{}).
 		Owns(&networkingv1alpha1.SwitchInterface{}).
+		Watches(
+			&networkingv1alpha1.SwitchCredentials{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueBySwitchCredentials),
+			// Ignore status updates of the SwitchCredentials reconciler.
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 		Named("switch").
 		Complete(r)
 }
@@ -5,16 +5,29 @@ package controller
 
 import (
 	"context"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+	switchUtil "github.com/ironcore-dev/sonic-operator/internal/switch_util"
 )
 
 var _ = Describe("Switch Controller", func() {
@@ -69,3 +82,251 @@ var _ = Describe("Switch Controller", func() {
 		})
 	})
 })
+
+var _ = Describe("Switch platform health conditions", func() {
+	var (
+		s        *networkingv1alpha1.Switch
+		recorder *events.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		s = &networkingv1alpha1.Switch{ObjectMeta: metav1.ObjectMeta{Name: "health-test", Generation: 1}}
+		recorder = events.NewFakeRecorder(10)
+	})
+
+	healthy := &agent.PlatformHealth{
+		PSUs:         []agent.PSU{{Name: "PSU 1", Presence: true, OK: true}, {Name: "PSU 2", Presence: true, OK: true}},
+		Fans:         []agent.Fan{{Name: "FAN 1", Presence: true, OK: true}},
+		Temperatures: []agent.TemperatureSensor{{Name: "CPU", Temperature: "40"}},
+	}
+
+	It("should set the conditions to Unknown if the platform health cannot be read", func() {
+		setPlatformHealthUnknown(recorder, s, status.Error(codes.Unimplemented, "unknown method GetPlatformHealth"))
+
+		for _, conditionType := range []string{
+			networkingv1alpha1.SwitchConditionPowerRedundant,
+			networkingv1alpha1.SwitchConditionFansHealthy,
+			networkingv1alpha1.SwitchConditionThermalOK,
+		} {
+			condition := meta.FindStatusCondition(s.Status.Conditions, conditionType)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionUnknown))
+			Expect(condition.ObservedGeneration).To(Equal(int64(1)))
+			Expect(condition.Message).To(ContainSubstring("unknown method GetPlatformHealth"))
+		}
+	})
+
+	It("should record events on transitions only", func() {
+		setPlatformHealthConditions(recorder, s, healthy)
+		Expect(recorder.Events).To(BeEmpty())
+
+		failed := *healthy
+		failed.Fans = []agent.Fan{{Name: "FAN 1", Presence: true, OK: false}}
+		setPlatformHealthConditions(recorder, s, &failed)
+		Expect(recorder.Events).To(Receive(ContainSubstring("FansHealthy is False: Unhealthy: FAN 1")))
+
+		setPlatformHealthConditions(recorder, s, &failed)
+		Expect(recorder.Events).To(BeEmpty())
+
+		setPlatformHealthUnknown(recorder, s, status.Error(codes.Unavailable, "connection refused"))
+		Expect(recorder.Events).To(HaveLen(3))
+	})
+})
+
+var _ = Describe("Switch port breakouts", func() {
+	const switchName = "breakout-switch"
+
+	ctx := context.Background()
+
+	var (
+		fake       *fakeAgent
+		s          *networkingv1alpha1.Switch
+		reconciler *SwitchReconciler
+	)
+
+	// createInterface creates a SwitchInterface controlled by the Switch.
+	createInterface := func(nativeName string) *networkingv1alpha1.SwitchInterface {
+		i := &networkingv1alpha1.SwitchInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: strings.ToLower(switchName + "-" + nativeName)},
+			Spec: networkingv1alpha1.SwitchInterfaceSpec{
+				NativeName: nativeName,
+				SwitchRef:  &corev1.LocalObjectReference{Name: switchName},
+			},
+		}
+		Expect(controllerutil.SetControllerReference(s, i, k8sClient.Scheme())).To(Succeed())
+		Expect(k8sClient.Create(ctx, i)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, i))).To(Succeed())
+		})
+		return i
+	}
+
+	reconcileBreakouts := func(ports ...networkingv1alpha1.PortSpec) (map[string]agent.PortBreakout, error) {
+		s.Spec.Ports = ports
+		switchAgentClient, err := switchUtil.NewAgentClientForSwitch(ctx, k8sClient, s)
+		Expect(err).NotTo(HaveOccurred())
+		return reconciler.reconcileBreakouts(ctx, logr.Discard(), s, switchAgentClient)
+	}
+
+	BeforeEach(func() {
+		fake = newFakeAgent()
+		fake.breakouts["Ethernet0"] = &agent.PortBreakout{
+			Port:           "Ethernet0",
+			Mode:           "4x25G[10G]",
+			DefaultMode:    "1x100G[40G]",
+			SupportedModes: []string{"1x100G[40G]", "4x25G[10G]"},
+			Ports:          []string{"Ethernet0", "Ethernet1", "Ethernet2", "Ethernet3"},
+		}
+		fake.breakoutPorts["1x100G[40G]"] = []string{"Ethernet0"}
+		fake.breakoutPorts["4x25G[10G]"] = []string{"Ethernet0", "Ethernet1", "Ethernet2", "Ethernet3"}
+		host, port := startFakeAgent(switchName, fake)
+
+		s = &networkingv1alpha1.Switch{
+			ObjectMeta: metav1.ObjectMeta{Name: switchName},
+			Spec: networkingv1alpha1.SwitchSpec{
+				Management: networkingv1alpha1.Management{Host: host, Port: port},
+				MacAddress: "aa:bb:cc:dd:ee:ff",
+			},
+		}
+		Expect(k8sClient.Create(ctx, s)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, s))).To(Succeed())
+		})
+
+		reconciler = &SwitchReconciler{
+			Client: k8sClient,
+			Scheme: k8sClient.Scheme(),
+		}
+	})
+
+	It("should apply the requested mode and delete the SwitchInterfaces of the removed ports", func() {
+		kept := createInterface("Ethernet0")
+		removed := createInterface("Ethernet2")
+
+		breakouts, err := reconcileBreakouts(networkingv1alpha1.PortSpec{Name: "Ethernet0", BreakoutMode: "1x100G[40G]"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(breakouts["Ethernet0"].Mode).To(Equal("1x100G[40G]"))
+		Expect(breakouts["Ethernet0"].Ports).To(Equal([]string{"Ethernet0"}))
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(kept), kept)).To(Succeed())
+		Expect(errors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(removed), removed))).To(BeTrue())
+	})
+
+	It("should order the interface refs of a port by the port of the interface", func() {
+		for name, nativeName := range map[string]string{
+			"breakout-b":  "Ethernet2",
+			"breakout-aa": "Ethernet10",
+			"breakout-z":  "Ethernet0",
+			"breakout-a":  "Ethernet0",
+		} {
+			i := &networkingv1alpha1.SwitchInterface{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: networkingv1alpha1.SwitchInterfaceSpec{
+					NativeName: nativeName,
+					SwitchRef:  &corev1.LocalObjectReference{Name: switchName},
+				},
+			}
+			Expect(controllerutil.SetControllerReference(s, i, k8sClient.Scheme())).To(Succeed())
+			Expect(k8sClient.Create(ctx, i)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, i))).To(Succeed())
+			})
+		}
+
+		portList := &agent.PortList{Items: []agent.Port{{Name: "Ethernet0"}}}
+		breakouts := map[string]agent.PortBreakout{
+			"Ethernet0": {Port: "Ethernet0", Mode: "4x25G[10G]", Ports: []string{"Ethernet10", "Ethernet0", "Ethernet2"}},
+		}
+		Expect(reconciler.reconcilePorts(ctx, s, portList, breakouts)).To(Succeed())
+		Expect(s.Status.Ports).To(HaveLen(1))
+		Expect(s.Status.Ports[0].InterfaceRefs).To(Equal([]corev1.LocalObjectReference{
+			{Name: "breakout-a"}, {Name: "breakout-z"}, {Name: "breakout-b"}, {Name: "breakout-aa"},
+		}))
+	})
+
+	It("should keep ports already in the requested mode", func() {
+		breakouts, err := reconcileBreakouts(networkingv1alpha1.PortSpec{Name: "Ethernet0", BreakoutMode: "4x25G[10G]"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(breakouts["Ethernet0"].Ports).To(HaveLen(4))
+	})
+
+	It("should fail for modes the platform does not support", func() {
+		_, err := reconcileBreakouts(networkingv1alpha1.PortSpec{Name: "Ethernet0", BreakoutMode: "2x50G"})
+		Expect(err).To(MatchError(ContainSubstring("failed to set breakout mode of port Ethernet0")))
+	})
+
+	It("should fail for ports without breakout definitions", func() {
+		_, err := reconcileBreakouts(networkingv1alpha1.PortSpec{Name: "Ethernet4", BreakoutMode: "1x100G[40G]"})
+		Expect(err).To(MatchError("port Ethernet4 does not support breakout"))
+	})
+
+	It("should accept platforms without breakout definitions unless a breakout is requested", func() {
+		fake.mu.Lock()
+		fake.breakouts = map[string]*agent.PortBreakout{}
+		fake.mu.Unlock()
+
+		breakouts, err := reconcileBreakouts(networkingv1alpha1.PortSpec{Name: "Ethernet0"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(breakouts).To(BeEmpty())
+
+		_, err = reconcileBreakouts(networkingv1alpha1.PortSpec{Name: "Ethernet0", BreakoutMode: "1x100G[40G]"})
+		Expect(err).To(MatchError(ContainSubstring("failed to list port breakouts")))
+	})
+})
+
+var _ = Describe("Switch deletion", func() {
+	ctx := context.Background()
+
+	It("should wait for its SwitchInterfaces until the deletion timeout", func() {
+		s := &networkingv1alpha1.Switch{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "deletion-switch",
+				Finalizers: []string{networkingv1alpha1.SwitchFinalizer},
+			},
+			Spec: networkingv1alpha1.SwitchSpec{MacAddress: "aa:bb:cc:dd:ee:ff"},
+		}
+		Expect(k8sClient.Create(ctx, s)).To(Succeed())
+
+		// the interface cannot be cleaned up, so its finalizer stays
+		i := &networkingv1alpha1.SwitchInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "deletion-switch-ethernet0",
+				Finalizers: []string{networkingv1alpha1.SwitchFinalizer},
+			},
+			Spec: networkingv1alpha1.SwitchInterfaceSpec{
+				NativeName: "Ethernet0",
+				SwitchRef:  &corev1.LocalObjectReference{Name: s.Name},
+			},
+		}
+		Expect(controllerutil.SetControllerReference(s, i, k8sClient.Scheme())).To(Succeed())
+		Expect(k8sClient.Create(ctx, i)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(client.IgnoreNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(i), i))).To(Succeed())
+			i.Finalizers = nil
+			Expect(client.IgnoreNotFound(k8sClient.Update(ctx, i))).To(Succeed())
+		})
+
+		recorder := events.NewFakeRecorder(10)
+		reconciler := &SwitchReconciler{
+			Client:          k8sClient,
+			Scheme:          k8sClient.Scheme(),
+			Recorder:        recorder,
+			DeletionTimeout: time.Hour,
+		}
+		Expect(k8sClient.Delete(ctx, s)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(s)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(i), i)).To(Succeed())
+		Expect(i.DeletionTimestamp).NotTo(BeNil())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(s), s)).To(Succeed())
+
+		By("removing the finalizer once the deletion timed out")
+		reconciler.DeletionTimeout = time.Nanosecond
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(s)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(errors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(s), s))).To(BeTrue())
+		Expect(recorder.Events).To(Receive(ContainSubstring("1 SwitchInterfaces remaining")))
+	})
+})
@@ -0,0 +1,280 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/ironcore-dev/controller-utils/clientutils"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	agentCli "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+	switchUtil "github.com/ironcore-dev/sonic-operator/internal/switch_util"
+)
+
+// SwitchBGPPeerReconciler reconciles a SwitchBGPPeer object
+type SwitchBGPPeerReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Recorder, if set, records events on condition transitions.
+	Recorder events.EventRecorder
+
+	// DeletionTimeout is the time after which the finalizer of a deleted SwitchBGPPeer is removed even
+	// if the neighbor could not be removed from the switch. Zero waits forever.
+	DeletionTimeout time.Duration
+
+	// ResyncInterval is the interval in which SwitchBGPPeers are reconciled again to report the
+	// session state, unless overridden by spec.pollInterval of their Switch.
+	ResyncInterval time.Duration
+
+	resync resyncPolicy
+}
+
+// +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchbgppeers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchbgppeers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchbgppeers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchinterfaces,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *SwitchBGPPeerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	peer := &networkingv1alpha1.SwitchBGPPeer{}
+	if err := r.Get(ctx, req.NamespacedName, peer); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	return r.reconileExists(ctx, log, peer)
+}
+
+func (r *SwitchBGPPeerReconciler) reconileExists(ctx context.Context, log logr.Logger, peer *networkingv1alpha1.SwitchBGPPeer) (ctrl.Result, error) {
+	if !peer.DeletionTimestamp.IsZero() {
+		return r.delete(ctx, log, peer)
+	}
+	return r.reconcile(ctx, log, peer)
+}
+
+func (r *SwitchBGPPeerReconciler) delete(ctx context.Context, log logr.Logger, peer *networkingv1alpha1.SwitchBGPPeer) (ctrl.Result, error) {
+	log.Info("Deleting SwitchBGPPeer")
+
+	if err := r.cleanup(ctx, log, peer); err != nil {
+		if !deletionTimedOut(peer, r.DeletionTimeout) {
+			return ctrl.Result{}, fmt.Errorf("failed to remove BGP neighbor %s: %w", peer.Status.NativeName, err)
+		}
+		log.Error(err, "Failed to remove BGP neighbor, removing finalizer after deletion timeout")
+		recordCleanupSkipped(r.Recorder, peer, err)
+	}
+
+	r.resync.forget(peer.Name)
+
+	if _, err := clientutils.PatchEnsureNoFinalizer(ctx, r.Client, peer, networkingv1alpha1.SwitchFinalizer); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Deleted SwitchBGPPeer")
+	return ctrl.Result{}, nil
+}
+
+// cleanup removes the neighbor from the switch.
+func (r *SwitchBGPPeerReconciler) cleanup(ctx context.Context, log logr.Logger, peer *networkingv1alpha1.SwitchBGPPeer) error {
+	// The neighbor can only be removed if it has been configured, which is recorded by the native name
+	if peer.Status.NativeName == "" {
+		return nil
+	}
+
+	switchAgentClient, err := switchUtil.NewAgentClientFromSwitchRef(ctx, r.Client, peer.Spec.SwitchRef, peer.Namespace)
+	if err != nil {
+		// The neighbor can only be removed from the switch as long as the switch exists
+		return client.IgnoreNotFound(err)
+	}
+
+	neighbor, err := findBGPNeighbor(ctx, switchAgentClient, peer.Status.NativeName)
+	if err != nil {
+		return err
+	}
+	if neighbor != nil && neighbor.Configured {
+		log.Info("Removing BGP neighbor", "interface", peer.Status.NativeName)
+		return switchAgentClient.RemoveBGPNeighbor(ctx, neighbor)
+	}
+	return nil
+}
+
+func (r *SwitchBGPPeerReconciler) reconcile(ctx context.Context, log logr.Logger, peer *networkingv1alpha1.SwitchBGPPeer) (result ctrl.Result, err error) {
+	log.Info("Reconciling SwitchBGPPeer")
+
+	if modified, err := clientutils.PatchEnsureFinalizer(ctx, r.Client, peer, networkingv1alpha1.SwitchFinalizer); err != nil || modified {
+		return ctrl.Result{}, err
+	}
+
+	original := peer.DeepCopy()
+	defer func() {
+		if err := r.Status().Patch(ctx, peer, client.MergeFrom(original)); err != nil {
+			log.Error(err, "Failed to update SwitchBGPPeer status")
+		}
+	}()
+
+	if peer.Status.State == "" {
+		peer.Status.State = networkingv1alpha1.SwitchBGPPeerStatePending
+		return ctrl.Result{}, nil
+	}
+
+	if peer.Spec.SwitchRef == nil || peer.Spec.InterfaceRef == nil {
+		peer.Status.State = networkingv1alpha1.SwitchBGPPeerStateFailed
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		setReadyConditions(r.Recorder, peer, &peer.Status.Conditions, err)
+		result, err = r.resync.result(peer.Name, r.pollInterval(ctx, peer), result, err)
+	}()
+
+	iface := &networkingv1alpha1.SwitchInterface{}
+	if err := r.Get(ctx, client.ObjectKey{Name: peer.Spec.InterfaceRef.Name, Namespace: peer.Namespace}, iface); err != nil {
+		peer.Status.State = networkingv1alpha1.SwitchBGPPeerStateFailed
+		return ctrl.Result{}, fmt.Errorf("failed to get SwitchInterface %s: %w", peer.Spec.InterfaceRef.Name, err)
+	}
+	if iface.Spec.SwitchRef == nil || iface.Spec.SwitchRef.Name != peer.Spec.SwitchRef.Name {
+		peer.Status.State = networkingv1alpha1.SwitchBGPPeerStateFailed
+		return ctrl.Result{}, fmt.Errorf("SwitchInterface %s does not belong to Switch %s", iface.Name, peer.Spec.SwitchRef.Name)
+	}
+
+	switchAgentClient, err := switchUtil.NewAgentClientFromSwitchRef(ctx, r.Client, peer.Spec.SwitchRef, peer.Namespace)
+	if err != nil {
+		peer.Status.State = networkingv1alpha1.SwitchBGPPeerStateFailed
+		return ctrl.Result{}, err
+	}
+
+	// remove the neighbor from the previous interface if the reference changed
+	if peer.Status.NativeName != "" && peer.Status.NativeName != iface.Spec.NativeName {
+		log.Info("Interface of the BGP peer changed, removing the previous neighbor", "interface", peer.Status.NativeName)
+		previous, err := findBGPNeighbor(ctx, switchAgentClient, peer.Status.NativeName)
+		if err != nil {
+			peer.Status.State = networkingv1alpha1.SwitchBGPPeerStateFailed
+			return ctrl.Result{}, err
+		}
+		if previous != nil && previous.Configured {
+			if err := switchAgentClient.RemoveBGPNeighbor(ctx, previous); err != nil {
+				peer.Status.State = networkingv1alpha1.SwitchBGPPeerStateFailed
+				return ctrl.Result{}, err
+			}
+		}
+	}
+	peer.Status.NativeName = iface.Spec.NativeName
+
+	neighbor, err := findBGPNeighbor(ctx, switchAgentClient, iface.Spec.NativeName)
+	if err != nil {
+		peer.Status.State = networkingv1alpha1.SwitchBGPPeerStateFailed
+		return ctrl.Result{}, err
+	}
+
+	if neighbor == nil || !neighbor.Configured || neighbor.RemoteAS != peer.Spec.RemoteAS || neighbor.Name != peer.Spec.Description {
+		if neighbor != nil && neighbor.Configured {
+			log.Info("BGP neighbor does not match the spec, re-creating it", "interface", iface.Spec.NativeName)
+			if err := switchAgentClient.RemoveBGPNeighbor(ctx, neighbor); err != nil {
+				peer.Status.State = networkingv1alpha1.SwitchBGPPeerStateFailed
+				return ctrl.Result{}, err
+			}
+		}
+
+		log.Info("Adding BGP neighbor", "interface", iface.Spec.NativeName, "remoteAS", peer.Spec.RemoteAS)
+		if _, err := switchAgentClient.AddBGPNeighbor(ctx, &agent.BGPNeighbor{
+			TypeMeta: agent.TypeMeta{
+				Kind: agent.BGPNeighborKind,
+			},
+			Neighbor: iface.Spec.NativeName,
+			RemoteAS: peer.Spec.RemoteAS,
+			Name:     peer.Spec.Description,
+		}); err != nil {
+			peer.Status.State = networkingv1alpha1.SwitchBGPPeerStateFailed
+			return ctrl.Result{}, err
+		}
+
+		// fetch the neighbor again to report the session state
+		if neighbor, err = findBGPNeighbor(ctx, switchAgentClient, iface.Spec.NativeName); err != nil {
+			peer.Status.State = networkingv1alpha1.SwitchBGPPeerStateFailed
+			return ctrl.Result{}, err
+		}
+	}
+
+	peer.Status.SessionState = ""
+	peer.Status.PrefixesReceived = 0
+	peer.Status.Uptime = ""
+	if neighbor != nil {
+		peer.Status.SessionState = neighbor.State
+		peer.Status.PrefixesReceived = int64(neighbor.PrefixesReceived)
+		peer.Status.Uptime = neighbor.Uptime
+	}
+	peer.Status.State = networkingv1alpha1.SwitchBGPPeerStateReady
+
+	log.Info("Reconciled SwitchBGPPeer")
+	return ctrl.Result{}, nil
+}
+
+// pollInterval returns the poll interval of the Switch the SwitchBGPPeer belongs to,
+// or the resync interval of the reconciler if it is not set.
+func (r *SwitchBGPPeerReconciler) pollInterval(ctx context.Context, peer *networkingv1alpha1.SwitchBGPPeer) time.Duration {
+	s := &networkingv1alpha1.Switch{}
+	if err := r.Get(ctx, client.ObjectKey{Name: peer.Spec.SwitchRef.Name}, s); err != nil {
+		return r.ResyncInterval
+	}
+	return pollInterval(s, r.ResyncInterval)
+}
+
+// findBGPNeighbor returns the BGP neighbor of the given interface, or nil if there is none.
+func findBGPNeighbor(ctx context.Context, switchAgentClient agentCli.SwitchAgentClient, nativeName string) (*agent.BGPNeighbor, error) {
+	neighbors, err := switchAgentClient.ListBGPNeighbors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, neighbor := range neighbors.Items {
+		if neighbor.Neighbor == nativeName {
+			return &neighbor, nil
+		}
+	}
+	return nil, nil
+}
+
+// enqueueBySwitchInterface enqueues all SwitchBGPPeers referencing the given SwitchInterface.
+func (r *SwitchBGPPeerReconciler) enqueueBySwitchInterface(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	peers := &networkingv1alpha1.SwitchBGPPeerList{}
+	if err := r.List(ctx, peers); err != nil {
+		log.Error(err, "Failed to list SwitchBGPPeers")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, peer := range peers.Items {
+		if peer.Spec.InterfaceRef != nil && peer.Spec.InterfaceRef.Name == obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&peer)})
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SwitchBGPPeerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1alpha1.SwitchBGPPeer{}).
+		Watches(
+			&networkingv1alpha1.SwitchInterface{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueBySwitchInterface),
+		).
+		Named("switchbgppeer").
+		Complete(r)
+}
@@ -0,0 +1,260 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	agenterrors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+)
+
+var _ = Describe("SwitchBGPPeer Controller", func() {
+	const (
+		switchName   = "bgppeer-switch"
+		peerName     = "bgppeer-test"
+		pollInterval = 30 * time.Second
+	)
+
+	ctx := context.Background()
+
+	var (
+		fake       *fakeAgent
+		reconciler *SwitchBGPPeerReconciler
+	)
+
+	createInterface := func(name, nativeName, switchRef string) {
+		iface := &networkingv1alpha1.SwitchInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: networkingv1alpha1.SwitchInterfaceSpec{
+				Handle:     "eth1-" + strings.TrimPrefix(nativeName, "Ethernet"),
+				NativeName: nativeName,
+				SwitchRef:  &corev1.LocalObjectReference{Name: switchRef},
+			},
+		}
+		Expect(k8sClient.Create(ctx, iface)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, iface))).To(Succeed())
+		})
+	}
+
+	createPeer := func(interfaceRef, remoteAS string) *networkingv1alpha1.SwitchBGPPeer {
+		peer := &networkingv1alpha1.SwitchBGPPeer{
+			ObjectMeta: metav1.ObjectMeta{Name: peerName},
+			Spec: networkingv1alpha1.SwitchBGPPeerSpec{
+				SwitchRef:    &corev1.LocalObjectReference{Name: switchName},
+				InterfaceRef: &corev1.LocalObjectReference{Name: interfaceRef},
+				RemoteAS:     remoteAS,
+				Description:  "spine-1",
+			},
+		}
+		Expect(k8sClient.Create(ctx, peer)).To(Succeed())
+		DeferCleanup(func() {
+			current := &networkingv1alpha1.SwitchBGPPeer{}
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(peer), current); apierrors.IsNotFound(err) {
+				return
+			}
+			current.Finalizers = nil
+			Expect(k8sClient.Update(ctx, current)).To(Succeed())
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, current))).To(Succeed())
+		})
+		return peer
+	}
+
+	reconcilePeer := func(peer *networkingv1alpha1.SwitchBGPPeer) (ctrl.Result, error) {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(peer)})
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(peer), peer)).To(Succeed())
+		return result, err
+	}
+
+	// reconcileReady runs the reconciliations adding the finalizer, initializing the
+	// state and configuring the neighbor.
+	reconcileReady := func(peer *networkingv1alpha1.SwitchBGPPeer) (ctrl.Result, error) {
+		for range 2 {
+			_, err := reconcilePeer(peer)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		return reconcilePeer(peer)
+	}
+
+	BeforeEach(func() {
+		fake = newFakeAgent()
+		host, port := startFakeAgent(switchName, fake)
+
+		s := &networkingv1alpha1.Switch{
+			ObjectMeta: metav1.ObjectMeta{Name: switchName},
+			Spec: networkingv1alpha1.SwitchSpec{
+				Management:   networkingv1alpha1.Management{Host: host, Port: port},
+				MacAddress:   "aa:bb:cc:dd:ee:ff",
+				PollInterval: &metav1.Duration{Duration: pollInterval},
+			},
+		}
+		Expect(k8sClient.Create(ctx, s)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, s))).To(Succeed())
+		})
+
+		reconciler = &SwitchBGPPeerReconciler{
+			Client:         k8sClient,
+			Scheme:         k8sClient.Scheme(),
+			ResyncInterval: DefaultSwitchBGPPeerResyncInterval,
+		}
+	})
+
+	It("should add the neighbor, report the session state and resync with the poll interval", func() {
+		createInterface("bgppeer-eth0", "Ethernet0", switchName)
+		peer := createPeer("bgppeer-eth0", "65000")
+
+		result, err := reconcileReady(peer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">=", pollInterval))
+		Expect(result.RequeueAfter).To(BeNumerically("<=", pollInterval+pollInterval/5))
+
+		neighbor := fake.bgpNeighbor("Ethernet0")
+		Expect(neighbor).NotTo(BeNil())
+		Expect(neighbor.RemoteAS).To(Equal("65000"))
+		Expect(neighbor.Name).To(Equal("spine-1"))
+
+		Expect(peer.Status.State).To(Equal(networkingv1alpha1.SwitchBGPPeerStateReady))
+		Expect(peer.Status.NativeName).To(Equal("Ethernet0"))
+		Expect(peer.Status.SessionState).To(Equal("Established"))
+		Expect(peer.Status.PrefixesReceived).To(Equal(int64(3)))
+		Expect(meta.IsStatusConditionTrue(peer.Status.Conditions, networkingv1alpha1.ConditionReady)).To(BeTrue())
+	})
+
+	It("should refresh the session state on resync", func() {
+		createInterface("bgppeer-eth0", "Ethernet0", switchName)
+		peer := createPeer("bgppeer-eth0", "65000")
+		_, err := reconcileReady(peer)
+		Expect(err).NotTo(HaveOccurred())
+
+		fake.mu.Lock()
+		fake.bgpNeighbors["Ethernet0"].State = "Active"
+		fake.bgpNeighbors["Ethernet0"].PrefixesReceived = 0
+		fake.mu.Unlock()
+
+		result, err := reconcilePeer(peer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).NotTo(BeZero())
+		Expect(peer.Status.SessionState).To(Equal("Active"))
+		Expect(peer.Status.PrefixesReceived).To(BeZero())
+	})
+
+	It("should re-create the neighbor if it does not match the spec", func() {
+		fake.bgpNeighbors["Ethernet0"] = &agent.BGPNeighbor{
+			Neighbor:   "Ethernet0",
+			RemoteAS:   "external",
+			Configured: true,
+		}
+		createInterface("bgppeer-eth0", "Ethernet0", switchName)
+		peer := createPeer("bgppeer-eth0", "65000")
+
+		_, err := reconcileReady(peer)
+		Expect(err).NotTo(HaveOccurred())
+
+		neighbor := fake.bgpNeighbor("Ethernet0")
+		Expect(neighbor).NotTo(BeNil())
+		Expect(neighbor.RemoteAS).To(Equal("65000"))
+		Expect(peer.Status.State).To(Equal(networkingv1alpha1.SwitchBGPPeerStateReady))
+	})
+
+	It("should move the neighbor if the interface reference changes", func() {
+		createInterface("bgppeer-eth0", "Ethernet0", switchName)
+		createInterface("bgppeer-eth4", "Ethernet4", switchName)
+		peer := createPeer("bgppeer-eth0", "65000")
+		_, err := reconcileReady(peer)
+		Expect(err).NotTo(HaveOccurred())
+
+		peer.Spec.InterfaceRef.Name = "bgppeer-eth4"
+		Expect(k8sClient.Update(ctx, peer)).To(Succeed())
+		_, err = reconcilePeer(peer)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fake.bgpNeighbor("Ethernet0")).To(BeNil())
+		Expect(fake.bgpNeighbor("Ethernet4")).NotTo(BeNil())
+		Expect(peer.Status.NativeName).To(Equal("Ethernet4"))
+	})
+
+	It("should fail if the interface belongs to another Switch", func() {
+		createInterface("bgppeer-foreign", "Ethernet0", "other-switch")
+		peer := createPeer("bgppeer-foreign", "65000")
+
+		_, err := reconcileReady(peer)
+		Expect(err).To(MatchError(ContainSubstring("does not belong to Switch")))
+
+		Expect(fake.bgpNeighbor("Ethernet0")).To(BeNil())
+		Expect(peer.Status.State).To(Equal(networkingv1alpha1.SwitchBGPPeerStateFailed))
+		Expect(meta.IsStatusConditionFalse(peer.Status.Conditions, networkingv1alpha1.ConditionReady)).To(BeTrue())
+	})
+
+	It("should remove the neighbor from the switch when deleted", func() {
+		createInterface("bgppeer-eth0", "Ethernet0", switchName)
+		peer := createPeer("bgppeer-eth0", "65000")
+		_, err := reconcileReady(peer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fake.bgpNeighbor("Ethernet0")).NotTo(BeNil())
+
+		Expect(k8sClient.Delete(ctx, peer)).To(Succeed())
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(peer)})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fake.bgpNeighbor("Ethernet0")).To(BeNil())
+		Expect(apierrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(peer), peer))).To(BeTrue())
+	})
+
+	It("should keep the finalizer while the neighbor cannot be removed", func() {
+		createInterface("bgppeer-eth0", "Ethernet0", switchName)
+		peer := createPeer("bgppeer-eth0", "65000")
+		_, err := reconcileReady(peer)
+		Expect(err).NotTo(HaveOccurred())
+
+		fake.mu.Lock()
+		fake.failStatus = agenterrors.NewErrorStatus(agenterrors.SERVER_ERROR, "vtysh failed")
+		fake.mu.Unlock()
+
+		reconciler.DeletionTimeout = time.Hour
+		Expect(k8sClient.Delete(ctx, peer)).To(Succeed())
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(peer)})
+		Expect(err).To(MatchError(ContainSubstring("failed to remove BGP neighbor Ethernet0")))
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(peer), peer)).To(Succeed())
+		Expect(peer.Finalizers).To(ContainElement(networkingv1alpha1.SwitchFinalizer))
+	})
+
+	It("should remove the finalizer after the deletion timeout", func() {
+		createInterface("bgppeer-eth0", "Ethernet0", switchName)
+		peer := createPeer("bgppeer-eth0", "65000")
+		_, err := reconcileReady(peer)
+		Expect(err).NotTo(HaveOccurred())
+
+		fake.mu.Lock()
+		fake.failStatus = agenterrors.NewErrorStatus(agenterrors.SERVER_ERROR, "vtysh failed")
+		fake.mu.Unlock()
+
+		recorder := events.NewFakeRecorder(10)
+		reconciler.Recorder = recorder
+		reconciler.DeletionTimeout = time.Nanosecond
+		Expect(k8sClient.Delete(ctx, peer)).To(Succeed())
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(peer)})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(apierrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(peer), peer))).To(BeTrue())
+		Expect(fake.bgpNeighbor("Ethernet0")).NotTo(BeNil())
+		Expect(recorder.Events).To(Receive(ContainSubstring("CleanupSkipped")))
+	})
+})
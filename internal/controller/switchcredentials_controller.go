@@ -5,42 +5,99 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	switchUtil "github.com/ironcore-dev/sonic-operator/internal/switch_util"
 )
 
 // SwitchCredentialsReconciler reconciles a SwitchCredentials object
 type SwitchCredentialsReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// Recorder, if set, records events on condition transitions.
+	Recorder events.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchcredentials,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchcredentials/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchcredentials/finalizers,verbs=update
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the SwitchCredentials object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
-//
-// For more details, check Reconcile and its Result here:
-// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.22.1/pkg/reconcile
+// Reconcile validates the SwitchCredentials and reports the result as Valid condition.
 func (r *SwitchCredentialsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = logf.FromContext(ctx)
+	log := logf.FromContext(ctx)
+	sc := &networkingv1alpha1.SwitchCredentials{}
+	if err := r.Get(ctx, req.NamespacedName, sc); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	log.Info("Reconciling SwitchCredentials")
+
+	scBase := sc.DeepCopy()
+
+	condition := metav1.Condition{
+		Type:               networkingv1alpha1.SwitchCredentialsConditionValid,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: sc.Generation,
+		Reason:             networkingv1alpha1.SwitchCredentialsReasonValid,
+		Message:            "All required keys are present and valid",
+	}
+	if reason, message := validateSwitchCredentials(sc); reason != "" {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reason
+		condition.Message = message
+	}
+	setCondition(r.Recorder, sc, &sc.Status.Conditions, condition)
 
-	// TODO(user): your logic here
+	if err := r.Status().Patch(ctx, sc, client.MergeFrom(scBase)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to patch SwitchCredentials status: %w", err)
+	}
 
+	log.Info("Reconciled SwitchCredentials", "Valid", condition.Status)
 	return ctrl.Result{}, nil
 }
 
+// validateSwitchCredentials returns the reason and message why the credentials
+// cannot be used to connect to a switch agent, or an empty reason if they can.
+func validateSwitchCredentials(sc *networkingv1alpha1.SwitchCredentials) (string, string) {
+	var missing []string
+	for _, key := range []string{
+		switchUtil.CredentialsCACertKey,
+		switchUtil.CredentialsCertKey,
+		switchUtil.CredentialsKeyKey,
+		switchUtil.CredentialsTokenKey,
+	} {
+		_, inData := sc.Data[key]
+		_, inStringData := sc.StringData[key]
+		if !inData && !inStringData {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return networkingv1alpha1.SwitchCredentialsReasonMissingKeys, fmt.Sprintf("Missing keys: %s", strings.Join(missing, ", "))
+	}
+
+	creds := switchUtil.CredentialsFromSwitchCredentials(sc)
+	if creds.Token == "" {
+		return networkingv1alpha1.SwitchCredentialsReasonInvalidData, fmt.Sprintf("Key %s is empty", switchUtil.CredentialsTokenKey)
+	}
+	if _, err := creds.DialOptions(); err != nil {
+		return networkingv1alpha1.SwitchCredentialsReasonInvalidData, err.Error()
+	}
+
+	return "", ""
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *SwitchCredentialsReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -5,29 +5,62 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/ironcore-dev/controller-utils/clientutils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	agentCli "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
 	agenterrors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
 	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
 	switchUtil "github.com/ironcore-dev/sonic-operator/internal/switch_util"
 )
 
+// defaultPortMTU is the MTU SONiC configures on ports by default.
+const defaultPortMTU = 9100
+
 // SwitchInterfaceReconciler reconciles a SwitchInterface object
 type SwitchInterfaceReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// InterfaceWatcher, if set, requeues SwitchInterfaces as soon as their state changes on the switch.
+	InterfaceWatcher *InterfaceWatcher
+
+	// Recorder, if set, records events on condition transitions.
+	Recorder events.EventRecorder
+
+	// DeletionTimeout is the time after which the finalizer of a deleted SwitchInterface is removed even if
+	// its deletion policy could not be applied. Zero waits forever.
+	DeletionTimeout time.Duration
+
+	// ResyncInterval is the interval in which SwitchInterfaces are reconciled again, unless overridden
+	// by spec.pollInterval of their Switch.
+	ResyncInterval time.Duration
+
+	resync resyncPolicy
 }
 
 // +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchinterfaces,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchinterfaces/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchinterfaces/finalizers,verbs=update
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -51,7 +84,15 @@ func (r *SwitchInterfaceReconciler) reconileExists(ctx context.Context, log logr
 func (r *SwitchInterfaceReconciler) delete(ctx context.Context, log logr.Logger, i *networkingv1alpha1.SwitchInterface) (ctrl.Result, error) {
 	log.Info("Deleting SwitchInterface")
 
-	// TODO: do cleanup
+	if err := r.cleanup(ctx, log, i); err != nil {
+		if !deletionTimedOut(i, r.DeletionTimeout) {
+			return ctrl.Result{}, fmt.Errorf("failed to clean up interface %s: %w", i.Spec.NativeName, err)
+		}
+		log.Error(err, "Failed to clean up interface, removing finalizer after deletion timeout")
+		recordCleanupSkipped(r.Recorder, i, err)
+	}
+
+	r.resync.forget(i.Name)
 
 	if _, err := clientutils.PatchEnsureNoFinalizer(ctx, r.Client, i, networkingv1alpha1.SwitchFinalizer); err != nil {
 		return ctrl.Result{}, err
@@ -61,7 +102,71 @@ func (r *SwitchInterfaceReconciler) delete(ctx context.Context, log logr.Logger,
 	return ctrl.Result{}, nil
 }
 
-func (r *SwitchInterfaceReconciler) reconcile(ctx context.Context, log logr.Logger, i *networkingv1alpha1.SwitchInterface) (ctrl.Result, error) {
+// cleanup applies the deletion policy of the SwitchInterface to the interface on the switch.
+func (r *SwitchInterfaceReconciler) cleanup(ctx context.Context, log logr.Logger, i *networkingv1alpha1.SwitchInterface) error {
+	// Orphaned interfaces no longer exist on the switch
+	if _, ok := i.Annotations[networkingv1alpha1.SwitchInterfaceOrphanedSinceAnnotation]; ok || i.Spec.SwitchRef == nil {
+		return nil
+	}
+
+	s := &networkingv1alpha1.Switch{}
+	if err := r.Get(ctx, client.ObjectKey{Name: i.Spec.SwitchRef.Name}, s); err != nil {
+		// The interface cannot be cleaned up without its switch
+		return client.IgnoreNotFound(err)
+	}
+
+	policy := i.Spec.DeletionPolicy
+	if policy == "" {
+		policy = s.Spec.DeletionPolicy
+	}
+	if policy == "" || policy == networkingv1alpha1.DeletionPolicyRetain {
+		return nil
+	}
+
+	switchAgentClient, err := switchUtil.NewAgentClientForSwitch(ctx, r.Client, s)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Applying deletion policy", "Policy", policy)
+	if _, err := switchAgentClient.SetInterfaceAdminStatus(ctx, &agent.Interface{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceKind,
+		},
+		Name:        i.Spec.NativeName,
+		AdminStatus: agent.StatusDown,
+	}); err != nil {
+		return err
+	}
+
+	if policy == networkingv1alpha1.DeletionPolicyResetToDefault {
+		if _, err := switchAgentClient.SetInterfacePortAttributes(ctx, &agent.Interface{
+			TypeMeta: agent.TypeMeta{
+				Kind: agent.InterfaceKind,
+			},
+			Name: i.Spec.NativeName,
+			MTU:  defaultPortMTU,
+		}); err != nil {
+			return err
+		}
+
+		if i.Status.DefaultAliasName != "" {
+			if _, err := switchAgentClient.SetInterfaceAliasName(ctx, &agent.Interface{
+				TypeMeta: agent.TypeMeta{
+					Kind: agent.InterfaceKind,
+				},
+				Name:      i.Spec.NativeName,
+				AliasName: i.Status.DefaultAliasName,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return switchAgentClient.SaveConfig(ctx)
+}
+
+func (r *SwitchInterfaceReconciler) reconcile(ctx context.Context, log logr.Logger, i *networkingv1alpha1.SwitchInterface) (result ctrl.Result, err error) {
 	log.Info("Reconciling SwitchInterface")
 
 	if modified, err := clientutils.PatchEnsureFinalizer(ctx, r.Client, i, networkingv1alpha1.SwitchFinalizer); err != nil || modified {
@@ -85,6 +190,18 @@ func (r *SwitchInterfaceReconciler) reconcile(ctx context.Context, log logr.Logg
 		return ctrl.Result{}, nil
 	}
 
+	// The interface no longer exists on the switch, the Switch reconciler deletes it after the grace period
+	if _, ok := i.Annotations[networkingv1alpha1.SwitchInterfaceOrphanedSinceAnnotation]; ok {
+		log.Info("SwitchInterface is orphaned, skipping reconciliation")
+		i.Status.State = networkingv1alpha1.SwitchInterfaceStateOrphaned
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		setReadyConditions(r.Recorder, i, &i.Status.Conditions, err)
+		result, err = r.resync.result(i.Name, r.pollInterval(ctx, i), result, err)
+	}()
+
 	switchAgentClient, err := switchUtil.NewAgentClientFromSwitchRef(ctx, r.Client, i.Spec.SwitchRef, i.Namespace)
 	if err != nil {
 		i.Status.State = networkingv1alpha1.SwitchInterfaceStateFailed
@@ -104,6 +221,9 @@ func (r *SwitchInterfaceReconciler) reconcile(ctx context.Context, log logr.Logg
 
 	if iface != nil {
 		if iface.AliasName != i.Spec.Handle {
+			if i.Status.DefaultAliasName == "" {
+				i.Status.DefaultAliasName = iface.AliasName
+			}
 			log.Info("Interface alias name does not match the expected handle, updating it", "expected", i.Spec.Handle, "actual", iface.AliasName)
 			if _, err := switchAgentClient.SetInterfaceAliasName(ctx, &agent.Interface{
 				TypeMeta: agent.TypeMeta{
@@ -141,16 +261,25 @@ func (r *SwitchInterfaceReconciler) reconcile(ctx context.Context, log logr.Logg
 		return ctrl.Result{}, err
 	}
 
-	var switchInterface *agent.Interface
-	if switchInterface, err = switchAgentClient.SetInterfaceAdminStatus(ctx, &agent.Interface{
-		TypeMeta: agent.TypeMeta{
-			Kind: agent.InterfaceKind,
-		},
-		Name:        i.Spec.NativeName,
-		AdminStatus: desired_state,
-	}); err != nil {
-		i.Status.State = networkingv1alpha1.SwitchInterfaceStateFailed
-		return ctrl.Result{}, err
+	switchInterface := iface
+	if iface.AdminStatus != desired_state {
+		log.Info("Interface admin state does not match the spec, updating it", "expected", desired_state, "actual", iface.AdminStatus)
+		if switchInterface, err = switchAgentClient.SetInterfaceAdminStatus(ctx, &agent.Interface{
+			TypeMeta: agent.TypeMeta{
+				Kind: agent.InterfaceKind,
+			},
+			Name:        i.Spec.NativeName,
+			AdminStatus: desired_state,
+		}); err != nil {
+			i.Status.State = networkingv1alpha1.SwitchInterfaceStateFailed
+			setCondition(r.Recorder, i, &i.Status.Conditions, metav1.Condition{
+				Type:    networkingv1alpha1.SwitchInterfaceConditionAdminStateSynced,
+				Status:  metav1.ConditionFalse,
+				Reason:  errorReason(err),
+				Message: err.Error(),
+			})
+			return ctrl.Result{}, err
+		}
 	}
 
 	if switchInterface != nil {
@@ -161,6 +290,19 @@ func (r *SwitchInterfaceReconciler) reconcile(ctx context.Context, log logr.Logg
 		}
 		i.Status.AdminState = adminState
 
+		synced := metav1.Condition{
+			Type:    networkingv1alpha1.SwitchInterfaceConditionAdminStateSynced,
+			Status:  metav1.ConditionTrue,
+			Reason:  networkingv1alpha1.SwitchInterfaceReasonSynced,
+			Message: fmt.Sprintf("The admin state is %s", adminState),
+		}
+		if adminState != i.Spec.AdminState {
+			synced.Status = metav1.ConditionFalse
+			synced.Reason = networkingv1alpha1.ReasonReconcileFailed
+			synced.Message = fmt.Sprintf("The admin state is %s instead of %s", adminState, i.Spec.AdminState)
+		}
+		setCondition(r.Recorder, i, &i.Status.Conditions, synced)
+
 		operationState, err := agent.AgentDeviceStatusToAPIOperationState(switchInterface.OperationStatus)
 		if err != nil {
 			i.Status.State = networkingv1alpha1.SwitchInterfaceStateFailed
@@ -174,6 +316,15 @@ func (r *SwitchInterfaceReconciler) reconcile(ctx context.Context, log logr.Logg
 			i.Status.OperationalState = networkingv1alpha1.OperationStateDown
 		}
 	}
+	if err := r.reconcilePortAttributes(ctx, log, i, switchAgentClient, iface); err != nil {
+		i.Status.State = networkingv1alpha1.SwitchInterfaceStateFailed
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileAddresses(ctx, log, i, switchAgentClient); err != nil {
+		i.Status.State = networkingv1alpha1.SwitchInterfaceStateFailed
+		return ctrl.Result{}, err
+	}
 	i.Status.State = networkingv1alpha1.SwitchInterfaceStateReady
 
 	neighbor, err := switchAgentClient.GetInterfaceNeighbor(ctx, &agent.Interface{
@@ -188,22 +339,225 @@ func (r *SwitchInterfaceReconciler) reconcile(ctx context.Context, log logr.Logg
 			return ctrl.Result{}, err
 		}
 		i.Status.Neighbor = networkingv1alpha1.Neighbor{}
+		setCondition(r.Recorder, i, &i.Status.Conditions, metav1.Condition{
+			Type:    networkingv1alpha1.SwitchInterfaceConditionNeighborDiscovered,
+			Status:  metav1.ConditionFalse,
+			Reason:  agenterrors.Reason(neighbor.Status.Code),
+			Message: neighbor.Status.Message,
+		})
 	} else {
 		i.Status.Neighbor = networkingv1alpha1.Neighbor{
 			MacAddress:      neighbor.MacAddress,
 			SystemName:      neighbor.SystemName,
 			InterfaceHandle: neighbor.Handle,
 		}
+		setCondition(r.Recorder, i, &i.Status.Conditions, metav1.Condition{
+			Type:    networkingv1alpha1.SwitchInterfaceConditionNeighborDiscovered,
+			Status:  metav1.ConditionTrue,
+			Reason:  networkingv1alpha1.SwitchInterfaceReasonNeighborFound,
+			Message: fmt.Sprintf("Connected to %s on %s", neighbor.SystemName, neighbor.Handle),
+		})
+	}
+
+	transceiver, err := switchAgentClient.GetTransceiver(ctx, &agent.Interface{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceKind,
+		},
+		Name: i.Spec.NativeName,
+	})
+	if err != nil {
+		if transceiver != nil && transceiver.Status.Code == agenterrors.NOT_FOUND {
+			i.Status.Transceiver = nil
+		} else {
+			// The transceiver data is informational and not provided by every agent or platform,
+			// so failing to read it must not fail the interface.
+			log.Error(err, "Failed to get transceiver, keeping the last known state")
+		}
+	} else {
+		i.Status.Transceiver = agent.AgentTransceiverToAPITransceiver(transceiver)
 	}
 
 	log.Info("Reconciled SwitchInterface")
 	return ctrl.Result{}, nil
 }
 
+// reconcilePortAttributes ensures the port attributes set in i.spec are
+// configured and reports the operational values of the port. The agent
+// compares the spec with the configured values, so it only writes and saves
+// the configuration when they differ.
+func (r *SwitchInterfaceReconciler) reconcilePortAttributes(ctx context.Context, log logr.Logger, i *networkingv1alpha1.SwitchInterface, switchAgentClient agentCli.SwitchAgentClient, iface *agent.Interface) error {
+	desired := &agent.Interface{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceKind,
+		},
+		Name:    i.Spec.NativeName,
+		MTU:     uint32(i.Spec.MTU),
+		Speed:   uint32(i.Spec.Speed),
+		FEC:     string(i.Spec.FEC),
+		Autoneg: agent.APIAutonegToAgentAutoneg(i.Spec.Autoneg),
+	}
+
+	if desired.MTU != 0 || desired.Speed != 0 || desired.FEC != "" || desired.Autoneg != "" {
+		log.V(1).Info("Ensuring port attributes", "mtu", desired.MTU, "speed", desired.Speed, "fec", desired.FEC, "autoneg", desired.Autoneg)
+		if _, err := switchAgentClient.SetInterfacePortAttributes(ctx, desired); err != nil {
+			return err
+		}
+	}
+
+	// the status reflects the values applied to the port, which follow the
+	// configuration once the port has been reconfigured
+	i.Status.MTU = int32(iface.MTU)
+	i.Status.Speed = int32(iface.Speed)
+	i.Status.FEC = networkingv1alpha1.FECMode(iface.FEC)
+	i.Status.Autoneg = agent.AgentAutonegToAPIAutoneg(iface.Autoneg)
+
+	return nil
+}
+
+// reconcileAddresses converges the addresses configured on the switch to
+// i.spec.Addresses and reports the addresses active on the interface.
+func (r *SwitchInterfaceReconciler) reconcileAddresses(ctx context.Context, log logr.Logger, i *networkingv1alpha1.SwitchInterface, switchAgentClient agentCli.SwitchAgentClient) error {
+	desired := make(map[string]bool, len(i.Spec.Addresses))
+	for _, address := range i.Spec.Addresses {
+		prefix, err := netip.ParsePrefix(address)
+		if err != nil {
+			return fmt.Errorf("invalid address %q: %w", address, err)
+		}
+		desired[prefix.String()] = true
+	}
+
+	addresses, err := switchAgentClient.ListInterfaceAddresses(ctx, &agent.Interface{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceKind,
+		},
+		Name: i.Spec.NativeName,
+	})
+	if err != nil {
+		return err
+	}
+
+	configured := make(map[string]bool, len(addresses.Items))
+	for _, address := range addresses.Items {
+		if !address.Configured {
+			continue
+		}
+		configured[address.Prefix] = true
+
+		if desired[address.Prefix] {
+			continue
+		}
+		log.Info("Removing interface address", "address", address.Prefix)
+		if err := switchAgentClient.RemoveInterfaceAddress(ctx, &address); err != nil {
+			return err
+		}
+	}
+
+	for _, address := range i.Spec.Addresses {
+		prefix := netip.MustParsePrefix(address).String()
+		if configured[prefix] {
+			continue
+		}
+		log.Info("Adding interface address", "address", prefix)
+		if _, err := switchAgentClient.AddInterfaceAddress(ctx, &agent.InterfaceAddress{
+			TypeMeta: agent.TypeMeta{
+				Kind: agent.InterfaceAddressKind,
+			},
+			Interface: i.Spec.NativeName,
+			Prefix:    prefix,
+		}); err != nil {
+			return err
+		}
+	}
+
+	// fetch the addresses again as APPL_DB reflects what has actually been applied
+	if addresses, err = switchAgentClient.ListInterfaceAddresses(ctx, &agent.Interface{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.InterfaceKind,
+		},
+		Name: i.Spec.NativeName,
+	}); err != nil {
+		return err
+	}
+
+	i.Status.Addresses = nil
+	for _, address := range addresses.Items {
+		if address.Active {
+			i.Status.Addresses = append(i.Status.Addresses, address.Prefix)
+		}
+	}
+
+	return nil
+}
+
+// pollInterval returns the poll interval of the Switch the SwitchInterface belongs to,
+// or the resync interval of the reconciler if it is not set.
+func (r *SwitchInterfaceReconciler) pollInterval(ctx context.Context, i *networkingv1alpha1.SwitchInterface) time.Duration {
+	s := &networkingv1alpha1.Switch{}
+	if err := r.Get(ctx, client.ObjectKey{Name: i.Spec.SwitchRef.Name}, s); err != nil {
+		return r.ResyncInterval
+	}
+	return pollInterval(s, r.ResyncInterval)
+}
+
+// enqueueByInterfaceEvent enqueues the SwitchInterfaces matching the switch
+// and native name of the SwitchInterface stub emitted by the InterfaceWatcher.
+func (r *SwitchInterfaceReconciler) enqueueByInterfaceEvent(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	stub, ok := obj.(*networkingv1alpha1.SwitchInterface)
+	if !ok || stub.Spec.SwitchRef == nil {
+		return nil
+	}
+
+	switchInterfaces := &networkingv1alpha1.SwitchInterfaceList{}
+	if err := r.List(ctx, switchInterfaces); err != nil {
+		log.Error(err, "Failed to list SwitchInterfaces")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, i := range switchInterfaces.Items {
+		if i.Spec.SwitchRef != nil && i.Spec.SwitchRef.Name == stub.Spec.SwitchRef.Name && i.Spec.NativeName == stub.Spec.NativeName {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&i)})
+		}
+	}
+	return requests
+}
+
+// switchInterfaceChangedPredicate ignores the status updates of the reconciler itself, as the
+// transceiver readings change on every reconciliation. Spec, annotation and deletion changes pass,
+// as well as finalizer and state changes, which the reconciler relies on to continue after adding
+// the finalizer and initializing the state. The state on the switch is polled by the resync
+// interval and the InterfaceWatcher instead.
+func switchInterfaceChangedPredicate() predicate.Predicate {
+	return predicate.Or(
+		predicate.GenerationChangedPredicate{},
+		predicate.AnnotationChangedPredicate{},
+		predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				oldIface, ok := e.ObjectOld.(*networkingv1alpha1.SwitchInterface)
+				if !ok {
+					return true
+				}
+				newIface, ok := e.ObjectNew.(*networkingv1alpha1.SwitchInterface)
+				if !ok {
+					return true
+				}
+				return !slices.Equal(oldIface.Finalizers, newIface.Finalizers) ||
+					!newIface.DeletionTimestamp.Equal(oldIface.DeletionTimestamp) ||
+					oldIface.Status.State != newIface.Status.State
+			},
+		},
+	)
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *SwitchInterfaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&networkingv1alpha1.SwitchInterface{}).
-		Named("switchinterface").
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1alpha1.SwitchInterface{}, builder.WithPredicates(switchInterfaceChangedPredicate()))
+	if r.InterfaceWatcher != nil {
+		b = b.WatchesRawSource(source.Channel(r.InterfaceWatcher.Events(), handler.EnqueueRequestsFromMapFunc(r.enqueueByInterfaceEvent)))
+	}
+	return b.Named("switchinterface").
 		Complete(r)
 }
@@ -5,17 +5,24 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	agenterrors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
 )
 
 var _ = Describe("SwitchInterface Controller", func() {
@@ -74,3 +81,272 @@ var _ = Describe("SwitchInterface Controller", func() {
 		})
 	})
 })
+
+var _ = Describe("SwitchInterface Controller with a switch agent", func() {
+	const (
+		switchName    = "switchinterface-switch"
+		interfaceName = "switchinterface-eth0"
+	)
+
+	ctx := context.Background()
+
+	var (
+		fake       *fakeAgent
+		reconciler *SwitchInterfaceReconciler
+	)
+
+	// reconcileInterface runs the reconciliations adding the finalizer, initializing
+	// the state and configuring the interface. The given functions can adjust the
+	// interface before it is created.
+	reconcileInterface := func(adminState networkingv1alpha1.AdminState, mutate ...func(i *networkingv1alpha1.SwitchInterface)) *networkingv1alpha1.SwitchInterface {
+		i := &networkingv1alpha1.SwitchInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: interfaceName},
+			Spec: networkingv1alpha1.SwitchInterfaceSpec{
+				Handle:     "eth1-0",
+				NativeName: "Ethernet0",
+				SwitchRef:  &corev1.LocalObjectReference{Name: switchName},
+				AdminState: adminState,
+			},
+		}
+		for _, m := range mutate {
+			m(i)
+		}
+		Expect(k8sClient.Create(ctx, i)).To(Succeed())
+		DeferCleanup(func() {
+			current := &networkingv1alpha1.SwitchInterface{}
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(i), current); errors.IsNotFound(err) {
+				return
+			}
+			current.Finalizers = nil
+			Expect(k8sClient.Update(ctx, current)).To(Succeed())
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, current))).To(Succeed())
+		})
+
+		for range 3 {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(i)})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(i), i)).To(Succeed())
+		return i
+	}
+
+	BeforeEach(func() {
+		fake = newFakeAgent()
+		fake.interfaces["Ethernet0"] = &agent.Interface{
+			Name:            "Ethernet0",
+			NativeName:      "Ethernet0",
+			AliasName:       "eth1-0",
+			AdminStatus:     agent.StatusUp,
+			OperationStatus: agent.StatusUp,
+			MTU:             9100,
+		}
+		host, port := startFakeAgent(switchName, fake)
+
+		s := &networkingv1alpha1.Switch{
+			ObjectMeta: metav1.ObjectMeta{Name: switchName},
+			Spec: networkingv1alpha1.SwitchSpec{
+				Management: networkingv1alpha1.Management{Host: host, Port: port},
+				MacAddress: "aa:bb:cc:dd:ee:ff",
+			},
+		}
+		Expect(k8sClient.Create(ctx, s)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, s))).To(Succeed())
+		})
+
+		reconciler = &SwitchInterfaceReconciler{
+			Client:         k8sClient,
+			Scheme:         k8sClient.Scheme(),
+			ResyncInterval: DefaultSwitchInterfaceResyncInterval,
+		}
+	})
+
+	It("should not set the admin state if it already matches the spec", func() {
+		i := reconcileInterface(networkingv1alpha1.AdminStateUp)
+
+		Expect(fake.adminStatusUpdates).To(BeZero())
+		Expect(i.Status.State).To(Equal(networkingv1alpha1.SwitchInterfaceStateReady))
+		Expect(i.Status.AdminState).To(Equal(networkingv1alpha1.AdminStateUp))
+		Expect(meta.IsStatusConditionTrue(i.Status.Conditions, networkingv1alpha1.SwitchInterfaceConditionAdminStateSynced)).To(BeTrue())
+	})
+
+	It("should set the admin state if it does not match the spec", func() {
+		i := reconcileInterface(networkingv1alpha1.AdminStateDown)
+
+		Expect(fake.adminStatusUpdates).To(Equal(1))
+		Expect(fake.interfaces["Ethernet0"].AdminStatus).To(Equal(agent.StatusDown))
+		Expect(i.Status.AdminState).To(Equal(networkingv1alpha1.AdminStateDown))
+		Expect(i.Status.OperationalState).To(Equal(networkingv1alpha1.OperationStateDown))
+		Expect(meta.IsStatusConditionTrue(i.Status.Conditions, networkingv1alpha1.SwitchInterfaceConditionAdminStateSynced)).To(BeTrue())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(i)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fake.adminStatusUpdates).To(Equal(1))
+	})
+
+	It("should not configure port attributes the spec does not set", func() {
+		reconcileInterface(networkingv1alpha1.AdminStateUp)
+
+		Expect(fake.portAttributeRequests).To(BeEmpty())
+	})
+
+	It("should pass the port attributes to the agent and report the applied ones", func() {
+		i := reconcileInterface(networkingv1alpha1.AdminStateUp, func(i *networkingv1alpha1.SwitchInterface) {
+			i.Spec.MTU = 9000
+			i.Spec.FEC = networkingv1alpha1.FECModeRS
+		})
+
+		Expect(fake.portAttributeRequests).NotTo(BeEmpty())
+		for _, request := range fake.portAttributeRequests {
+			Expect(request.MTU).To(BeEquivalentTo(9000))
+			Expect(request.FEC).To(Equal("rs"))
+			Expect(request.Speed).To(BeZero())
+			Expect(request.Autoneg).To(BeEmpty())
+		}
+
+		By("reporting the values applied to the port rather than the configured ones")
+		Expect(i.Status.State).To(Equal(networkingv1alpha1.SwitchInterfaceStateReady))
+		Expect(i.Status.MTU).To(BeEquivalentTo(9100))
+		Expect(i.Status.FEC).To(BeEmpty())
+
+		By("reporting the new values once the port has been reconfigured")
+		fake.mu.Lock()
+		fake.interfaces["Ethernet0"].MTU = 9000
+		fake.interfaces["Ethernet0"].FEC = "rs"
+		fake.mu.Unlock()
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(i)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(i), i)).To(Succeed())
+		Expect(i.Status.MTU).To(BeEquivalentTo(9000))
+		Expect(i.Status.FEC).To(Equal(networkingv1alpha1.FECModeRS))
+	})
+
+	// deleteInterface deletes the SwitchInterface and runs the reconciliation applying the deletion policy.
+	deleteInterface := func(i *networkingv1alpha1.SwitchInterface) error {
+		Expect(k8sClient.Delete(ctx, i)).To(Succeed())
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(i)})
+		return err
+	}
+
+	It("should retain the interface configuration by default", func() {
+		i := reconcileInterface(networkingv1alpha1.AdminStateUp)
+
+		Expect(deleteInterface(i)).To(Succeed())
+		Expect(errors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(i), i))).To(BeTrue())
+		Expect(fake.interfaces["Ethernet0"].AdminStatus).To(Equal(agent.StatusUp))
+		Expect(fake.configSaves).To(BeZero())
+	})
+
+	It("should shut the interface down with the AdminDown policy", func() {
+		i := reconcileInterface(networkingv1alpha1.AdminStateUp, func(i *networkingv1alpha1.SwitchInterface) {
+			i.Spec.DeletionPolicy = networkingv1alpha1.DeletionPolicyAdminDown
+		})
+
+		Expect(deleteInterface(i)).To(Succeed())
+		Expect(errors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(i), i))).To(BeTrue())
+		Expect(fake.interfaces["Ethernet0"].AdminStatus).To(Equal(agent.StatusDown))
+		Expect(fake.portAttributeRequests).To(BeEmpty())
+		Expect(fake.configSaves).To(Equal(1))
+	})
+
+	It("should restore the default alias and MTU with the ResetToDefault policy of the Switch", func() {
+		fake.interfaces["Ethernet0"].Name = "eth1-0"
+		fake.interfaces["Ethernet0"].AliasName = "Eth1"
+		s := &networkingv1alpha1.Switch{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: switchName}, s)).To(Succeed())
+		s.Spec.DeletionPolicy = networkingv1alpha1.DeletionPolicyResetToDefault
+		Expect(k8sClient.Update(ctx, s)).To(Succeed())
+
+		i := reconcileInterface(networkingv1alpha1.AdminStateUp)
+		Expect(i.Status.DefaultAliasName).To(Equal("Eth1"))
+		Expect(fake.interfaces["Ethernet0"].AliasName).To(Equal("eth1-0"))
+
+		Expect(deleteInterface(i)).To(Succeed())
+		Expect(errors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(i), i))).To(BeTrue())
+		Expect(fake.interfaces["Ethernet0"].AdminStatus).To(Equal(agent.StatusDown))
+		Expect(fake.interfaces["Ethernet0"].AliasName).To(Equal("Eth1"))
+		Expect(fake.portAttributeRequests).To(ConsistOf(HaveField("MTU", BeEquivalentTo(defaultPortMTU))))
+		Expect(fake.configSaves).To(Equal(1))
+	})
+
+	It("should keep the finalizer until the deletion timeout if the cleanup fails", func() {
+		i := reconcileInterface(networkingv1alpha1.AdminStateUp, func(i *networkingv1alpha1.SwitchInterface) {
+			i.Spec.DeletionPolicy = networkingv1alpha1.DeletionPolicyAdminDown
+		})
+		fake.mu.Lock()
+		fake.saveConfigStatus = agenterrors.NewErrorStatus(agenterrors.SERVER_ERROR, "D-Bus unavailable")
+		fake.mu.Unlock()
+
+		reconciler.DeletionTimeout = time.Hour
+		Expect(deleteInterface(i)).To(MatchError(ContainSubstring("failed to clean up interface Ethernet0")))
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(i), i)).To(Succeed())
+		Expect(i.Finalizers).To(ContainElement(networkingv1alpha1.SwitchFinalizer))
+
+		By("removing the finalizer once the deletion timed out")
+		recorder := events.NewFakeRecorder(10)
+		reconciler.Recorder = recorder
+		reconciler.DeletionTimeout = time.Nanosecond
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(i)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(errors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(i), i))).To(BeTrue())
+		Expect(recorder.Events).To(Receive(ContainSubstring("CleanupSkipped")))
+	})
+
+	It("should report the transceiver", func() {
+		i := reconcileInterface(networkingv1alpha1.AdminStateUp)
+
+		Expect(i.Status.Transceiver).NotTo(BeNil())
+		Expect(i.Status.Transceiver.Vendor).To(Equal("ACME"))
+	})
+
+	It("should not fail if the transceiver cannot be read", func() {
+		fake.transceiverStatus = agenterrors.NewErrorStatus(agenterrors.SERVER_ERROR, "failed to read STATE_DB")
+		i := reconcileInterface(networkingv1alpha1.AdminStateUp)
+
+		Expect(i.Status.State).To(Equal(networkingv1alpha1.SwitchInterfaceStateReady))
+		Expect(i.Status.Transceiver).To(BeNil())
+		Expect(meta.IsStatusConditionTrue(i.Status.Conditions, networkingv1alpha1.ConditionReady)).To(BeTrue())
+	})
+})
+
+var _ = Describe("SwitchInterface watch predicate", func() {
+	base := func() *networkingv1alpha1.SwitchInterface {
+		return &networkingv1alpha1.SwitchInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "predicate-test",
+				Generation: 1,
+				Finalizers: []string{networkingv1alpha1.SwitchFinalizer},
+			},
+			Status: networkingv1alpha1.SwitchInterfaceStatus{
+				State: networkingv1alpha1.SwitchInterfaceStateReady,
+			},
+		}
+	}
+
+	DescribeTable("update events",
+		func(mutate func(i *networkingv1alpha1.SwitchInterface), expected bool) {
+			oldIface := base()
+			newIface := base()
+			mutate(newIface)
+			Expect(switchInterfaceChangedPredicate().Update(event.UpdateEvent{ObjectOld: oldIface, ObjectNew: newIface})).To(Equal(expected))
+		},
+		Entry("ignores transceiver readings", func(i *networkingv1alpha1.SwitchInterface) {
+			i.Status.Transceiver = &networkingv1alpha1.TransceiverStatus{Temperature: "42.5"}
+		}, false),
+		Entry("ignores condition updates", func(i *networkingv1alpha1.SwitchInterface) {
+			i.Status.Conditions = []metav1.Condition{{Type: networkingv1alpha1.ConditionReady, Status: metav1.ConditionTrue}}
+		}, false),
+		Entry("passes spec changes", func(i *networkingv1alpha1.SwitchInterface) {
+			i.Generation = 2
+		}, true),
+		Entry("passes annotation changes", func(i *networkingv1alpha1.SwitchInterface) {
+			i.Annotations = map[string]string{networkingv1alpha1.SwitchInterfaceOrphanedSinceAnnotation: "2025-01-01T00:00:00Z"}
+		}, true),
+		Entry("passes finalizer changes", func(i *networkingv1alpha1.SwitchInterface) {
+			i.Finalizers = nil
+		}, true),
+		Entry("passes state changes", func(i *networkingv1alpha1.SwitchInterface) {
+			i.Status.State = networkingv1alpha1.SwitchInterfaceStateFailed
+		}, true),
+	)
+})
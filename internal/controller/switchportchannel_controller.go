@@ -0,0 +1,336 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/ironcore-dev/controller-utils/clientutils"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	agentCli "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
+	agenterrors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+	switchUtil "github.com/ironcore-dev/sonic-operator/internal/switch_util"
+)
+
+// SwitchPortChannelReconciler reconciles a SwitchPortChannel object
+type SwitchPortChannelReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Recorder, if set, records events on condition transitions.
+	Recorder events.EventRecorder
+
+	// DeletionTimeout is the time after which the finalizer of a deleted SwitchPortChannel is removed even
+	// if the port channel could not be removed from the switch. Zero waits forever.
+	DeletionTimeout time.Duration
+
+	// ResyncInterval is the interval in which SwitchPortChannels are reconciled again to report the
+	// state of their members, unless overridden by spec.pollInterval of their Switch.
+	ResyncInterval time.Duration
+
+	resync resyncPolicy
+}
+
+// +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchportchannels,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchportchannels/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchportchannels/finalizers,verbs=update
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=sonic.networking.metal.ironcore.dev,resources=switchinterfaces,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *SwitchPortChannelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	pc := &networkingv1alpha1.SwitchPortChannel{}
+	if err := r.Get(ctx, req.NamespacedName, pc); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	return r.reconileExists(ctx, log, pc)
+}
+
+func (r *SwitchPortChannelReconciler) reconileExists(ctx context.Context, log logr.Logger, pc *networkingv1alpha1.SwitchPortChannel) (ctrl.Result, error) {
+	if !pc.DeletionTimestamp.IsZero() {
+		return r.delete(ctx, log, pc)
+	}
+	return r.reconcile(ctx, log, pc)
+}
+
+func (r *SwitchPortChannelReconciler) delete(ctx context.Context, log logr.Logger, pc *networkingv1alpha1.SwitchPortChannel) (ctrl.Result, error) {
+	log.Info("Deleting SwitchPortChannel")
+
+	if err := r.cleanup(ctx, log, pc); err != nil {
+		if !deletionTimedOut(pc, r.DeletionTimeout) {
+			return ctrl.Result{}, fmt.Errorf("failed to remove port channel %s: %w", pc.Spec.NativeName, err)
+		}
+		log.Error(err, "Failed to remove port channel, removing finalizer after deletion timeout")
+		recordCleanupSkipped(r.Recorder, pc, err)
+	}
+
+	r.resync.forget(pc.Name)
+
+	if _, err := clientutils.PatchEnsureNoFinalizer(ctx, r.Client, pc, networkingv1alpha1.SwitchFinalizer); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Deleted SwitchPortChannel")
+	return ctrl.Result{}, nil
+}
+
+// cleanup removes the port channel from the switch.
+func (r *SwitchPortChannelReconciler) cleanup(ctx context.Context, log logr.Logger, pc *networkingv1alpha1.SwitchPortChannel) error {
+	switchAgentClient, err := switchUtil.NewAgentClientFromSwitchRef(ctx, r.Client, pc.Spec.SwitchRef, pc.Namespace)
+	if err != nil {
+		// The port channel can only be removed from the switch as long as the switch exists
+		return client.IgnoreNotFound(err)
+	}
+	return r.deletePortChannel(ctx, log, switchAgentClient, pc.Spec.NativeName)
+}
+
+func (r *SwitchPortChannelReconciler) deletePortChannel(ctx context.Context, log logr.Logger, switchAgentClient agentCli.SwitchAgentClient, name string) error {
+	portChannel, err := switchAgentClient.GetPortChannel(ctx, &agent.PortChannel{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortChannelKind,
+		},
+		Name: name,
+	})
+	if err != nil {
+		if portChannel != nil && portChannel.Status.Code == agenterrors.NOT_FOUND {
+			return nil
+		}
+		return err
+	}
+
+	for _, member := range portChannel.Members {
+		log.V(1).Info("Removing port channel member", "interface", member.Interface)
+		if err := switchAgentClient.RemovePortChannelMember(ctx, &member); err != nil {
+			return err
+		}
+	}
+
+	return switchAgentClient.DeletePortChannel(ctx, portChannel)
+}
+
+func (r *SwitchPortChannelReconciler) reconcile(ctx context.Context, log logr.Logger, pc *networkingv1alpha1.SwitchPortChannel) (result ctrl.Result, err error) {
+	log.Info("Reconciling SwitchPortChannel")
+
+	if modified, err := clientutils.PatchEnsureFinalizer(ctx, r.Client, pc, networkingv1alpha1.SwitchFinalizer); err != nil || modified {
+		return ctrl.Result{}, err
+	}
+
+	original := pc.DeepCopy()
+	defer func() {
+		if err := r.Status().Patch(ctx, pc, client.MergeFrom(original)); err != nil {
+			log.Error(err, "Failed to update SwitchPortChannel status")
+		}
+	}()
+
+	if pc.Status.State == "" {
+		pc.Status.State = networkingv1alpha1.SwitchPortChannelStatePending
+		return ctrl.Result{}, nil
+	}
+
+	if pc.Spec.SwitchRef == nil {
+		pc.Status.State = networkingv1alpha1.SwitchPortChannelStateFailed
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		setReadyConditions(r.Recorder, pc, &pc.Status.Conditions, err)
+		result, err = r.resync.result(pc.Name, r.pollInterval(ctx, pc), result, err)
+	}()
+
+	// resolve the member SwitchInterfaces to their native names
+	members, err := r.resolveMembers(ctx, pc)
+	if err != nil {
+		pc.Status.State = networkingv1alpha1.SwitchPortChannelStateFailed
+		return ctrl.Result{}, err
+	}
+	desiredMembers := make(map[string]bool, len(members))
+	for _, member := range members {
+		desiredMembers[member.NativeName] = true
+	}
+
+	switchAgentClient, err := switchUtil.NewAgentClientFromSwitchRef(ctx, r.Client, pc.Spec.SwitchRef, pc.Namespace)
+	if err != nil {
+		pc.Status.State = networkingv1alpha1.SwitchPortChannelStateFailed
+		return ctrl.Result{}, err
+	}
+
+	portChannel, err := switchAgentClient.GetPortChannel(ctx, &agent.PortChannel{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortChannelKind,
+		},
+		Name: pc.Spec.NativeName,
+	})
+	if err != nil {
+		if portChannel == nil || portChannel.Status.Code != agenterrors.NOT_FOUND {
+			pc.Status.State = networkingv1alpha1.SwitchPortChannelStateFailed
+			return ctrl.Result{}, err
+		}
+
+		log.Info("Port channel does not exist on the switch, creating it", "nativeName", pc.Spec.NativeName)
+		if portChannel, err = switchAgentClient.CreatePortChannel(ctx, &agent.PortChannel{
+			TypeMeta: agent.TypeMeta{
+				Kind: agent.PortChannelKind,
+			},
+			Name:        pc.Spec.NativeName,
+			AdminStatus: agent.StatusUp,
+		}); err != nil {
+			pc.Status.State = networkingv1alpha1.SwitchPortChannelStateFailed
+			return ctrl.Result{}, err
+		}
+	}
+
+	// remove members which are no longer referenced
+	for _, member := range portChannel.Members {
+		if desiredMembers[member.Interface] {
+			continue
+		}
+		log.Info("Removing port channel member", "interface", member.Interface)
+		if err := switchAgentClient.RemovePortChannelMember(ctx, &member); err != nil {
+			pc.Status.State = networkingv1alpha1.SwitchPortChannelStateFailed
+			return ctrl.Result{}, err
+		}
+	}
+
+	// add referenced members which are missing on the switch
+	current := make(map[string]bool, len(portChannel.Members))
+	for _, member := range portChannel.Members {
+		current[member.Interface] = true
+	}
+	for _, member := range members {
+		if current[member.NativeName] {
+			continue
+		}
+		log.Info("Adding port channel member", "interface", member.NativeName)
+		if _, err := switchAgentClient.AddPortChannelMember(ctx, &agent.PortChannelMember{
+			TypeMeta: agent.TypeMeta{
+				Kind: agent.PortChannelMemberKind,
+			},
+			PortChannelName: pc.Spec.NativeName,
+			Interface:       member.NativeName,
+		}); err != nil {
+			pc.Status.State = networkingv1alpha1.SwitchPortChannelStateFailed
+			return ctrl.Result{}, err
+		}
+	}
+
+	// fetch the port channel again to report the LACP state of the members
+	if portChannel, err = switchAgentClient.GetPortChannel(ctx, &agent.PortChannel{
+		TypeMeta: agent.TypeMeta{
+			Kind: agent.PortChannelKind,
+		},
+		Name: pc.Spec.NativeName,
+	}); err != nil {
+		pc.Status.State = networkingv1alpha1.SwitchPortChannelStateFailed
+		return ctrl.Result{}, err
+	}
+
+	adminState, err := agent.AgentDeviceStatusToAPIAdminState(portChannel.AdminStatus)
+	if err != nil {
+		pc.Status.State = networkingv1alpha1.SwitchPortChannelStateFailed
+		return ctrl.Result{}, err
+	}
+	pc.Status.AdminState = adminState
+
+	operationState, err := agent.AgentDeviceStatusToAPIOperationState(portChannel.OperationStatus)
+	if err != nil {
+		pc.Status.State = networkingv1alpha1.SwitchPortChannelStateFailed
+		return ctrl.Result{}, err
+	}
+	pc.Status.OperationalState = operationState
+
+	selected := make(map[string]bool, len(portChannel.Members))
+	for _, member := range portChannel.Members {
+		selected[member.Interface] = member.Selected
+	}
+
+	for i := range members {
+		members[i].Selected = selected[members[i].NativeName]
+	}
+	pc.Status.Members = members
+	pc.Status.State = networkingv1alpha1.SwitchPortChannelStateReady
+
+	log.Info("Reconciled SwitchPortChannel")
+	return ctrl.Result{}, nil
+}
+
+// pollInterval returns the poll interval of the Switch the SwitchPortChannel belongs to,
+// or the resync interval of the reconciler if it is not set.
+func (r *SwitchPortChannelReconciler) pollInterval(ctx context.Context, pc *networkingv1alpha1.SwitchPortChannel) time.Duration {
+	s := &networkingv1alpha1.Switch{}
+	if err := r.Get(ctx, client.ObjectKey{Name: pc.Spec.SwitchRef.Name}, s); err != nil {
+		return r.ResyncInterval
+	}
+	return pollInterval(s, r.ResyncInterval)
+}
+
+// resolveMembers resolves the referenced member SwitchInterfaces in spec order.
+func (r *SwitchPortChannelReconciler) resolveMembers(ctx context.Context, pc *networkingv1alpha1.SwitchPortChannel) ([]networkingv1alpha1.PortChannelMemberStatus, error) {
+	members := make([]networkingv1alpha1.PortChannelMemberStatus, 0, len(pc.Spec.MemberRefs))
+	for _, ref := range pc.Spec.MemberRefs {
+		iface := &networkingv1alpha1.SwitchInterface{}
+		if err := r.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: pc.Namespace}, iface); err != nil {
+			return nil, fmt.Errorf("failed to get member SwitchInterface %s: %w", ref.Name, err)
+		}
+
+		if iface.Spec.SwitchRef == nil || iface.Spec.SwitchRef.Name != pc.Spec.SwitchRef.Name {
+			return nil, fmt.Errorf("member SwitchInterface %s does not belong to Switch %s", ref.Name, pc.Spec.SwitchRef.Name)
+		}
+
+		members = append(members, networkingv1alpha1.PortChannelMemberStatus{
+			Name:       iface.Name,
+			NativeName: iface.Spec.NativeName,
+		})
+	}
+
+	return members, nil
+}
+
+// enqueueBySwitchInterface enqueues all SwitchPortChannels referencing the given SwitchInterface.
+func (r *SwitchPortChannelReconciler) enqueueBySwitchInterface(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	portChannels := &networkingv1alpha1.SwitchPortChannelList{}
+	if err := r.List(ctx, portChannels); err != nil {
+		log.Error(err, "Failed to list SwitchPortChannels")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, pc := range portChannels.Items {
+		for _, ref := range pc.Spec.MemberRefs {
+			if ref.Name == obj.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&pc)})
+				break
+			}
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SwitchPortChannelReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1alpha1.SwitchPortChannel{}).
+		Watches(
+			&networkingv1alpha1.SwitchInterface{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueBySwitchInterface),
+		).
+		Named("switchportchannel").
+		Complete(r)
+}
@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	agenterrors "github.com/ironcore-dev/sonic-operator/internal/agent/errors"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+)
+
+var _ = Describe("SwitchPortChannel Controller", func() {
+	const (
+		switchName      = "portchannel-switch"
+		portChannelName = "portchannel-test"
+		pollInterval    = 30 * time.Second
+	)
+
+	ctx := context.Background()
+
+	var (
+		fake       *fakeAgent
+		reconciler *SwitchPortChannelReconciler
+	)
+
+	createInterface := func(name, nativeName, switchRef string) {
+		iface := &networkingv1alpha1.SwitchInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: networkingv1alpha1.SwitchInterfaceSpec{
+				Handle:     "eth1-" + strings.TrimPrefix(nativeName, "Ethernet"),
+				NativeName: nativeName,
+				SwitchRef:  &corev1.LocalObjectReference{Name: switchRef},
+			},
+		}
+		Expect(k8sClient.Create(ctx, iface)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, iface))).To(Succeed())
+		})
+	}
+
+	createPortChannel := func(memberRefs ...string) *networkingv1alpha1.SwitchPortChannel {
+		pc := &networkingv1alpha1.SwitchPortChannel{
+			ObjectMeta: metav1.ObjectMeta{Name: portChannelName},
+			Spec: networkingv1alpha1.SwitchPortChannelSpec{
+				NativeName: "PortChannel1",
+				SwitchRef:  &corev1.LocalObjectReference{Name: switchName},
+			},
+		}
+		for _, ref := range memberRefs {
+			pc.Spec.MemberRefs = append(pc.Spec.MemberRefs, corev1.LocalObjectReference{Name: ref})
+		}
+		Expect(k8sClient.Create(ctx, pc)).To(Succeed())
+		DeferCleanup(func() {
+			current := &networkingv1alpha1.SwitchPortChannel{}
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(pc), current); apierrors.IsNotFound(err) {
+				return
+			}
+			current.Finalizers = nil
+			Expect(k8sClient.Update(ctx, current)).To(Succeed())
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, current))).To(Succeed())
+		})
+		return pc
+	}
+
+	// reconcileReady runs the reconciliations adding the finalizer, initializing the
+	// state and configuring the port channel.
+	reconcileReady := func(pc *networkingv1alpha1.SwitchPortChannel) error {
+		var err error
+		for range 3 {
+			if _, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(pc)}); err != nil {
+				break
+			}
+		}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pc), pc)).To(Succeed())
+		return err
+	}
+
+	BeforeEach(func() {
+		fake = newFakeAgent()
+		host, port := startFakeAgent(switchName, fake)
+
+		s := &networkingv1alpha1.Switch{
+			ObjectMeta: metav1.ObjectMeta{Name: switchName},
+			Spec: networkingv1alpha1.SwitchSpec{
+				Management:   networkingv1alpha1.Management{Host: host, Port: port},
+				MacAddress:   "aa:bb:cc:dd:ee:ff",
+				PollInterval: &metav1.Duration{Duration: pollInterval},
+			},
+		}
+		Expect(k8sClient.Create(ctx, s)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, s))).To(Succeed())
+		})
+
+		reconciler = &SwitchPortChannelReconciler{
+			Client:         k8sClient,
+			Scheme:         k8sClient.Scheme(),
+			ResyncInterval: DefaultSwitchPortChannelResyncInterval,
+		}
+	})
+
+	It("should create the port channel with its members and report their state", func() {
+		createInterface("portchannel-eth0", "Ethernet0", switchName)
+		createInterface("portchannel-eth4", "Ethernet4", switchName)
+		pc := createPortChannel("portchannel-eth0", "portchannel-eth4")
+
+		Expect(reconcileReady(pc)).To(Succeed())
+
+		Expect(fake.memberNames("PortChannel1")).To(ConsistOf("Ethernet0", "Ethernet4"))
+		Expect(pc.Finalizers).To(ContainElement(networkingv1alpha1.SwitchFinalizer))
+		Expect(pc.Status.State).To(Equal(networkingv1alpha1.SwitchPortChannelStateReady))
+		Expect(pc.Status.AdminState).To(Equal(networkingv1alpha1.AdminStateUp))
+		Expect(pc.Status.OperationalState).To(Equal(networkingv1alpha1.OperationStateUp))
+		Expect(pc.Status.Members).To(Equal([]networkingv1alpha1.PortChannelMemberStatus{
+			{Name: "portchannel-eth0", NativeName: "Ethernet0", Selected: true},
+			{Name: "portchannel-eth4", NativeName: "Ethernet4", Selected: true},
+		}))
+		Expect(meta.IsStatusConditionTrue(pc.Status.Conditions, networkingv1alpha1.ConditionReady)).To(BeTrue())
+	})
+
+	It("should refresh the member state with the poll interval of the Switch", func() {
+		createInterface("portchannel-eth0", "Ethernet0", switchName)
+		pc := createPortChannel("portchannel-eth0")
+		Expect(reconcileReady(pc)).To(Succeed())
+		Expect(meta.IsStatusConditionTrue(pc.Status.Conditions, networkingv1alpha1.ConditionAgentReachable)).To(BeTrue())
+
+		fake.mu.Lock()
+		fake.portChannels["PortChannel1"].Members[0].Selected = false
+		fake.portChannels["PortChannel1"].OperationStatus = agent.StatusDown
+		fake.mu.Unlock()
+
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(pc)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">=", pollInterval))
+		Expect(result.RequeueAfter).To(BeNumerically("<=", pollInterval+pollInterval/5))
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pc), pc)).To(Succeed())
+		Expect(pc.Status.OperationalState).To(Equal(networkingv1alpha1.OperationStateDown))
+		Expect(pc.Status.Members).To(Equal([]networkingv1alpha1.PortChannelMemberStatus{
+			{Name: "portchannel-eth0", NativeName: "Ethernet0", Selected: false},
+		}))
+	})
+
+	It("should remove members which are no longer referenced", func() {
+		fake.portChannels["PortChannel1"] = &agent.PortChannel{
+			Name:            "PortChannel1",
+			AdminStatus:     agent.StatusUp,
+			OperationStatus: agent.StatusUp,
+			Members: []agent.PortChannelMember{
+				{PortChannelName: "PortChannel1", Interface: "Ethernet0", Selected: true},
+				{PortChannelName: "PortChannel1", Interface: "Ethernet8", Selected: true},
+			},
+		}
+		createInterface("portchannel-eth0", "Ethernet0", switchName)
+		pc := createPortChannel("portchannel-eth0")
+
+		Expect(reconcileReady(pc)).To(Succeed())
+
+		Expect(fake.memberNames("PortChannel1")).To(ConsistOf("Ethernet0"))
+		Expect(pc.Status.State).To(Equal(networkingv1alpha1.SwitchPortChannelStateReady))
+	})
+
+	It("should fail if a member belongs to another Switch", func() {
+		createInterface("portchannel-foreign", "Ethernet0", "other-switch")
+		pc := createPortChannel("portchannel-foreign")
+
+		Expect(reconcileReady(pc)).To(MatchError(ContainSubstring("does not belong to Switch")))
+
+		Expect(fake.hasPortChannel("PortChannel1")).To(BeFalse())
+		Expect(pc.Status.State).To(Equal(networkingv1alpha1.SwitchPortChannelStateFailed))
+		Expect(meta.IsStatusConditionFalse(pc.Status.Conditions, networkingv1alpha1.ConditionReady)).To(BeTrue())
+	})
+
+	It("should remove the port channel from the switch when deleted", func() {
+		createInterface("portchannel-eth0", "Ethernet0", switchName)
+		pc := createPortChannel("portchannel-eth0")
+		Expect(reconcileReady(pc)).To(Succeed())
+		Expect(fake.hasPortChannel("PortChannel1")).To(BeTrue())
+
+		Expect(k8sClient.Delete(ctx, pc)).To(Succeed())
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(pc)})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fake.hasPortChannel("PortChannel1")).To(BeFalse())
+		Expect(apierrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(pc), pc))).To(BeTrue())
+	})
+
+	It("should keep the finalizer while the port channel cannot be removed", func() {
+		createInterface("portchannel-eth0", "Ethernet0", switchName)
+		pc := createPortChannel("portchannel-eth0")
+		Expect(reconcileReady(pc)).To(Succeed())
+
+		fake.mu.Lock()
+		fake.failStatus = agenterrors.NewErrorStatus(agenterrors.SERVER_ERROR, "redis unavailable")
+		fake.mu.Unlock()
+
+		reconciler.DeletionTimeout = time.Hour
+		Expect(k8sClient.Delete(ctx, pc)).To(Succeed())
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(pc)})
+		Expect(err).To(MatchError(ContainSubstring("failed to remove port channel PortChannel1")))
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pc), pc)).To(Succeed())
+		Expect(pc.Finalizers).To(ContainElement(networkingv1alpha1.SwitchFinalizer))
+	})
+
+	It("should remove the finalizer after the deletion timeout", func() {
+		createInterface("portchannel-eth0", "Ethernet0", switchName)
+		pc := createPortChannel("portchannel-eth0")
+		Expect(reconcileReady(pc)).To(Succeed())
+
+		fake.mu.Lock()
+		fake.failStatus = agenterrors.NewErrorStatus(agenterrors.SERVER_ERROR, "redis unavailable")
+		fake.mu.Unlock()
+
+		recorder := events.NewFakeRecorder(10)
+		reconciler.Recorder = recorder
+		reconciler.DeletionTimeout = time.Nanosecond
+		Expect(k8sClient.Delete(ctx, pc)).To(Succeed())
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(pc)})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(apierrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(pc), pc))).To(BeTrue())
+		Expect(fake.hasPortChannel("PortChannel1")).To(BeTrue())
+		Expect(recorder.Events).To(Receive(ContainSubstring("CleanupSkipped")))
+	})
+})
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package filewatch reloads configuration files when their content changes.
+package filewatch
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultInterval is the default interval in which files are checked for changes.
+const DefaultInterval = 10 * time.Second
+
+// Watcher polls a configuration file and applies every new version of it. Polling the content
+// instead of watching inotify events also covers files mounted from a ConfigMap, which are
+// replaced by swapping a symlink.
+type Watcher struct {
+	// Path is the file to watch.
+	Path string
+	// Dirs are directories whose files are watched as well, e.g., templates referenced by the
+	// file. A change of them applies the content of Path again.
+	Dirs []string
+	// Interval is the interval in which the file is read. Defaults to DefaultInterval.
+	Interval time.Duration
+	// Apply parses, validates and activates the content of the file. If it returns an error,
+	// the previously applied configuration must stay active.
+	Apply func(content []byte) error
+
+	applied   [sha256.Size]byte
+	attempted [sha256.Size]byte
+}
+
+// Load reads and applies the file once. It is used for the initial configuration.
+func (w *Watcher) Load() error {
+	content, sum, err := w.read()
+	if err != nil {
+		return err
+	}
+	if err := w.Apply(content); err != nil {
+		return fmt.Errorf("invalid config %s: %w", w.Path, err)
+	}
+	w.applied = sum
+	w.attempted = sum
+	return nil
+}
+
+// read returns the content of Path and a checksum covering Path and the files of Dirs.
+func (w *Watcher) read() ([]byte, [sha256.Size]byte, error) {
+	content, err := os.ReadFile(w.Path)
+	if err != nil {
+		return nil, [sha256.Size]byte{}, fmt.Errorf("unable to read %s: %w", w.Path, err)
+	}
+	h := sha256.New()
+	h.Write(content)
+	for _, dir := range w.Dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, [sha256.Size]byte{}, fmt.Errorf("unable to read %s: %w", dir, err)
+		}
+		for _, entry := range entries {
+			file, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+			if err != nil {
+				// Skip subdirectories like the ..data directory of a mounted ConfigMap.
+				continue
+			}
+			h.Write([]byte(entry.Name()))
+			h.Write(file)
+		}
+	}
+	var sum [sha256.Size]byte
+	h.Sum(sum[:0])
+	return content, sum, nil
+}
+
+// Run checks the file for changes until ctx is done. A version failing to apply is logged once
+// and the last good configuration is kept.
+func (w *Watcher) Run(ctx context.Context) {
+	interval := w.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	logger := slog.With("component", "filewatch", "path", w.Path)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		content, sum, err := w.read()
+		if err != nil {
+			logger.Warn("failed to read config, keeping the last good one", "err", err)
+			continue
+		}
+		if sum == w.applied || sum == w.attempted {
+			continue
+		}
+		w.attempted = sum
+
+		if err := w.Apply(content); err != nil {
+			logger.Error("invalid config, keeping the last good one", "err", err)
+			continue
+		}
+		w.applied = sum
+		logger.Info("reloaded config")
+	}
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package filewatch
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Watcher", func() {
+	type config struct {
+		Image string `json:"image"`
+	}
+
+	var (
+		path    string
+		watcher *Watcher
+
+		mu      sync.Mutex
+		active  config
+		applies int
+	)
+
+	current := func() config {
+		mu.Lock()
+		defer mu.Unlock()
+		return active
+	}
+
+	write := func(content string) {
+		// Replace the file atomically like a ConfigMap update, so the watcher never reads a partial file.
+		tmp := path + ".tmp"
+		Expect(os.WriteFile(tmp, []byte(content), 0o644)).To(Succeed())
+		Expect(os.Rename(tmp, path)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "config.json")
+		active = config{}
+		applies = 0
+
+		watcher = &Watcher{
+			Path:     path,
+			Interval: 10 * time.Millisecond,
+			Apply: func(content []byte) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				applies++
+				var c config
+				if err := json.Unmarshal(content, &c); err != nil {
+					return err
+				}
+				active = c
+				return nil
+			},
+		}
+	})
+
+	It("should keep the last good config while the file is invalid and apply it once fixed", func() {
+		write(`{"image": "sonic-1.bin"}`)
+		Expect(watcher.Load()).To(Succeed())
+		Expect(current().Image).To(Equal("sonic-1.bin"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			watcher.Run(ctx)
+		}()
+		DeferCleanup(func() {
+			cancel()
+			<-done
+		})
+
+		write(`{"image": `)
+		Eventually(func() int {
+			mu.Lock()
+			defer mu.Unlock()
+			return applies
+		}).Should(Equal(2))
+		Consistently(current, 100*time.Millisecond, 10*time.Millisecond).Should(Equal(config{Image: "sonic-1.bin"}))
+
+		// The invalid version is only attempted once.
+		mu.Lock()
+		Expect(applies).To(Equal(2))
+		mu.Unlock()
+
+		write(`{"image": "sonic-2.bin"}`)
+		Eventually(current).Should(Equal(config{Image: "sonic-2.bin"}))
+	})
+
+	It("should fail to load an invalid file", func() {
+		write(`not json`)
+		Expect(watcher.Load()).To(MatchError(ContainSubstring("invalid config")))
+	})
+})
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package identity identifies the switches requesting provisioning resources.
+package identity
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+)
+
+const (
+	// HeaderSerialNumber is sent by ONIE with the serial number of the switch.
+	HeaderSerialNumber = "ONIE-SERIAL-NUMBER"
+	// HeaderEthAddr is sent by ONIE with the MAC address of the management interface.
+	HeaderEthAddr = "ONIE-ETH-ADDR"
+
+	// ParamSerialNumber is the query parameter carrying the serial number of the switch.
+	ParamSerialNumber = "serial"
+	// ParamMAC is the query parameter carrying the MAC address of the switch.
+	ParamMAC = "mac"
+)
+
+// Identity identifies a switch requesting a provisioning resource. Addr is always set, MAC and
+// SerialNumber only if the switch sent them.
+type Identity struct {
+	Addr         netip.Addr
+	MAC          net.HardwareAddr
+	SerialNumber string
+}
+
+func (id Identity) String() string {
+	s := id.Addr.String()
+	if id.MAC != nil {
+		s += " mac=" + id.MAC.String()
+	}
+	if id.SerialNumber != "" {
+		s += " serial=" + id.SerialNumber
+	}
+	return s
+}
+
+// Query returns the query parameters carrying the MAC address and the serial number, so that
+// follow-up requests of the switch are matched the same way.
+func (id Identity) Query() url.Values {
+	query := url.Values{}
+	if id.MAC != nil {
+		query.Set(ParamMAC, id.MAC.String())
+	}
+	if id.SerialNumber != "" {
+		query.Set(ParamSerialNumber, id.SerialNumber)
+	}
+	return query
+}
+
+// TrustedProxies are the networks of the proxies whose X-Forwarded-For header is trusted.
+type TrustedProxies []netip.Prefix
+
+// ParseTrustedProxies parses a list of addresses and prefixes, e.g., "10.0.0.1,2001:db8::/64".
+func ParseTrustedProxies(s string) (TrustedProxies, error) {
+	var proxies TrustedProxies
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if prefix, err := netip.ParsePrefix(field); err == nil {
+			proxies = append(proxies, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", field)
+		}
+		proxies = append(proxies, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return proxies, nil
+}
+
+func (p TrustedProxies) contains(addr netip.Addr) bool {
+	for _, prefix := range p {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Identify returns the identity of the switch sending r. The address is the remote address of the
+// connection. If that is a trusted proxy, the X-Forwarded-For header is followed from the right
+// until the first address which is not a trusted proxy. The MAC address and the serial number are
+// taken from the query parameters or, if absent, from the ONIE headers.
+func (p TrustedProxies) Identify(r *http.Request) (Identity, error) {
+	ap, err := netip.ParseAddrPort(r.RemoteAddr)
+	if err != nil {
+		return Identity{}, fmt.Errorf("invalid remote address %q: %w", r.RemoteAddr, err)
+	}
+	id := Identity{Addr: ap.Addr().Unmap()}
+
+	if p.contains(id.Addr) {
+		forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+		for i := len(forwarded) - 1; i >= 0; i-- {
+			field := strings.TrimSpace(forwarded[i])
+			if field == "" {
+				continue
+			}
+			addr, err := netip.ParseAddr(field)
+			if err != nil {
+				return Identity{}, fmt.Errorf("invalid X-Forwarded-For address %q", field)
+			}
+			id.Addr = addr.Unmap()
+			if !p.contains(id.Addr) {
+				break
+			}
+		}
+	}
+
+	query := r.URL.Query()
+	if mac := firstNonEmpty(query.Get(ParamMAC), r.Header.Get(HeaderEthAddr)); mac != "" {
+		id.MAC, err = net.ParseMAC(mac)
+		if err != nil {
+			return Identity{}, fmt.Errorf("invalid MAC address %q: %w", mac, err)
+		}
+	}
+	id.SerialNumber = firstNonEmpty(query.Get(ParamSerialNumber), r.Header.Get(HeaderSerialNumber))
+	return id, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
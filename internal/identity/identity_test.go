@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package identity
+
+import (
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Identify", func() {
+	var proxies TrustedProxies
+
+	BeforeEach(func() {
+		var err error
+		proxies, err = ParseTrustedProxies("10.0.0.1, 10.1.0.0/16")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	type request struct {
+		remoteAddr string
+		target     string
+		headers    map[string][]string
+	}
+
+	identify := func(req request) (Identity, error) {
+		target := req.target
+		if target == "" {
+			target = "/ztp"
+		}
+		r := httptest.NewRequest("GET", target, nil)
+		r.RemoteAddr = req.remoteAddr
+		for name, values := range req.headers {
+			for _, value := range values {
+				r.Header.Add(name, value)
+			}
+		}
+		return proxies.Identify(r)
+	}
+
+	DescribeTable("the address of the switch",
+		func(req request, expected string) {
+			id, err := identify(req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id.Addr.String()).To(Equal(expected))
+		},
+		Entry("a direct connection", request{remoteAddr: "192.0.2.10:1234"}, "192.0.2.10"),
+		Entry("an IPv4-mapped IPv6 remote address", request{remoteAddr: "[::ffff:192.0.2.10]:1234"}, "192.0.2.10"),
+		Entry("a spoofed X-Forwarded-For from an untrusted remote",
+			request{remoteAddr: "192.0.2.10:1234", headers: map[string][]string{"X-Forwarded-For": {"198.51.100.7"}}},
+			"192.0.2.10"),
+		Entry("a trusted proxy",
+			request{remoteAddr: "10.0.0.1:1234", headers: map[string][]string{"X-Forwarded-For": {"192.0.2.10"}}},
+			"192.0.2.10"),
+		Entry("chained trusted proxies",
+			request{remoteAddr: "10.0.0.1:1234", headers: map[string][]string{"X-Forwarded-For": {"192.0.2.10, 10.1.2.3"}}},
+			"192.0.2.10"),
+		Entry("chained trusted proxies in several headers",
+			request{remoteAddr: "10.0.0.1:1234", headers: map[string][]string{"X-Forwarded-For": {"192.0.2.10", "10.1.2.3"}}},
+			"192.0.2.10"),
+		Entry("an address prepended by the switch to a trusted chain",
+			request{remoteAddr: "10.0.0.1:1234", headers: map[string][]string{"X-Forwarded-For": {"198.51.100.7, 192.0.2.10, 10.1.2.3"}}},
+			"192.0.2.10"),
+		Entry("a trusted proxy without X-Forwarded-For", request{remoteAddr: "10.0.0.1:1234"}, "10.0.0.1"),
+		Entry("only trusted proxies in X-Forwarded-For",
+			request{remoteAddr: "10.0.0.1:1234", headers: map[string][]string{"X-Forwarded-For": {"10.1.2.3"}}},
+			"10.1.2.3"),
+	)
+
+	DescribeTable("invalid requests",
+		func(req request, expected string) {
+			_, err := identify(req)
+			Expect(err).To(MatchError(ContainSubstring(expected)))
+		},
+		Entry("an invalid remote address", request{remoteAddr: "switch"}, "invalid remote address"),
+		Entry("an invalid X-Forwarded-For entry",
+			request{remoteAddr: "10.0.0.1:1234", headers: map[string][]string{"X-Forwarded-For": {"192.0.2.10, unknown"}}},
+			`invalid X-Forwarded-For address "unknown"`),
+		Entry("an invalid MAC address", request{remoteAddr: "192.0.2.10:1234", target: "/ztp?mac=aa:bb"}, "invalid MAC address"),
+	)
+
+	It("should ignore an invalid X-Forwarded-For entry left of the switch", func() {
+		id, err := identify(request{
+			remoteAddr: "10.0.0.1:1234",
+			headers:    map[string][]string{"X-Forwarded-For": {"unknown, 192.0.2.10"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(id.Addr.String()).To(Equal("192.0.2.10"))
+	})
+
+	DescribeTable("the MAC address and serial number",
+		func(req request, expectedMAC, expectedSerial string) {
+			req.remoteAddr = "192.0.2.10:1234"
+			id, err := identify(req)
+			Expect(err).NotTo(HaveOccurred())
+			if expectedMAC == "" {
+				Expect(id.MAC).To(BeNil())
+			} else {
+				Expect(id.MAC.String()).To(Equal(expectedMAC))
+			}
+			Expect(id.SerialNumber).To(Equal(expectedSerial))
+		},
+		Entry("none", request{}, "", ""),
+		Entry("the ONIE headers",
+			request{headers: map[string][]string{HeaderEthAddr: {"AA:BB:CC:DD:EE:FF"}, HeaderSerialNumber: {"SN-ONIE"}}},
+			"aa:bb:cc:dd:ee:ff", "SN-ONIE"),
+		Entry("the query parameters",
+			request{target: "/ztp?mac=aa-bb-cc-dd-ee-01&serial=SN-QUERY"},
+			"aa:bb:cc:dd:ee:01", "SN-QUERY"),
+		Entry("the query parameters taking precedence over the ONIE headers",
+			request{
+				target:  "/ztp?mac=aa:bb:cc:dd:ee:01&serial=SN-QUERY",
+				headers: map[string][]string{HeaderEthAddr: {"aa:bb:cc:dd:ee:ff"}, HeaderSerialNumber: {"SN-ONIE"}},
+			},
+			"aa:bb:cc:dd:ee:01", "SN-QUERY"),
+		Entry("the ONIE headers completing the query parameters",
+			request{
+				target:  "/ztp?serial=SN-QUERY",
+				headers: map[string][]string{HeaderEthAddr: {"aa:bb:cc:dd:ee:ff"}, HeaderSerialNumber: {"SN-ONIE"}},
+			},
+			"aa:bb:cc:dd:ee:ff", "SN-QUERY"),
+	)
+
+	It("should round-trip the identity through its query", func() {
+		id, err := identify(request{
+			remoteAddr: "192.0.2.10:1234",
+			headers:    map[string][]string{HeaderEthAddr: {"aa:bb:cc:dd:ee:ff"}, HeaderSerialNumber: {"SN-ONIE"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		followUp, err := identify(request{remoteAddr: "192.0.2.10:1234", target: "/ztp?" + id.Query().Encode()})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(followUp).To(Equal(id))
+	})
+})
@@ -4,15 +4,20 @@
 package onie
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"io/fs"
 	"log/slog"
-	"net"
 	"net/http"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
+
+	"github.com/ironcore-dev/sonic-operator/internal/identity"
 )
 
 // OnieImage maps a vendor string (matching the ONIE-MACHINE request header) to
@@ -26,12 +31,57 @@ type OnieImage struct {
 // Config holds the machine-to-image mappings loaded from onie.json.
 type Config struct {
 	OnieImages []OnieImage `json:"onieImages"`
+	// Recorder records the images served to a switch. If nil, served images are only logged.
+	Recorder ImageRecorder `json:"-"`
+	// TrustedProxies are the proxies whose X-Forwarded-For header is used to identify a switch.
+	TrustedProxies identity.TrustedProxies `json:"-"`
+}
+
+// ImageRecorder records the images served to a switch.
+type ImageRecorder interface {
+	// RecordImage records that image was served to the switch with the given identity.
+	RecordImage(ctx context.Context, id identity.Identity, image string) error
+}
+
+// ParseConfig decodes and validates the content of onie.json.
+func ParseConfig(content []byte) (Config, error) {
+	var cfg Config
+	if err := json.Unmarshal(content, &cfg); err != nil {
+		return Config{}, err
+	}
+	return cfg, cfg.Validate()
+}
+
+// Validate checks that every vendor is mapped once and that the image filenames stay within the
+// images directory.
+func (c Config) Validate() error {
+	vendors := make(map[string]bool, len(c.OnieImages))
+	for i, img := range c.OnieImages {
+		if img.Vendor == "" {
+			return fmt.Errorf("onieImages[%d]: vendor must not be empty", i)
+		}
+		if vendors[img.Vendor] {
+			return fmt.Errorf("onieImages[%d]: duplicate vendor %s", i, img.Vendor)
+		}
+		vendors[img.Vendor] = true
+
+		if img.OnieUpdater == "" && img.OnieInstaller == "" {
+			return fmt.Errorf("onieImages[%d]: neither onieUpdater nor onieInstaller is set for %s", i, img.Vendor)
+		}
+		for _, name := range []string{img.OnieUpdater, img.OnieInstaller} {
+			if name != "" && !fs.ValidPath(name) {
+				return fmt.Errorf("onieImages[%d]: invalid image path %q for %s", i, name, img.Vendor)
+			}
+		}
+	}
+	return nil
 }
 
 // Register a handler which serves ONIE and SONiC installer images over HTTP.
 // The correct image is selected based on the ONIE-OPERATION and ONIE-MACHINE
-// request headers sent by ONIE clients on every download request.
-func Register(mux *http.ServeMux, onieImagesDir string, cfg Config) {
+// request headers sent by ONIE clients on every download request. The mappings
+// can be replaced at runtime with Handler.Update.
+func Register(mux *http.ServeMux, onieImagesDir string, cfg Config) *Handler {
 	logger := slog.With(
 		"component", "onie",
 		"onieImagesDir", onieImagesDir,
@@ -46,21 +96,45 @@ func Register(mux *http.ServeMux, onieImagesDir string, cfg Config) {
 		logger.Info("images directory configured", "mode", st.Mode().String())
 	}
 
-	images := make(map[string]OnieImage, len(cfg.OnieImages))
-	for _, img := range cfg.OnieImages {
-		images[img.Vendor] = img
-	}
-
-	h := &handler{onieImagesDir: onieImagesDir, images: images, logger: logger}
+	h := &Handler{onieImagesDir: onieImagesDir, logger: logger}
+	h.store(cfg)
 	mux.Handle("GET /onie", h)
+	return h
 }
 
-type handler struct {
+// Handler serves the ONIE and SONiC installer images.
+type Handler struct {
 	onieImagesDir string
-	images        map[string]OnieImage
+	state         atomic.Pointer[handlerState]
 	logger        *slog.Logger
 }
 
+type handlerState struct {
+	images   map[string]OnieImage
+	recorder ImageRecorder
+	proxies  identity.TrustedProxies
+}
+
+// Update validates cfg and replaces the mappings of the handler. Requests which are already
+// being served keep the mappings they started with. If cfg is invalid, the current mappings
+// are kept.
+func (h *Handler) Update(cfg Config) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	h.store(cfg)
+	h.logger.Info("updated image mappings", "vendors", len(cfg.OnieImages))
+	return nil
+}
+
+func (h *Handler) store(cfg Config) {
+	images := make(map[string]OnieImage, len(cfg.OnieImages))
+	for _, img := range cfg.OnieImages {
+		images[img.Vendor] = img
+	}
+	h.state.Store(&handlerState{images: images, recorder: cfg.Recorder, proxies: cfg.TrustedProxies})
+}
+
 type statusRecorder struct {
 	http.ResponseWriter
 	status int
@@ -81,15 +155,22 @@ func (w *statusRecorder) Write(p []byte) (int, error) {
 	return n, err
 }
 
-func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	start := time.Now()
+	state := h.state.Load()
 
-	clientIP, _, _ := clientIdentity(r)
+	id, err := state.proxies.Identify(r)
+	if err != nil {
+		h.logger.Warn("unable to identify client, rejecting", "remoteAddr", r.RemoteAddr, "err", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	clientIP := id.Addr.String()
 
 	operation := r.Header.Get("ONIE-OPERATION")
 	machine := r.Header.Get("ONIE-MACHINE")
 
-	img, ok := h.images[machine]
+	img, ok := state.images[machine]
 	if !ok {
 		h.logger.Warn("unknown ONIE-MACHINE, rejecting", "machine", machine, "clientIP", clientIP)
 		http.NotFound(w, r)
@@ -119,6 +200,8 @@ func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		"cleanURLPath", cleanURLPath,
 		"fsPath", fsPath,
 		"clientIP", clientIP,
+		"clientMAC", id.MAC.String(),
+		"serialNumber", id.SerialNumber,
 		"operation", operation,
 		"machine", machine,
 	)
@@ -153,6 +236,7 @@ func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		if st, err := os.Stat(fsPath); err == nil && st.Mode().IsRegular() {
 			fields = append(fields, "fileSize", st.Size())
 			reqLogger.Info("served download", fields...)
+			recordImage(r.Context(), state.recorder, id, rel, reqLogger)
 			return
 		}
 	}
@@ -160,6 +244,15 @@ func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	reqLogger.Info("served request", fields...)
 }
 
+func recordImage(ctx context.Context, recorder ImageRecorder, id identity.Identity, image string, logger *slog.Logger) {
+	if recorder == nil {
+		return
+	}
+	if err := recorder.RecordImage(ctx, id, image); err != nil {
+		logger.Warn("failed to record served image", "err", err)
+	}
+}
+
 type onieFS struct {
 	baseDir string
 	inner   fs.FS
@@ -178,26 +271,3 @@ func (f *onieFS) Open(name string) (fs.File, error) {
 	}
 	return file, nil
 }
-
-func clientIdentity(r *http.Request) (clientIP string, xForwardedFor string, forwarded string) {
-	xForwardedFor = r.Header.Get("X-Forwarded-For")
-	forwarded = r.Header.Get("Forwarded")
-
-	// Prefer X-Forwarded-For if present; otherwise use RemoteAddr. Keep the raw
-	// header values in logs for correlation/debugging.
-	if xForwardedFor != "" {
-		parts := strings.Split(xForwardedFor, ",")
-		if len(parts) > 0 {
-			ip := strings.TrimSpace(parts[0])
-			if ip != "" {
-				return ip, xForwardedFor, forwarded
-			}
-		}
-	}
-
-	host, _, err := net.SplitHostPort(r.RemoteAddr)
-	if err == nil && host != "" {
-		return host, xForwardedFor, forwarded
-	}
-	return r.RemoteAddr, xForwardedFor, forwarded
-}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package switchutil
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	_ "google.golang.org/grpc/health" // enables client-side health checking
+	"google.golang.org/grpc/keepalive"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	agentCli "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
+)
+
+const (
+	// DefaultKeepaliveTime is the interval of keepalive pings on idle agent connections.
+	DefaultKeepaliveTime = 30 * time.Second
+	// DefaultKeepaliveTimeout is the time to wait for a keepalive ack before the connection is considered broken.
+	DefaultKeepaliveTimeout = 10 * time.Second
+
+	// healthCheckServiceConfig enables the gRPC health checking of the agent.
+	// Client-side health checking requires a load balancing policy supporting it.
+	healthCheckServiceConfig = `{"loadBalancingConfig":[{"round_robin":{}}],"healthCheckConfig":{"serviceName":""}}`
+)
+
+var (
+	agentConnectionState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sonic_operator_agent_connection_state",
+		Help: "State of the gRPC connection to the switch agent. 1 for the current state, 0 otherwise.",
+	}, []string{"switch", "state"})
+
+	connectionStates = []connectivity.State{
+		connectivity.Idle,
+		connectivity.Connecting,
+		connectivity.Ready,
+		connectivity.TransientFailure,
+		connectivity.Shutdown,
+	}
+)
+
+func init() {
+	metrics.Registry.MustRegister(agentConnectionState)
+}
+
+// DefaultConnectionManager is the ConnectionManager used by NewAgentClientForSwitch and NewAgentClientFromSwitchRef.
+var DefaultConnectionManager = NewConnectionManager()
+
+// ConnectionManager keeps one long-lived gRPC connection per Switch. A connection
+// is replaced when the management endpoint or the credentials of the Switch change.
+type ConnectionManager struct {
+	KeepaliveTime    time.Duration
+	KeepaliveTimeout time.Duration
+
+	mu    sync.Mutex
+	conns map[string]*connection
+}
+
+type connection struct {
+	address     string
+	fingerprint string
+	conn        *grpc.ClientConn
+	cancel      context.CancelFunc
+}
+
+func NewConnectionManager() *ConnectionManager {
+	return &ConnectionManager{
+		KeepaliveTime:    DefaultKeepaliveTime,
+		KeepaliveTimeout: DefaultKeepaliveTimeout,
+		conns:            map[string]*connection{},
+	}
+}
+
+// ClientForSwitch returns an agent client using the pooled connection of the Switch.
+func (m *ConnectionManager) ClientForSwitch(ctx context.Context, cli client.Reader, s *networkingv1alpha1.Switch) (agentCli.SwitchAgentClient, error) {
+	creds, err := GetCredentialsForSwitch(ctx, cli, s)
+	if err != nil {
+		return nil, err
+	}
+
+	address := "localhost:50051"
+	if s.Spec.Management.Host != "" || s.Spec.Management.Port != "" {
+		address = net.JoinHostPort(s.Spec.Management.Host, s.Spec.Management.Port)
+	}
+	fingerprint := credentialsFingerprint(creds)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if c, ok := m.conns[s.Name]; ok {
+		if c.address == address && c.fingerprint == fingerprint {
+			return agentCli.NewSwitchAgentClientForConn(c.conn), nil
+		}
+		m.close(s.Name, c)
+	}
+
+	opts, err := creds.DialOptions()
+	if err != nil {
+		return nil, fmt.Errorf("invalid credentials for switch %s: %w", s.Name, err)
+	}
+	opts = append(opts,
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                m.KeepaliveTime,
+			Timeout:             m.KeepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithDefaultServiceConfig(healthCheckServiceConfig),
+	)
+
+	conn, err := grpc.NewClient(address, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to switch agent: %w", err)
+	}
+	conn.Connect()
+
+	stateCtx, cancel := context.WithCancel(context.Background())
+	m.conns[s.Name] = &connection{
+		address:     address,
+		fingerprint: fingerprint,
+		conn:        conn,
+		cancel:      cancel,
+	}
+	go trackConnectionState(stateCtx, s.Name, conn)
+
+	return agentCli.NewSwitchAgentClientForConn(conn), nil
+}
+
+// State returns the state of the connection to the Switch with the given name,
+// and false if there is no connection.
+func (m *ConnectionManager) State(name string) (connectivity.State, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c, ok := m.conns[name]
+	if !ok {
+		return connectivity.Shutdown, false
+	}
+	return c.conn.GetState(), true
+}
+
+// Evict closes the connection to the Switch with the given name.
+func (m *ConnectionManager) Evict(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if c, ok := m.conns[name]; ok {
+		m.close(name, c)
+	}
+}
+
+func (m *ConnectionManager) close(name string, c *connection) {
+	c.cancel()
+	_ = c.conn.Close()
+	delete(m.conns, name)
+	for _, state := range connectionStates {
+		agentConnectionState.DeleteLabelValues(name, state.String())
+	}
+}
+
+// trackConnectionState records the state of the connection until the context is cancelled.
+func trackConnectionState(ctx context.Context, name string, conn *grpc.ClientConn) {
+	for {
+		current := conn.GetState()
+		for _, state := range connectionStates {
+			value := 0.0
+			if state == current {
+				value = 1
+			}
+			agentConnectionState.WithLabelValues(name, state.String()).Set(value)
+		}
+		if !conn.WaitForStateChange(ctx, current) {
+			return
+		}
+	}
+}
+
+func credentialsFingerprint(creds *agentCli.Credentials) string {
+	if creds == nil {
+		return ""
+	}
+	h := sha256.New()
+	for _, part := range [][]byte{creds.CACert, creds.Cert, creds.Key, []byte(creds.Token)} {
+		_, _ = fmt.Fprintf(h, "%d:", len(part))
+		_, _ = h.Write(part)
+	}
+	_, _ = fmt.Fprintf(h, "%t", creds.Insecure)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -5,6 +5,8 @@ package switchutil
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
 	v1 "k8s.io/api/core/v1"
@@ -14,22 +16,61 @@ import (
 	agentCli "github.com/ironcore-dev/sonic-operator/internal/agent/agent_client/client"
 )
 
-func NewAgentClientForSwitch(ctx context.Context, s *networkingv1alpha1.Switch) (agentCli.SwitchAgentClient, error) {
-	// TODO: construct client from s.spec.Management
+const (
+	// CredentialsCACertKey is the SwitchCredentials key of the CA bundle used to verify the agent certificate.
+	CredentialsCACertKey = v1.ServiceAccountRootCAKey
+	// CredentialsCertKey is the SwitchCredentials key of the client certificate presented to the agent.
+	CredentialsCertKey = v1.TLSCertKey
+	// CredentialsKeyKey is the SwitchCredentials key of the client key presented to the agent.
+	CredentialsKeyKey = v1.TLSPrivateKeyKey
+	// CredentialsTokenKey is the SwitchCredentials key of the bearer token presented to the agent.
+	CredentialsTokenKey = v1.ServiceAccountTokenKey
+)
+
+// NewAgentClientForSwitch returns an agent client for the Switch using the pooled
+// connection of the DefaultConnectionManager.
+func NewAgentClientForSwitch(ctx context.Context, cli client.Reader, s *networkingv1alpha1.Switch) (agentCli.SwitchAgentClient, error) {
+	return DefaultConnectionManager.ClientForSwitch(ctx, cli, s)
+}
 
-	if s.Spec.Management.Host == "" && s.Spec.Management.Port == "" {
-		agentcli, err := agentCli.NewDefaultSwitchAgentClient("", 0)
-		return agentcli, err
+// GetCredentialsForSwitch returns the agent credentials from the SwitchCredentials
+// referenced by the Switch, or nil if the Switch does not reference any.
+func GetCredentialsForSwitch(ctx context.Context, cli client.Reader, s *networkingv1alpha1.Switch) (*agentCli.Credentials, error) {
+	ref := s.Spec.Management.Credentials
+	if ref.Name == "" {
+		return nil, nil
 	}
 
-	address := s.Spec.Management.Host + ":" + s.Spec.Management.Port
+	switchCredentials := &networkingv1alpha1.SwitchCredentials{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, switchCredentials); err != nil {
+		return nil, fmt.Errorf("failed to get SwitchCredentials %s: %w", ref.Name, err)
+	}
 
-	agentcli, err := agentCli.NewDefaultSwitchAgentClient(address, 0)
-	if err != nil {
-		return nil, err
+	return CredentialsFromSwitchCredentials(switchCredentials), nil
+}
+
+// CredentialsFromSwitchCredentials converts SwitchCredentials into agent credentials.
+// TLS is used if a CA bundle or a client certificate is present.
+func CredentialsFromSwitchCredentials(sc *networkingv1alpha1.SwitchCredentials) *agentCli.Credentials {
+	value := func(key string) []byte {
+		if v, ok := sc.Data[key]; ok {
+			return v
+		}
+		if v, ok := sc.StringData[key]; ok {
+			return []byte(v)
+		}
+		return nil
 	}
 
-	return agentcli, nil
+	creds := &agentCli.Credentials{
+		CACert: value(CredentialsCACertKey),
+		Cert:   value(CredentialsCertKey),
+		Key:    value(CredentialsKeyKey),
+		Token:  strings.TrimSpace(string(value(CredentialsTokenKey))),
+	}
+	creds.Insecure = len(creds.CACert) == 0 && len(creds.Cert) == 0
+
+	return creds
 }
 
 func NewAgentClientFromSwitchRef(ctx context.Context, cli client.Reader, ref *v1.LocalObjectReference, nameSpace string) (agentCli.SwitchAgentClient, error) {
@@ -47,7 +88,7 @@ func NewAgentClientFromSwitchRef(ctx context.Context, cli client.Reader, ref *v1
 		return nil, err
 	}
 
-	agentcli, err := NewAgentClientForSwitch(ctx, ownerSwitch)
+	agentcli, err := NewAgentClientForSwitch(ctx, cli, ownerSwitch)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+)
+
+// log is for logging in this package.
+var switchlog = logf.Log.WithName("switch-resource")
+
+// SetupSwitchWebhookWithManager registers the webhook for Switch in the manager.
+func SetupSwitchWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &networkingv1alpha1.Switch{}).
+		WithValidator(&SwitchCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-sonic-networking-metal-ironcore-dev-v1alpha1-switch,mutating=false,failurePolicy=fail,sideEffects=None,groups=sonic.networking.metal.ironcore.dev,resources=switches,verbs=create;update,versions=v1alpha1,name=vswitch-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// SwitchCustomValidator struct is responsible for validating the Switch resource
+// when it is created, updated, or deleted.
+type SwitchCustomValidator struct {
+	Client client.Reader
+}
+
+var _ admission.Validator[*networkingv1alpha1.Switch] = &SwitchCustomValidator{}
+
+// ValidateCreate implements admission.Validator so a webhook will be registered for the type Switch.
+func (v *SwitchCustomValidator) ValidateCreate(ctx context.Context, s *networkingv1alpha1.Switch) (admission.Warnings, error) {
+	switchlog.Info("Validation for Switch upon creation", "name", s.GetName())
+
+	return nil, v.validate(ctx, nil, s)
+}
+
+// ValidateUpdate implements admission.Validator so a webhook will be registered for the type Switch.
+// Switches being deleted are not validated, so finalizers can always be removed.
+func (v *SwitchCustomValidator) ValidateUpdate(ctx context.Context, oldObj, s *networkingv1alpha1.Switch) (admission.Warnings, error) {
+	switchlog.Info("Validation for Switch upon update", "name", s.GetName())
+
+	if s.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	return nil, v.validate(ctx, oldObj, s)
+}
+
+// ValidateDelete implements admission.Validator so a webhook will be registered for the type Switch.
+func (v *SwitchCustomValidator) ValidateDelete(_ context.Context, _ *networkingv1alpha1.Switch) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate validates the Switch. On updates, oldObj is set and only changed fields are validated, so
+// objects admitted before a check was added can still be updated, e.g. by finalizer patches.
+func (v *SwitchCustomValidator) validate(ctx context.Context, oldObj, s *networkingv1alpha1.Switch) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	old := &networkingv1alpha1.Switch{}
+	if oldObj != nil {
+		old = oldObj
+	}
+	changed := func(oldValue, newValue any) bool {
+		return oldObj == nil || !equality.Semantic.DeepEqual(oldValue, newValue)
+	}
+
+	if changed(old.Spec.MacAddress, s.Spec.MacAddress) {
+		if _, err := net.ParseMAC(s.Spec.MacAddress); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("macAddress"), s.Spec.MacAddress, "must be a valid MAC address"))
+		}
+	}
+
+	managementPath := specPath.Child("management")
+	if changed(old.Spec.Management.Host, s.Spec.Management.Host) || changed(old.Spec.Management.Port, s.Spec.Management.Port) {
+		if host := s.Spec.Management.Host; host != "" && net.ParseIP(host) == nil {
+			for _, msg := range validation.IsDNS1123Subdomain(host) {
+				allErrs = append(allErrs, field.Invalid(managementPath.Child("host"), host, "must be an IP address or a DNS name: "+msg))
+			}
+		}
+		if port := s.Spec.Management.Port; port != "" {
+			if p, err := strconv.Atoi(port); err != nil || p < 1 || p > 65535 {
+				allErrs = append(allErrs, field.Invalid(managementPath.Child("port"), port, "must be a port number between 1 and 65535"))
+			}
+		}
+		if (s.Spec.Management.Host == "") != (s.Spec.Management.Port == "") {
+			allErrs = append(allErrs, field.Invalid(managementPath, fmt.Sprintf("%s:%s", s.Spec.Management.Host, s.Spec.Management.Port), "host and port must be set together"))
+		}
+	}
+
+	if ref := s.Spec.Management.Credentials; ref.Name != "" && changed(old.Spec.Management.Credentials, ref) {
+		credentialsPath := managementPath.Child("credentials")
+		credentials := &networkingv1alpha1.SwitchCredentials{}
+		err := v.Client.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, credentials)
+		switch {
+		case apierrors.IsNotFound(err):
+			allErrs = append(allErrs, field.NotFound(credentialsPath.Child("name"), ref.Name))
+		case err != nil:
+			allErrs = append(allErrs, field.InternalError(credentialsPath, err))
+		}
+	}
+
+	if p := s.Spec.Provisioning; p != nil && changed(old.Spec.Provisioning, p) {
+		provisioningPath := specPath.Child("provisioning")
+		if prefix, err := netip.ParsePrefix(p.Prefix); err != nil || prefix.Bits() != 64 {
+			allErrs = append(allErrs, field.Invalid(provisioningPath.Child("prefix"), p.Prefix, "must be a /64 prefix"))
+		}
+		if _, err := netip.ParsePrefix(p.LoopbackIP); err != nil {
+			allErrs = append(allErrs, field.Invalid(provisioningPath.Child("loopbackIP"), p.LoopbackIP, "must be an address in CIDR notation"))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(networkingv1alpha1.GroupVersion.WithKind("Switch").GroupKind(), s.Name, allErrs)
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+)
+
+var _ = Describe("Switch Webhook", func() {
+	var (
+		obj       *networkingv1alpha1.Switch
+		validator SwitchCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &networkingv1alpha1.Switch{
+			ObjectMeta: metav1.ObjectMeta{Name: "switch-webhook"},
+			Spec: networkingv1alpha1.SwitchSpec{
+				Management: networkingv1alpha1.Management{
+					Host: "192.0.2.10",
+					Port: "50051",
+				},
+				MacAddress: "aa:bb:cc:dd:ee:ff",
+			},
+		}
+		validator = SwitchCustomValidator{Client: k8sClient}
+	})
+
+	Context("When creating or updating Switch under Validating Webhook", func() {
+		It("Should admit a valid Switch", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny an invalid MAC address", func() {
+			obj.Spec.MacAddress = "aa:bb:cc"
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(MatchError(ContainSubstring("spec.macAddress")))
+		})
+
+		It("Should deny an invalid host and port", func() {
+			obj.Spec.Management.Host = "not_a_host"
+			obj.Spec.Management.Port = "70000"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.management.host")))
+			Expect(err).To(MatchError(ContainSubstring("spec.management.port")))
+		})
+
+		It("Should deny provisioning parameters without a /64 prefix", func() {
+			obj.Spec.Provisioning = &networkingv1alpha1.ProvisioningSpec{
+				Type:       "leaf",
+				Prefix:     "2001:db8::/48",
+				LoopbackIP: "2001:db8::/128",
+				ASNumber:   65000,
+			}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(MatchError(ContainSubstring("spec.provisioning.prefix")))
+		})
+
+		It("Should deny a reference to missing SwitchCredentials", func() {
+			oldObj := obj.DeepCopy()
+			obj.Spec.Management.Credentials = corev1.ObjectReference{Name: "missing"}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().To(MatchError(ContainSubstring("spec.management.credentials.name")))
+		})
+
+		It("Should admit a reference to existing SwitchCredentials", func() {
+			credentials := &networkingv1alpha1.SwitchCredentials{ObjectMeta: metav1.ObjectMeta{Name: "switch-webhook-credentials"}}
+			Expect(k8sClient.Create(ctx, credentials)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, credentials)
+
+			obj.Spec.Management.Credentials = corev1.ObjectReference{Name: credentials.Name}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+	})
+
+	Context("When updating Switch under Validating Webhook", func() {
+		It("Should deny changing the MAC address to an invalid one", func() {
+			oldObj := obj.DeepCopy()
+			obj.Spec.MacAddress = "aa:bb:cc"
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().To(MatchError(ContainSubstring("spec.macAddress")))
+		})
+
+		It("Should admit updates keeping an invalid MAC address and missing SwitchCredentials", func() {
+			obj.Spec.MacAddress = "aa:bb:cc"
+			obj.Spec.Management.Credentials = corev1.ObjectReference{Name: "missing"}
+			oldObj := obj.DeepCopy()
+			obj.Labels = map[string]string{"rack": "r1"}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should admit removing the finalizer of a Switch being deleted", func() {
+			obj.Spec.MacAddress = "aa:bb:cc"
+			obj.Finalizers = []string{networkingv1alpha1.SwitchFinalizer}
+			oldObj := obj.DeepCopy()
+			obj.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			obj.Finalizers = nil
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+	})
+})
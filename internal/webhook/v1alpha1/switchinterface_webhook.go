@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+)
+
+// log is for logging in this package.
+var switchinterfacelog = logf.Log.WithName("switchinterface-resource")
+
+// SetupSwitchInterfaceWebhookWithManager registers the webhook for SwitchInterface in the manager.
+// The name tables and deriveNames must match the configuration of the switch agents.
+func SetupSwitchInterfaceWebhookWithManager(mgr ctrl.Manager, nameTables agent.NameTables, deriveNames bool) error {
+	return ctrl.NewWebhookManagedBy(mgr, &networkingv1alpha1.SwitchInterface{}).
+		WithValidator(&SwitchInterfaceCustomValidator{
+			Client:      mgr.GetClient(),
+			NameTables:  nameTables,
+			DeriveNames: deriveNames,
+		}).
+		WithDefaulter(&SwitchInterfaceCustomDefaulter{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-sonic-networking-metal-ironcore-dev-v1alpha1-switchinterface,mutating=true,failurePolicy=fail,sideEffects=None,groups=sonic.networking.metal.ironcore.dev,resources=switchinterfaces,verbs=create;update,versions=v1alpha1,name=mswitchinterface-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// SwitchInterfaceCustomDefaulter struct is responsible for setting default values on the custom resource of the
+// Kind SwitchInterface when those are created or updated.
+type SwitchInterfaceCustomDefaulter struct{}
+
+var _ admission.Defaulter[*networkingv1alpha1.SwitchInterface] = &SwitchInterfaceCustomDefaulter{}
+
+// Default implements admission.Defaulter so a webhook will be registered for the Kind SwitchInterface.
+func (d *SwitchInterfaceCustomDefaulter) Default(_ context.Context, i *networkingv1alpha1.SwitchInterface) error {
+	switchinterfacelog.Info("Defaulting for SwitchInterface", "name", i.GetName())
+
+	if i.Spec.AdminState == "" {
+		i.Spec.AdminState = networkingv1alpha1.AdminStateUp
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-sonic-networking-metal-ironcore-dev-v1alpha1-switchinterface,mutating=false,failurePolicy=fail,sideEffects=None,groups=sonic.networking.metal.ironcore.dev,resources=switchinterfaces,verbs=create;update,versions=v1alpha1,name=vswitchinterface-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// SwitchInterfaceCustomValidator struct is responsible for validating the SwitchInterface resource
+// when it is created, updated, or deleted.
+type SwitchInterfaceCustomValidator struct {
+	Client client.Reader
+
+	// NameTables holds the explicit tables of native to abstract names by HWSKU the agents use.
+	NameTables agent.NameTables
+	// DeriveNames is set if the agents derive the names from the lane data of the platform, which is
+	// not known here, for HWSKUs without a name table.
+	DeriveNames bool
+}
+
+var _ admission.Validator[*networkingv1alpha1.SwitchInterface] = &SwitchInterfaceCustomValidator{}
+
+// ValidateCreate implements admission.Validator so a webhook will be registered for the type SwitchInterface.
+func (v *SwitchInterfaceCustomValidator) ValidateCreate(ctx context.Context, i *networkingv1alpha1.SwitchInterface) (admission.Warnings, error) {
+	switchinterfacelog.Info("Validation for SwitchInterface upon creation", "name", i.GetName())
+
+	return nil, v.validate(ctx, nil, i)
+}
+
+// ValidateUpdate implements admission.Validator so a webhook will be registered for the type SwitchInterface.
+// SwitchInterfaces being deleted are not validated, so finalizers can always be removed.
+func (v *SwitchInterfaceCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *networkingv1alpha1.SwitchInterface) (admission.Warnings, error) {
+	switchinterfacelog.Info("Validation for SwitchInterface upon update", "name", newObj.GetName())
+
+	if newObj.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	return nil, v.validate(ctx, oldObj, newObj)
+}
+
+// ValidateDelete implements admission.Validator so a webhook will be registered for the type SwitchInterface.
+func (v *SwitchInterfaceCustomValidator) ValidateDelete(_ context.Context, _ *networkingv1alpha1.SwitchInterface) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate validates the SwitchInterface. On updates, oldObj is set and only changed fields are
+// validated, so objects admitted before a check was added can still be updated, e.g. by finalizer patches.
+func (v *SwitchInterfaceCustomValidator) validate(ctx context.Context, oldObj, i *networkingv1alpha1.SwitchInterface) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	old := &networkingv1alpha1.SwitchInterface{}
+	if oldObj != nil {
+		old = oldObj
+	}
+	changed := func(oldValue, newValue any) bool {
+		return oldObj == nil || !equality.Semantic.DeepEqual(oldValue, newValue)
+	}
+	switchRefChanged := changed(old.Spec.SwitchRef, i.Spec.SwitchRef)
+	nativeNameChanged := changed(old.Spec.NativeName, i.Spec.NativeName)
+
+	// The handle is checked with the naming scheme the agent uses for the Switch.
+	if changed(old.Spec.Handle, i.Spec.Handle) || nativeNameChanged || switchRefChanged {
+		allErrs = append(allErrs, v.validateHandle(ctx, i, specPath)...)
+	}
+
+	if oldObj != nil && nativeNameChanged {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("nativeName"), "field is immutable"))
+	}
+
+	if switchRefChanged && (i.Spec.SwitchRef == nil || i.Spec.SwitchRef.Name == "") {
+		allErrs = append(allErrs, field.Required(specPath.Child("switchRef", "name"), "a Switch must be referenced"))
+	}
+
+	if changed(old.Spec.AdminState, i.Spec.AdminState) {
+		switch i.Spec.AdminState {
+		case networkingv1alpha1.AdminStateUp, networkingv1alpha1.AdminStateDown:
+		default:
+			allErrs = append(allErrs, field.NotSupported(specPath.Child("adminState"), i.Spec.AdminState,
+				[]networkingv1alpha1.AdminState{networkingv1alpha1.AdminStateUp, networkingv1alpha1.AdminStateDown}))
+		}
+	}
+
+	// Listing all SwitchInterfaces is expensive, so duplicates are only checked if the claim changed.
+	if (switchRefChanged || nativeNameChanged) && i.Spec.SwitchRef != nil && i.Spec.SwitchRef.Name != "" && i.Spec.NativeName != "" {
+		switchInterfaces := &networkingv1alpha1.SwitchInterfaceList{}
+		if err := v.Client.List(ctx, switchInterfaces); err != nil {
+			allErrs = append(allErrs, field.InternalError(specPath.Child("nativeName"), err))
+		}
+		for _, other := range switchInterfaces.Items {
+			if other.Name == i.Name || other.Spec.SwitchRef == nil {
+				continue
+			}
+			if other.Spec.SwitchRef.Name == i.Spec.SwitchRef.Name && other.Spec.NativeName == i.Spec.NativeName {
+				allErrs = append(allErrs, field.Duplicate(specPath.Child("nativeName"), i.Spec.NativeName))
+				break
+			}
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(networkingv1alpha1.GroupVersion.WithKind("SwitchInterface").GroupKind(), i.Name, allErrs)
+}
+
+// validateHandle checks that the handle is an abstract name which the agent maps to the native name.
+func (v *SwitchInterfaceCustomValidator) validateHandle(ctx context.Context, i *networkingv1alpha1.SwitchInterface, specPath *field.Path) field.ErrorList {
+	handlePath := specPath.Child("handle")
+	if _, _, err := agent.ParseAbstractName(i.Spec.Handle); err != nil {
+		return field.ErrorList{field.Invalid(handlePath, i.Spec.Handle, err.Error())}
+	}
+
+	mapper, err := v.nameMapper(ctx, i)
+	if err != nil {
+		return field.ErrorList{field.InternalError(handlePath, err)}
+	}
+	if mapper == nil {
+		return nil
+	}
+	nativeName, err := mapper.AbstractToNative(i.Spec.Handle)
+	if err != nil {
+		return field.ErrorList{field.Invalid(handlePath, i.Spec.Handle, err.Error())}
+	}
+	if i.Spec.NativeName != "" && i.Spec.NativeName != nativeName {
+		return field.ErrorList{field.Invalid(specPath.Child("nativeName"), i.Spec.NativeName,
+			fmt.Sprintf("does not match %s, the native name of handle %s", nativeName, i.Spec.Handle))}
+	}
+	return nil
+}
+
+// nameMapper returns the NameMapper the agent uses for the Switch of the interface, selected by the
+// HWSKU reported in its status. It returns nil if the names are derived from the platform.
+func (v *SwitchInterfaceCustomValidator) nameMapper(ctx context.Context, i *networkingv1alpha1.SwitchInterface) (agent.NameMapper, error) {
+	var hwsku string
+	if i.Spec.SwitchRef != nil && i.Spec.SwitchRef.Name != "" {
+		s := &networkingv1alpha1.Switch{}
+		if err := v.Client.Get(ctx, client.ObjectKey{Name: i.Spec.SwitchRef.Name}, s); client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		hwsku = s.Status.SKU
+	}
+
+	if table, ok := v.NameTables[hwsku]; ok {
+		return agent.NewTableNameMapper(table)
+	}
+	if v.DeriveNames {
+		return nil, nil
+	}
+	return agent.DefaultNameMapper, nil
+}
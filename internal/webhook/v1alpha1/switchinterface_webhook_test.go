@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	agent "github.com/ironcore-dev/sonic-operator/internal/agent/types"
+)
+
+var _ = Describe("SwitchInterface Webhook", func() {
+	var (
+		obj       *networkingv1alpha1.SwitchInterface
+		validator SwitchInterfaceCustomValidator
+		defaulter SwitchInterfaceCustomDefaulter
+	)
+
+	BeforeEach(func() {
+		obj = &networkingv1alpha1.SwitchInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "switchinterface-webhook"},
+			Spec: networkingv1alpha1.SwitchInterfaceSpec{
+				Handle:     "eth0-0",
+				NativeName: "Ethernet0",
+				SwitchRef:  &corev1.LocalObjectReference{Name: "switch-webhook"},
+				AdminState: networkingv1alpha1.AdminStateUp,
+			},
+		}
+		validator = SwitchInterfaceCustomValidator{Client: k8sClient}
+		defaulter = SwitchInterfaceCustomDefaulter{}
+	})
+
+	Context("When creating SwitchInterface under Defaulting Webhook", func() {
+		It("Should default the admin state to Up", func() {
+			obj.Spec.AdminState = ""
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.AdminState).To(Equal(networkingv1alpha1.AdminStateUp))
+		})
+
+		It("Should keep an explicit admin state", func() {
+			obj.Spec.AdminState = networkingv1alpha1.AdminStateDown
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.AdminState).To(Equal(networkingv1alpha1.AdminStateDown))
+		})
+	})
+
+	Context("When creating or updating SwitchInterface under Validating Webhook", func() {
+		It("Should admit a valid SwitchInterface", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny a handle that cannot be converted", func() {
+			obj.Spec.Handle = "Ethernet0"
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(MatchError(ContainSubstring("spec.handle")))
+		})
+
+		It("Should deny a missing switch reference", func() {
+			obj.Spec.SwitchRef = nil
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(MatchError(ContainSubstring("spec.switchRef.name")))
+		})
+
+		It("Should deny an unknown admin state", func() {
+			obj.Spec.AdminState = networkingv1alpha1.AdminStateUnknown
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(MatchError(ContainSubstring("spec.adminState")))
+		})
+
+		It("Should deny changing the native name", func() {
+			oldObj := obj.DeepCopy()
+			obj.Spec.NativeName = "Ethernet4"
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().To(MatchError(ContainSubstring("spec.nativeName")))
+		})
+
+		It("Should deny a second interface with the same native name on the switch", func() {
+			existing := obj.DeepCopy()
+			existing.Name = "switchinterface-webhook-existing"
+			Expect(k8sClient.Create(ctx, existing)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, existing)
+
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(MatchError(ContainSubstring("Duplicate value")))
+
+			obj.Spec.SwitchRef = &corev1.LocalObjectReference{Name: "other-switch"}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+	})
+
+	Context("When updating SwitchInterface under Validating Webhook", func() {
+		It("Should admit updates of an interface colliding with another one", func() {
+			existing := obj.DeepCopy()
+			existing.Name = "switchinterface-webhook-existing"
+			Expect(k8sClient.Create(ctx, existing)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, existing)
+
+			oldObj := obj.DeepCopy()
+			obj.Spec.AdminState = networkingv1alpha1.AdminStateDown
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny moving an interface to a Switch where its native name is claimed", func() {
+			existing := obj.DeepCopy()
+			existing.Name = "switchinterface-webhook-existing"
+			Expect(k8sClient.Create(ctx, existing)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, existing)
+
+			oldObj := obj.DeepCopy()
+			oldObj.Spec.SwitchRef = &corev1.LocalObjectReference{Name: "other-switch"}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().To(MatchError(ContainSubstring("Duplicate value")))
+		})
+
+		It("Should admit updates keeping an unsupported admin state and a missing switch reference", func() {
+			obj.Spec.AdminState = networkingv1alpha1.AdminStateUnknown
+			obj.Spec.SwitchRef = nil
+			oldObj := obj.DeepCopy()
+			obj.Labels = map[string]string{"rack": "r1"}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should admit removing the finalizer of an interface being deleted", func() {
+			obj.Spec.AdminState = networkingv1alpha1.AdminStateUnknown
+			obj.Finalizers = []string{networkingv1alpha1.SwitchFinalizer}
+			oldObj := obj.DeepCopy()
+			obj.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			obj.Finalizers = nil
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+	})
+
+	Context("When checking the handle with the naming scheme of the agent", func() {
+		It("Should deny a handle the default scheme cannot map", func() {
+			obj.Spec.Handle = "eth1-4"
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(MatchError(ContainSubstring("spec.handle")))
+		})
+
+		It("Should deny a native name not matching the handle", func() {
+			obj.Spec.NativeName = "Ethernet4"
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(MatchError(ContainSubstring("spec.nativeName")))
+		})
+
+		It("Should use the name table of the HWSKU of the Switch", func() {
+			s := &networkingv1alpha1.Switch{
+				ObjectMeta: metav1.ObjectMeta{Name: "switch-webhook-table"},
+				Spec:       networkingv1alpha1.SwitchSpec{MacAddress: "aa:bb:cc:dd:ee:ff"},
+			}
+			Expect(k8sClient.Create(ctx, s)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, s)
+			s.Status.SKU = "Accton-AS7726-32X"
+			Expect(k8sClient.Status().Update(ctx, s)).To(Succeed())
+
+			validator.NameTables = agent.NameTables{
+				"Accton-AS7726-32X": {"Ethernet0": "eth1-0"},
+			}
+			obj.Spec.SwitchRef = &corev1.LocalObjectReference{Name: s.Name}
+			obj.Spec.Handle = "eth1-0"
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+
+			obj.Spec.Handle = "eth0-0"
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(MatchError(ContainSubstring("spec.handle")))
+		})
+
+		It("Should only check the format if the agents derive the names from the platform", func() {
+			validator.DeriveNames = true
+			obj.Spec.Handle = "eth1-4"
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should not check an unchanged handle again", func() {
+			obj.Spec.Handle = "eth1-0"
+			oldObj := obj.DeepCopy()
+			obj.Spec.AdminState = networkingv1alpha1.AdminStateDown
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+	})
+})
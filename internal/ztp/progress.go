@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ztp
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	"github.com/ironcore-dev/sonic-operator/internal/identity"
+)
+
+// Stage is a step of the ZTP script reported to POST /ztp/status.
+type Stage string
+
+const (
+	StageStage1Start  Stage = "stage1-start"
+	StageStage1Done   Stage = "stage1-done"
+	StageStage2Done   Stage = "stage2-done"
+	StageAgentStarted Stage = "agent-started"
+)
+
+var stagePhases = map[Stage]networkingv1alpha1.ProvisioningPhase{
+	StageStage1Start:  networkingv1alpha1.ProvisioningPhaseStage1Started,
+	StageStage1Done:   networkingv1alpha1.ProvisioningPhaseStage1Done,
+	StageStage2Done:   networkingv1alpha1.ProvisioningPhaseStage2Done,
+	StageAgentStarted: networkingv1alpha1.ProvisioningPhaseAgentStarted,
+}
+
+// ProgressRecorder records the provisioning progress of a switch.
+type ProgressRecorder interface {
+	// RecordPhase records that the switch with the given identity reached phase.
+	RecordPhase(ctx context.Context, id identity.Identity, phase networkingv1alpha1.ProvisioningPhase) error
+}
+
+// SwitchStatusRecorder records the provisioning progress in status.provisioning of the Switch matching
+// the identity like SwitchResolver does. Switches without a Switch object, e.g., the ones from
+// the static config, are skipped. It also implements onie.ImageRecorder.
+type SwitchStatusRecorder struct {
+	Client client.Client
+}
+
+func (r *SwitchStatusRecorder) RecordPhase(ctx context.Context, id identity.Identity, phase networkingv1alpha1.ProvisioningPhase) error {
+	return r.record(ctx, id, func(p *networkingv1alpha1.ProvisioningStatus, now *metav1.Time) {
+		p.Phase = phase
+		switch phase {
+		case networkingv1alpha1.ProvisioningPhaseScriptServed:
+			// The script is downloaded again after the reboot between stage 1 and 2, which continues
+			// the run. Only a download after a finished or a not yet started run starts a new one.
+			if p.AgentStartedTime != nil || p.Stage1StartedTime == nil {
+				clearScriptStages(p)
+			}
+			p.ScriptServedTime = now
+		case networkingv1alpha1.ProvisioningPhaseStage1Started:
+			p.Stage1StartedTime = now
+		case networkingv1alpha1.ProvisioningPhaseStage1Done:
+			p.Stage1DoneTime = now
+		case networkingv1alpha1.ProvisioningPhaseStage2Done:
+			p.Stage2DoneTime = now
+		case networkingv1alpha1.ProvisioningPhaseAgentStarted:
+			p.AgentStartedTime = now
+		}
+	})
+}
+
+func (r *SwitchStatusRecorder) RecordImage(ctx context.Context, id identity.Identity, image string) error {
+	return r.record(ctx, id, func(p *networkingv1alpha1.ProvisioningStatus, now *metav1.Time) {
+		p.Phase = networkingv1alpha1.ProvisioningPhaseImageServed
+		p.Image = image
+		p.ImageServedTime = now
+		p.ScriptServedTime = nil
+		clearScriptStages(p)
+	})
+}
+
+// clearScriptStages clears the times of the stages of the ZTP script, so that a new provisioning run
+// does not report the stages of the previous one.
+func clearScriptStages(p *networkingv1alpha1.ProvisioningStatus) {
+	p.Stage1StartedTime = nil
+	p.Stage1DoneTime = nil
+	p.Stage2DoneTime = nil
+	p.AgentStartedTime = nil
+}
+
+func (r *SwitchStatusRecorder) record(ctx context.Context, id identity.Identity, update func(p *networkingv1alpha1.ProvisioningStatus, now *metav1.Time)) error {
+	s, err := findSwitch(ctx, r.Client, id, false)
+	if err != nil || s == nil {
+		return err
+	}
+
+	original := s.DeepCopy()
+	if s.Status.Provisioning == nil {
+		s.Status.Provisioning = &networkingv1alpha1.ProvisioningStatus{}
+	}
+	now := metav1.Now()
+	s.Status.Provisioning.LastUpdateTime = &now
+	update(s.Status.Provisioning, &now)
+
+	if err := r.Client.Status().Patch(ctx, s, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to patch status of Switch %s: %w", s.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ztp
+
+import (
+	"context"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	"github.com/ironcore-dev/sonic-operator/internal/identity"
+)
+
+var _ = Describe("SwitchStatusRecorder", func() {
+	const mac = "aa:bb:cc:dd:ee:ff"
+
+	ctx := context.Background()
+
+	var (
+		c        client.Client
+		recorder *SwitchStatusRecorder
+		id       identity.Identity
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(networkingv1alpha1.AddToScheme(scheme)).To(Succeed())
+		c = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithStatusSubresource(&networkingv1alpha1.Switch{}).
+			WithObjects(&networkingv1alpha1.Switch{
+				ObjectMeta: metav1.ObjectMeta{Name: "leaf-1"},
+				Spec:       networkingv1alpha1.SwitchSpec{MacAddress: mac},
+			}).
+			Build()
+		recorder = &SwitchStatusRecorder{Client: c}
+
+		hw, err := net.ParseMAC(mac)
+		Expect(err).NotTo(HaveOccurred())
+		id = identity.Identity{MAC: hw}
+	})
+
+	provisioning := func() *networkingv1alpha1.ProvisioningStatus {
+		s := &networkingv1alpha1.Switch{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "leaf-1"}, s)).To(Succeed())
+		Expect(s.Status.Provisioning).NotTo(BeNil())
+		return s.Status.Provisioning
+	}
+
+	recordRun := func() {
+		for _, phase := range []networkingv1alpha1.ProvisioningPhase{
+			networkingv1alpha1.ProvisioningPhaseScriptServed,
+			networkingv1alpha1.ProvisioningPhaseStage1Started,
+			networkingv1alpha1.ProvisioningPhaseStage1Done,
+			networkingv1alpha1.ProvisioningPhaseStage2Done,
+			networkingv1alpha1.ProvisioningPhaseAgentStarted,
+		} {
+			Expect(recorder.RecordPhase(ctx, id, phase)).To(Succeed())
+		}
+	}
+
+	It("should record the times of the phases", func() {
+		Expect(recorder.RecordImage(ctx, id, "sonic.bin")).To(Succeed())
+		recordRun()
+
+		p := provisioning()
+		Expect(p.Phase).To(Equal(networkingv1alpha1.ProvisioningPhaseAgentStarted))
+		Expect(p.Image).To(Equal("sonic.bin"))
+		Expect(p.ImageServedTime).NotTo(BeNil())
+		Expect(p.ScriptServedTime).NotTo(BeNil())
+		Expect(p.Stage1StartedTime).NotTo(BeNil())
+		Expect(p.Stage1DoneTime).NotTo(BeNil())
+		Expect(p.Stage2DoneTime).NotTo(BeNil())
+		Expect(p.AgentStartedTime).NotTo(BeNil())
+	})
+
+	It("should keep the stage 1 times when the script is served again after the reboot", func() {
+		for _, phase := range []networkingv1alpha1.ProvisioningPhase{
+			networkingv1alpha1.ProvisioningPhaseScriptServed,
+			networkingv1alpha1.ProvisioningPhaseStage1Started,
+			networkingv1alpha1.ProvisioningPhaseStage1Done,
+			networkingv1alpha1.ProvisioningPhaseScriptServed,
+			networkingv1alpha1.ProvisioningPhaseStage2Done,
+		} {
+			Expect(recorder.RecordPhase(ctx, id, phase)).To(Succeed())
+		}
+
+		p := provisioning()
+		Expect(p.Phase).To(Equal(networkingv1alpha1.ProvisioningPhaseStage2Done))
+		Expect(p.ScriptServedTime).NotTo(BeNil())
+		Expect(p.Stage1StartedTime).NotTo(BeNil())
+		Expect(p.Stage1DoneTime).NotTo(BeNil())
+		Expect(p.Stage2DoneTime).NotTo(BeNil())
+		Expect(p.AgentStartedTime).To(BeNil())
+	})
+
+	It("should clear the stages of a finished run when the script is served again", func() {
+		recordRun()
+		Expect(recorder.RecordPhase(ctx, id, networkingv1alpha1.ProvisioningPhaseScriptServed)).To(Succeed())
+
+		p := provisioning()
+		Expect(p.Phase).To(Equal(networkingv1alpha1.ProvisioningPhaseScriptServed))
+		Expect(p.ScriptServedTime).NotTo(BeNil())
+		Expect(p.Stage1StartedTime).To(BeNil())
+		Expect(p.Stage1DoneTime).To(BeNil())
+		Expect(p.Stage2DoneTime).To(BeNil())
+		Expect(p.AgentStartedTime).To(BeNil())
+	})
+
+	It("should clear the script and its stages when an image is served again", func() {
+		recordRun()
+		Expect(recorder.RecordImage(ctx, id, "sonic-2.bin")).To(Succeed())
+
+		p := provisioning()
+		Expect(p.Phase).To(Equal(networkingv1alpha1.ProvisioningPhaseImageServed))
+		Expect(p.Image).To(Equal("sonic-2.bin"))
+		Expect(p.ImageServedTime).NotTo(BeNil())
+		Expect(p.ScriptServedTime).To(BeNil())
+		Expect(p.Stage1StartedTime).To(BeNil())
+		Expect(p.Stage1DoneTime).To(BeNil())
+		Expect(p.Stage2DoneTime).To(BeNil())
+		Expect(p.AgentStartedTime).To(BeNil())
+	})
+
+	It("should skip identities without a Switch", func() {
+		hw, err := net.ParseMAC("11:22:33:44:55:66")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.RecordPhase(ctx, identity.Identity{MAC: hw}, networkingv1alpha1.ProvisioningPhaseScriptServed)).To(Succeed())
+
+		s := &networkingv1alpha1.Switch{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "leaf-1"}, s)).To(Succeed())
+		Expect(s.Status.Provisioning).To(BeNil())
+	})
+})
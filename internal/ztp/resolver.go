@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ztp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	"github.com/ironcore-dev/sonic-operator/internal/identity"
+)
+
+// Resolver resolves the parameters of the switch requesting a ZTP script.
+type Resolver interface {
+	// Resolve returns the parameters of the switch with the given identity. ok is false if the
+	// switch is unknown.
+	Resolve(ctx context.Context, id identity.Identity) (params SwitchParameters, ok bool, err error)
+}
+
+// StaticResolver resolves switches from the static parameters of a Config.
+type StaticResolver struct {
+	byAddr   map[netip.Addr]SwitchParameters
+	byMAC    map[string]SwitchParameters
+	bySerial map[string]SwitchParameters
+}
+
+// NewStaticResolver returns a resolver for the switchParams, switchParamsByMAC and switchParamsBySerial
+// of c. Invalid MAC addresses are skipped, they are rejected by Config.Validate.
+func NewStaticResolver(c Config) *StaticResolver {
+	r := &StaticResolver{
+		byAddr:   c.SwitchParams,
+		byMAC:    make(map[string]SwitchParameters, len(c.SwitchParamsByMAC)),
+		bySerial: c.SwitchParamsBySerial,
+	}
+	for mac, params := range c.SwitchParamsByMAC {
+		if hw, err := net.ParseMAC(mac); err == nil {
+			r.byMAC[hw.String()] = params
+		}
+	}
+	return r
+}
+
+// Resolve matches the serial number first, then the MAC address and then the address.
+func (r *StaticResolver) Resolve(_ context.Context, id identity.Identity) (SwitchParameters, bool, error) {
+	if params, ok := r.bySerial[id.SerialNumber]; ok && id.SerialNumber != "" {
+		return params, true, nil
+	}
+	if params, ok := r.byMAC[id.MAC.String()]; ok && id.MAC != nil {
+		return params, true, nil
+	}
+	params, ok := r.byAddr[id.Addr]
+	return params, ok, nil
+}
+
+// Resolvers asks each resolver in turn and returns the first match.
+type Resolvers []Resolver
+
+func (r Resolvers) Resolve(ctx context.Context, id identity.Identity) (SwitchParameters, bool, error) {
+	for _, resolver := range r {
+		params, ok, err := resolver.Resolve(ctx, id)
+		if err != nil || ok {
+			return params, ok, err
+		}
+	}
+	return SwitchParameters{}, false, nil
+}
+
+// SwitchResolver resolves switches from Switch objects with spec.provisioning set, matching the
+// identity against spec.provisioning.serialNumber, spec.macAddress and spec.management.host. The
+// HWSKU defaults to the one observed in status.sku. Reader is usually backed by a cache, so new
+// Switches are picked up without a restart.
+type SwitchResolver struct {
+	Reader client.Reader
+}
+
+func (r *SwitchResolver) Resolve(ctx context.Context, id identity.Identity) (SwitchParameters, bool, error) {
+	s, err := findSwitch(ctx, r.Reader, id, true)
+	if err != nil || s == nil {
+		return SwitchParameters{}, false, err
+	}
+	params, err := SwitchParametersFromSpec(s.Spec.Provisioning)
+	if err != nil {
+		return SwitchParameters{}, false, fmt.Errorf("invalid provisioning parameters of Switch %s: %w", s.Name, err)
+	}
+	if params.HWSKU == "" {
+		params.HWSKU = s.Status.SKU
+	}
+	return params, true, nil
+}
+
+const (
+	matchNone = iota
+	matchAddr
+	matchMAC
+	matchSerialNumber
+)
+
+// findSwitch returns the Switch matching id best, or nil if there is none. A matching serial number
+// takes precedence over a matching MAC address, which takes precedence over a matching management
+// host. If provisioning is true, only Switches with spec.provisioning set are considered.
+func findSwitch(ctx context.Context, reader client.Reader, id identity.Identity, provisioning bool) (*networkingv1alpha1.Switch, error) {
+	switches := &networkingv1alpha1.SwitchList{}
+	if err := reader.List(ctx, switches); err != nil {
+		return nil, fmt.Errorf("failed to list Switches: %w", err)
+	}
+
+	var best *networkingv1alpha1.Switch
+	bestMatch := matchNone
+	for i := range switches.Items {
+		s := &switches.Items[i]
+		if provisioning && s.Spec.Provisioning == nil {
+			continue
+		}
+		if m := matchSwitch(s, id); m > bestMatch {
+			best, bestMatch = s, m
+		}
+	}
+	return best, nil
+}
+
+func matchSwitch(s *networkingv1alpha1.Switch, id identity.Identity) int {
+	if id.SerialNumber != "" && s.Spec.Provisioning != nil && s.Spec.Provisioning.SerialNumber == id.SerialNumber {
+		return matchSerialNumber
+	}
+	if id.MAC != nil {
+		if mac, err := net.ParseMAC(s.Spec.MacAddress); err == nil && bytes.Equal(mac, id.MAC) {
+			return matchMAC
+		}
+	}
+	if host, err := netip.ParseAddr(s.Spec.Management.Host); err == nil && host.Unmap() == id.Addr.Unmap() {
+		return matchAddr
+	}
+	return matchNone
+}
+
+// SwitchParametersFromSpec converts the provisioning spec of a Switch into ZTP parameters.
+func SwitchParametersFromSpec(spec *networkingv1alpha1.ProvisioningSpec) (SwitchParameters, error) {
+	prefix, err := netip.ParsePrefix(spec.Prefix)
+	if err != nil {
+		return SwitchParameters{}, fmt.Errorf("invalid prefix: %w", err)
+	}
+	ip, err := netip.ParsePrefix(spec.LoopbackIP)
+	if err != nil {
+		return SwitchParameters{}, fmt.Errorf("invalid loopback IP: %w", err)
+	}
+	return SwitchParameters{
+		Type:     SwitchType(spec.Type),
+		ID:       int(spec.ID),
+		Prefix:   prefix,
+		IP:       ip,
+		ASNumber: int(spec.ASNumber),
+		HWSKU:    spec.HWSKU,
+	}, nil
+}
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ztp
+
+import (
+	"context"
+	"net"
+	"net/netip"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	"github.com/ironcore-dev/sonic-operator/internal/identity"
+)
+
+var _ = Describe("SwitchResolver", func() {
+	ctx := context.Background()
+
+	provisioning := func(id int32) *networkingv1alpha1.ProvisioningSpec {
+		return &networkingv1alpha1.ProvisioningSpec{
+			Type:       "leaf",
+			ID:         id,
+			Prefix:     "2001:db8::/64",
+			LoopbackIP: "2001:db8::/128",
+			ASNumber:   65000 + int64(id),
+		}
+	}
+
+	newResolver := func(switches ...*networkingv1alpha1.Switch) *SwitchResolver {
+		scheme := runtime.NewScheme()
+		Expect(networkingv1alpha1.AddToScheme(scheme)).To(Succeed())
+		builder := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&networkingv1alpha1.Switch{})
+		for _, s := range switches {
+			builder = builder.WithObjects(s)
+		}
+		return &SwitchResolver{Reader: builder.Build()}
+	}
+
+	mustParseMAC := func(s string) net.HardwareAddr {
+		mac, err := net.ParseMAC(s)
+		Expect(err).NotTo(HaveOccurred())
+		return mac
+	}
+
+	It("should resolve the parameters from the provisioning spec", func() {
+		r := newResolver(&networkingv1alpha1.Switch{
+			ObjectMeta: metav1.ObjectMeta{Name: "leaf-1"},
+			Spec: networkingv1alpha1.SwitchSpec{
+				MacAddress:   "aa:bb:cc:dd:ee:01",
+				Provisioning: provisioning(1),
+			},
+			Status: networkingv1alpha1.SwitchStatus{SKU: "Accton-AS7726-32X"},
+		})
+
+		params, ok, err := r.Resolve(ctx, identity.Identity{MAC: mustParseMAC("AA-BB-CC-DD-EE-01")})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(params).To(Equal(SwitchParameters{
+			Type:     SwitchTypeLeaf,
+			ID:       1,
+			Prefix:   netip.MustParsePrefix("2001:db8::/64"),
+			IP:       netip.MustParsePrefix("2001:db8::/128"),
+			ASNumber: 65001,
+			HWSKU:    "Accton-AS7726-32X",
+		}))
+	})
+
+	It("should prefer the HWSKU of the spec over the observed one", func() {
+		p := provisioning(1)
+		p.HWSKU = "Accton-AS7726-32X-100G"
+		r := newResolver(&networkingv1alpha1.Switch{
+			ObjectMeta: metav1.ObjectMeta{Name: "leaf-1"},
+			Spec:       networkingv1alpha1.SwitchSpec{MacAddress: "aa:bb:cc:dd:ee:01", Provisioning: p},
+			Status:     networkingv1alpha1.SwitchStatus{SKU: "Accton-AS7726-32X"},
+		})
+
+		params, ok, err := r.Resolve(ctx, identity.Identity{MAC: mustParseMAC("aa:bb:cc:dd:ee:01")})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(params.HWSKU).To(Equal("Accton-AS7726-32X-100G"))
+	})
+
+	It("should prefer the serial number over the MAC address over the management host", func() {
+		bySerial := provisioning(1)
+		bySerial.SerialNumber = "SN-1"
+		r := newResolver(
+			&networkingv1alpha1.Switch{
+				ObjectMeta: metav1.ObjectMeta{Name: "by-host"},
+				Spec: networkingv1alpha1.SwitchSpec{
+					Management:   networkingv1alpha1.Management{Host: "192.0.2.10"},
+					Provisioning: provisioning(3),
+				},
+			},
+			&networkingv1alpha1.Switch{
+				ObjectMeta: metav1.ObjectMeta{Name: "by-mac"},
+				Spec:       networkingv1alpha1.SwitchSpec{MacAddress: "aa:bb:cc:dd:ee:02", Provisioning: provisioning(2)},
+			},
+			&networkingv1alpha1.Switch{
+				ObjectMeta: metav1.ObjectMeta{Name: "by-serial"},
+				Spec:       networkingv1alpha1.SwitchSpec{Provisioning: bySerial},
+			},
+		)
+
+		id := identity.Identity{
+			Addr:         netip.MustParseAddr("192.0.2.10"),
+			MAC:          mustParseMAC("aa:bb:cc:dd:ee:02"),
+			SerialNumber: "SN-1",
+		}
+		params, ok, err := r.Resolve(ctx, id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(params.ID).To(Equal(1))
+
+		id.SerialNumber = "SN-2"
+		params, _, err = r.Resolve(ctx, id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(params.ID).To(Equal(2))
+
+		id.MAC = mustParseMAC("aa:bb:cc:dd:ee:03")
+		params, _, err = r.Resolve(ctx, id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(params.ID).To(Equal(3))
+	})
+
+	It("should skip Switches without provisioning parameters", func() {
+		r := newResolver(&networkingv1alpha1.Switch{
+			ObjectMeta: metav1.ObjectMeta{Name: "leaf-1"},
+			Spec:       networkingv1alpha1.SwitchSpec{MacAddress: "aa:bb:cc:dd:ee:01"},
+		})
+
+		_, ok, err := r.Resolve(ctx, identity.Identity{MAC: mustParseMAC("aa:bb:cc:dd:ee:01")})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should fail for invalid provisioning parameters", func() {
+		p := provisioning(1)
+		p.Prefix = "2001:db8::"
+		r := newResolver(&networkingv1alpha1.Switch{
+			ObjectMeta: metav1.ObjectMeta{Name: "leaf-1"},
+			Spec:       networkingv1alpha1.SwitchSpec{MacAddress: "aa:bb:cc:dd:ee:01", Provisioning: p},
+		})
+
+		_, ok, err := r.Resolve(ctx, identity.Identity{MAC: mustParseMAC("aa:bb:cc:dd:ee:01")})
+		Expect(err).To(MatchError(ContainSubstring("invalid provisioning parameters of Switch leaf-1: invalid prefix")))
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should fall back to the next resolver", func() {
+		static := NewStaticResolver(Config{
+			SwitchParamsBySerial: map[string]SwitchParameters{"SN-9": {Type: SwitchTypeSpine, ID: 9}},
+		})
+		r := Resolvers{newResolver(), static}
+
+		params, ok, err := r.Resolve(ctx, identity.Identity{SerialNumber: "SN-9"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(params.Type).To(Equal(SwitchTypeSpine))
+
+		_, ok, err = r.Resolve(ctx, identity.Identity{SerialNumber: "SN-10"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ztp
+
+import (
+	"fmt"
+	"path/filepath"
+	"text/template"
+)
+
+// templateSuffix is the suffix of template files. A switch type without an entry in
+// Config.TemplatesByType uses the template "<type>.sh.gotmpl".
+const templateSuffix = ".sh.gotmpl"
+
+// parseTemplates parses the built-in templates and the templates of c.TemplateDir with the
+// helper functions available to all templates. Templates of the directory replace built-in
+// templates of the same name.
+func parseTemplates(c Config) (*template.Template, error) {
+	t := template.New("ztp-scripts")
+	t = t.Funcs(template.FuncMap{
+		"add":             func(a, b int) int { return a + b },
+		"dhcpServerAddr":  func() string { return c.DHCPServerAddr },
+		"searchDomain":    func() string { return c.SearchDomain },
+		"interfacePrefix": interfacePrefix,
+	})
+	t, err := t.ParseFS(templateFS, "templates/*.gotmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse built-in templates: %w", err)
+	}
+
+	if c.TemplateDir == "" {
+		return t, nil
+	}
+	files, err := filepath.Glob(filepath.Join(c.TemplateDir, "*.gotmpl"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return t, nil
+	}
+	if t, err = t.ParseFiles(files...); err != nil {
+		return nil, fmt.Errorf("failed to parse templates of %s: %w", c.TemplateDir, err)
+	}
+	return t, nil
+}
+
+// templateName returns the name of the template of a switch. A template mapped to its HWSKU takes
+// precedence over the template of its type.
+func (s *handlerState) templateName(p SwitchParameters) (string, error) {
+	if name, ok := s.templatesByHWSKU[p.HWSKU]; ok && p.HWSKU != "" {
+		return name, nil
+	}
+	name, ok := s.templatesByType[p.Type]
+	if !ok {
+		name = string(p.Type) + templateSuffix
+	}
+	if s.t.Lookup(name) == nil {
+		return "", fmt.Errorf("no ZTP template for switch type '%s'", p.Type)
+	}
+	return name, nil
+}
+
+// checkTemplates checks that every mapped template exists and that every static switch has a
+// template.
+func (s *handlerState) checkTemplates(c Config) error {
+	for hwsku, name := range c.TemplatesByHWSKU {
+		if s.t.Lookup(name) == nil {
+			return fmt.Errorf("templatesByHWSKU[%s]: unknown template %s", hwsku, name)
+		}
+	}
+	for switchType, name := range c.TemplatesByType {
+		if s.t.Lookup(name) == nil {
+			return fmt.Errorf("templatesByType[%s]: unknown template %s", switchType, name)
+		}
+	}
+
+	check := func(field string, key any, params SwitchParameters) error {
+		if _, err := s.templateName(params); err != nil {
+			return fmt.Errorf("%s[%v]: %w", field, key, err)
+		}
+		return nil
+	}
+	for addr, params := range c.SwitchParams {
+		if err := check("switchParams", addr, params); err != nil {
+			return err
+		}
+	}
+	for mac, params := range c.SwitchParamsByMAC {
+		if err := check("switchParamsByMAC", mac, params); err != nil {
+			return err
+		}
+	}
+	for serial, params := range c.SwitchParamsBySerial {
+		if err := check("switchParamsBySerial", serial, params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -4,19 +4,30 @@
 package ztp
 
 import (
+	"bytes"
+	"context"
 	"embed"
+	"encoding/json"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"net/netip"
+	"net/url"
+	"sync/atomic"
 	"text/template"
+
+	networkingv1alpha1 "github.com/ironcore-dev/sonic-operator/api/v1alpha1"
+	"github.com/ironcore-dev/sonic-operator/internal/identity"
 )
 
 //go:embed templates
 var templateFS embed.FS
 
+// SwitchType selects the ZTP template of a switch, see Config.TemplatesByType.
 type SwitchType string
 
+// The built-in templates support these switch types.
 const (
 	SwitchTypeLeaf  SwitchType = "leaf"
 	SwitchTypeSpine SwitchType = "spine"
@@ -44,6 +55,27 @@ type Config struct {
 	//   2001:db8::547
 	DHCPServerAddr string                          `json:"dhcpServerAddr"`
 	SwitchParams   map[netip.Addr]SwitchParameters `json:"switchParams"`
+	// SwitchParamsByMAC holds the parameters of switches identified by their MAC address,
+	// sent as ONIE-ETH-ADDR header or mac query parameter.
+	SwitchParamsByMAC map[string]SwitchParameters `json:"switchParamsByMAC,omitempty"`
+	// SwitchParamsBySerial holds the parameters of switches identified by their serial number,
+	// sent as ONIE-SERIAL-NUMBER header or serial query parameter.
+	SwitchParamsBySerial map[string]SwitchParameters `json:"switchParamsBySerial,omitempty"`
+	// Resolver resolves the parameters of a requesting switch. If nil, the static parameters
+	// above are used.
+	Resolver Resolver `json:"-"`
+	// TrustedProxies are the proxies whose X-Forwarded-For header is used to identify a switch.
+	TrustedProxies identity.TrustedProxies `json:"-"`
+	// Recorder records the progress reported by the ZTP scripts. If nil, the progress is only logged.
+	Recorder ProgressRecorder `json:"-"`
+	// TemplatesByType maps switch types to template names, e.g., "border-leaf" to
+	// "leaf.sh.gotmpl". A type without an entry uses the template "<type>.sh.gotmpl".
+	TemplatesByType map[SwitchType]string `json:"templatesByType,omitempty"`
+	// TemplatesByHWSKU maps HWSKUs to template names. It takes precedence over TemplatesByType.
+	TemplatesByHWSKU map[string]string `json:"templatesByHWSKU,omitempty"`
+	// TemplateDir is a directory with additional templates (*.gotmpl), e.g., a mounted ConfigMap.
+	// A template with the name of a built-in template replaces it.
+	TemplateDir string `json:"-"`
 }
 
 type SwitchParameters struct {
@@ -62,24 +94,128 @@ type SwitchParameters struct {
 	//   2001:db8::/128
 	IP       netip.Prefix `json:"ip"`
 	ASNumber int          `json:"asNumber"`
+	// HWSKU selects a template of Config.TemplatesByHWSKU.
+	HWSKU string `json:"hwsku,omitempty"`
+}
+
+// ParseConfig decodes and validates the content of a ZTP config file.
+func ParseConfig(content []byte) (Config, error) {
+	var c Config
+	if err := json.Unmarshal(content, &c); err != nil {
+		return Config{}, err
+	}
+	return c, c.Validate()
+}
+
+// Validate checks that the static switch parameters can be rendered.
+func (c Config) Validate() error {
+	for addr, params := range c.SwitchParams {
+		if err := params.Validate(); err != nil {
+			return fmt.Errorf("switchParams[%s]: %w", addr, err)
+		}
+	}
+	for mac, params := range c.SwitchParamsByMAC {
+		if _, err := net.ParseMAC(mac); err != nil {
+			return fmt.Errorf("switchParamsByMAC[%s]: %w", mac, err)
+		}
+		if err := params.Validate(); err != nil {
+			return fmt.Errorf("switchParamsByMAC[%s]: %w", mac, err)
+		}
+	}
+	for serial, params := range c.SwitchParamsBySerial {
+		if err := params.Validate(); err != nil {
+			return fmt.Errorf("switchParamsBySerial[%s]: %w", serial, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks that the type of the switch is set and that its prefix is a /64. Whether a
+// template exists for the type is checked when the config is activated.
+func (p SwitchParameters) Validate() error {
+	if p.Type == "" {
+		return fmt.Errorf("switch type must not be empty")
+	}
+	if p.Prefix.Bits() != 64 {
+		return fmt.Errorf("unexpected prefix size %d, want 64", p.Prefix.Bits())
+	}
+	return nil
+}
+
+// Handler renders the ZTP scripts.
+type Handler struct {
+	state atomic.Pointer[handlerState]
 }
 
-type handler struct {
-	t *template.Template
-	m map[netip.Addr]SwitchParameters
+type handlerState struct {
+	t                *template.Template
+	templatesByType  map[SwitchType]string
+	templatesByHWSKU map[string]string
+	resolver         Resolver
+	recorder         ProgressRecorder
+	proxies          identity.TrustedProxies
 }
 
-func Register(mux *http.ServeMux, c Config) {
-	t := template.New("ztp-scripts")
-	t = t.Funcs(template.FuncMap{
-		"add":             func(a, b int) int { return a + b },
-		"dhcpServerAddr":  func() string { return c.DHCPServerAddr },
-		"searchDomain":    func() string { return c.SearchDomain },
-		"interfacePrefix": interfacePrefix,
-	})
-	t = template.Must(t.ParseFS(templateFS, "templates/*.gotmpl"))
+// templateData is passed to the ZTP templates. StatusURL is the URL of POST /ztp/status as seen
+// by the switch, which the scripts call with the stage they reached.
+type templateData struct {
+	SwitchParameters
+	StatusURL string
+}
 
-	mux.Handle("GET /ztp", &handler{t: t, m: c.SwitchParams})
+// Register a handler which renders the ZTP script of the requesting switch at GET /ztp and
+// receives the progress reported by the script at POST /ztp/status. The config can be replaced
+// at runtime with Handler.Update.
+func Register(mux *http.ServeMux, c Config) (*Handler, error) {
+	state, err := newHandlerState(c)
+	if err != nil {
+		return nil, err
+	}
+	h := &Handler{}
+	h.state.Store(state)
+	mux.Handle("GET /ztp", h)
+	mux.HandleFunc("POST /ztp/status", h.serveStatus)
+	return h, nil
+}
+
+// Update validates c, parses its templates and replaces the config of the handler. Requests which
+// are already being served keep the config they started with. If c is invalid, the current config
+// is kept.
+func (h *Handler) Update(c Config) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	state, err := newHandlerState(c)
+	if err != nil {
+		return err
+	}
+	h.state.Store(state)
+	slog.Info("updated ZTP config", "component", "ztp", "switches", len(c.SwitchParams))
+	return nil
+}
+
+func newHandlerState(c Config) (*handlerState, error) {
+	t, err := parseTemplates(c)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := c.Resolver
+	if resolver == nil {
+		resolver = NewStaticResolver(c)
+	}
+	state := &handlerState{
+		t:                t,
+		templatesByType:  c.TemplatesByType,
+		templatesByHWSKU: c.TemplatesByHWSKU,
+		resolver:         resolver,
+		recorder:         c.Recorder,
+		proxies:          c.TrustedProxies,
+	}
+	if err := state.checkTemplates(c); err != nil {
+		return nil, err
+	}
+	return state, nil
 }
 
 // interfacePrefix takes the interface ID and the /64 prefix of the switch and
@@ -98,29 +234,85 @@ func interfacePrefix(prefix netip.Prefix, interfaceID int) (string, error) {
 	return prefix.String(), nil
 }
 
-func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	ap, err := netip.ParseAddrPort(r.RemoteAddr)
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	state := h.state.Load()
+
+	id, err := state.proxies.Identify(r)
 	if err != nil {
-		handleErr(w, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	c, ok := h.m[ap.Addr()]
+	c, ok, err := state.resolver.Resolve(r.Context(), id)
+	if err != nil {
+		handleErr(w, err)
+		return
+	}
 	if !ok {
-		handleErr(w, fmt.Errorf("unknown ip '%s'", ap.Addr().String()))
+		http.Error(w, fmt.Sprintf("unknown switch '%s'", id), http.StatusNotFound)
 		return
 	}
 
-	switch c.Type {
-	case SwitchTypeLeaf:
-		err = h.t.ExecuteTemplate(w, "leaf.sh.gotmpl", c)
-	case SwitchTypeSpine:
-		err = h.t.ExecuteTemplate(w, "spine.sh.gotmpl", c)
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
 	}
+	statusURL := url.URL{Scheme: scheme, Host: r.Host, Path: "/ztp/status", RawQuery: id.Query().Encode()}
+	data := templateData{
+		SwitchParameters: c,
+		StatusURL:        statusURL.String(),
+	}
+
+	name, err := state.templateName(c)
 	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Render into a buffer first, a switch must never run a partially rendered script.
+	var script bytes.Buffer
+	if err := state.t.ExecuteTemplate(&script, name, data); err != nil {
 		handleErr(w, err)
 		return
 	}
+	if _, err := script.WriteTo(w); err != nil {
+		slog.Error("failed to write ZTP script", "component", "ztp", "client", id.String(), "err", err)
+		return
+	}
+
+	state.record(r.Context(), id, networkingv1alpha1.ProvisioningPhaseScriptServed)
+}
+
+// serveStatus receives the stage reached by a ZTP script, passed as the form value "stage".
+func (h *Handler) serveStatus(w http.ResponseWriter, r *http.Request) {
+	state := h.state.Load()
+
+	id, err := state.proxies.Identify(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	stage := Stage(r.FormValue("stage"))
+	phase, ok := stagePhases[stage]
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown stage '%s'", stage), http.StatusBadRequest)
+		return
+	}
+
+	state.record(r.Context(), id, phase)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *handlerState) record(ctx context.Context, id identity.Identity, phase networkingv1alpha1.ProvisioningPhase) {
+	logger := slog.With("component", "ztp", "client", id.String(), "phase", phase)
+	if s.recorder == nil {
+		logger.Info("provisioning progress")
+		return
+	}
+	if err := s.recorder.RecordPhase(ctx, id, phase); err != nil {
+		logger.Warn("failed to record provisioning progress", "err", err)
+	}
 }
 
 func handleErr(w http.ResponseWriter, e error) {
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ztp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ironcore-dev/sonic-operator/internal/identity"
+)
+
+// failingResolver fails to resolve any switch.
+type failingResolver struct{}
+
+func (failingResolver) Resolve(context.Context, identity.Identity) (SwitchParameters, bool, error) {
+	return SwitchParameters{}, false, errors.New("failed to list Switches")
+}
+
+var _ = Describe("Handler", func() {
+	var mux *http.ServeMux
+
+	config := func() Config {
+		return Config{
+			SwitchParams: map[netip.Addr]SwitchParameters{
+				netip.MustParseAddr("192.0.2.10"): {
+					Type:   SwitchTypeLeaf,
+					ID:     1,
+					Prefix: netip.MustParsePrefix("2001:db8::/64"),
+					IP:     netip.MustParsePrefix("2001:db8::/128"),
+				},
+			},
+		}
+	}
+
+	serve := func(remoteAddr string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/ztp", nil)
+		r.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w
+	}
+
+	BeforeEach(func() {
+		mux = http.NewServeMux()
+	})
+
+	It("should serve the script of a known switch", func() {
+		_, err := Register(mux, config())
+		Expect(err).NotTo(HaveOccurred())
+
+		w := serve("192.0.2.10:4242")
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Body.String()).To(ContainSubstring("http://example.com/ztp/status"))
+	})
+
+	It("should answer unknown switches with not found", func() {
+		_, err := Register(mux, config())
+		Expect(err).NotTo(HaveOccurred())
+
+		w := serve("192.0.2.11:4242")
+		Expect(w.Code).To(Equal(http.StatusNotFound))
+		Expect(w.Body.String()).To(ContainSubstring("unknown switch"))
+	})
+
+	It("should answer resolver failures with a server error", func() {
+		c := config()
+		c.Resolver = failingResolver{}
+		_, err := Register(mux, c)
+		Expect(err).NotTo(HaveOccurred())
+
+		w := serve("192.0.2.10:4242")
+		Expect(w.Code).To(Equal(http.StatusInternalServerError))
+	})
+})